@@ -25,6 +25,7 @@ func main() {
 
 	app.Commands = []*cli.Command{
 		commands.SolveCommand(),
+		commands.EnumerateCommand(),
 		commands.LsCommand(),
 	}
 
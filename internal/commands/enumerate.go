@@ -0,0 +1,118 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/abhchand/libmatch"
+	"github.com/abhchand/libmatch/internal/config"
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/urfave/cli/v2"
+)
+
+// Matching algorithms whose stable matchings can be enumerated
+var ENUMERABLE_ALGORITHMS = [2]string{"SMP", "SMI"}
+
+// EnumerateCommand generates the cli.Command definition for the `enumerate`
+// subcommand.
+func EnumerateCommand() *cli.Command {
+	/*
+	 * The `cli.Command` return value is wrapped in a function so we return a new
+	 * instance of it every time. This avoids caching flags between tests
+	 */
+	return &cli.Command{
+		Name:   "enumerate",
+		Usage:  "List every stable matching",
+		Action: enumerateAction,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "algorithm",
+				Usage:    "Algorithm whose stable matchings are listed. Must be one of 'SMP', 'SMI'",
+				Required: true,
+				Aliases:  []string{"a"},
+			},
+			&cli.StringSliceFlag{
+				Name:     "file",
+				Usage:    "JSON-formatted file containing list of matching preferences",
+				Required: true,
+				Aliases:  []string{"f"},
+			},
+			&cli.StringFlag{
+				Name:     "format",
+				Usage:    "Output format to print results. Must be one of 'csv', 'json'",
+				Required: false,
+				Value:    "csv",
+				Aliases:  []string{"o"},
+			},
+			&cli.StringFlag{
+				Name:     "proposer",
+				Usage:    "Side whose optimal matching is listed first. Must be one of 'a' (first --file), 'b' (second --file)",
+				Required: false,
+				Value:    "a",
+				Aliases:  []string{"p"},
+			},
+		},
+	}
+}
+
+// enumerateAction is the handler for the `enumerate` subcommand, which prints
+// every stable matching of the specified matching algorithm as it is found.
+//
+// In the csv format matchings are separated by an empty line, and in the json
+// format each matching is printed on its own line.
+func enumerateAction(ctx *cli.Context) error {
+	// Create a new libmatch `Config`
+	cfg, err := config.NewConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Validate the config, which validates the CLI input flags
+	if err = validateEnumerateConfig(*cfg); err != nil {
+		return err
+	}
+
+	// Read one or more input files and load data into `core.MatchPreference` structures
+	prefsSet, err := loadFiles(*cfg)
+	if err != nil {
+		return err
+	}
+
+	opts := []libmatch.Option{libmatch.WithProposer(libmatch.Side(cfg.Proposer))}
+	if cfg.Algorithm == "SMI" {
+		opts = append(opts, libmatch.WithIncompleteLists())
+	}
+
+	count := 0
+	print := func(result core.MatchResult) bool {
+		if count > 0 && cfg.OutputFormat == "csv" {
+			fmt.Println("")
+		}
+		count++
+
+		result.Print(cfg.OutputFormat)
+
+		return true
+	}
+
+	return libmatch.EnumerateSMP(prefsSet[0], prefsSet[1], print, opts...)
+}
+
+// validateEnumerateConfig validates the configuration containing the CLI
+// input flags of the `enumerate` subcommand
+func validateEnumerateConfig(cfg config.Config) error {
+	// Verify `--algorithm` value can be enumerated
+	valid := false
+	for i := range ENUMERABLE_ALGORITHMS {
+		if cfg.Algorithm == ENUMERABLE_ALGORITHMS[i] {
+			valid = true
+			break
+		}
+	}
+
+	if !(valid) {
+		return errors.New(fmt.Sprintf("Can not enumerate matchings for `--algorithm` value: %v", cfg.Algorithm))
+	}
+
+	return validateConfig(cfg)
+}
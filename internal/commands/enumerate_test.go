@@ -0,0 +1,126 @@
+package commands
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/abhchand/libmatch/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli/v2"
+)
+
+func TestEnumerateAction(t *testing.T) {
+	t.Run("SMP", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"A", "preferences": ["C", "D"] },
+	    { "name":"B", "preferences": ["D", "C"] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		body = `
+	  [
+	    { "name":"C", "preferences": ["B", "A"] },
+	    { "name":"D", "preferences": ["A", "B"] }
+	  ]
+		`
+		writeToFile(otherFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "SMP", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+		err := enumerateAction(ctx)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("SMI", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"A", "preferences": ["C", "D"] },
+	    { "name":"B", "preferences": ["D"] },
+	    { "name":"E", "preferences": ["C"] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		body = `
+	  [
+	    { "name":"C", "preferences": ["E", "A"] },
+	    { "name":"D", "preferences": ["A", "B"] }
+	  ]
+		`
+		writeToFile(otherFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "SMI", "doc")
+		globalSet.String("format", "json", "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+		err := enumerateAction(ctx)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("error validating Config", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"A", "preferences": ["B"] },
+	    { "name":"B", "preferences": ["A"] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "SRP", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.Var(cli.NewStringSlice(testFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+		err := enumerateAction(ctx)
+
+		if assert.NotNil(t, err) {
+			assert.Equal(t, "Can not enumerate matchings for `--algorithm` value: SRP", err.Error())
+		}
+	})
+}
+
+func TestValidateEnumerateConfig(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "smp", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+		cfg, err := config.NewConfig(ctx)
+		err = validateEnumerateConfig(*cfg)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("invalid number of tables", func(t *testing.T) {
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "smp", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.Var(cli.NewStringSlice(testFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+		cfg, err := config.NewConfig(ctx)
+		err = validateEnumerateConfig(*cfg)
+
+		if assert.NotNil(t, err) {
+			assert.Equal(t, "Expected --file to be specified exactly 2 time(s)", err.Error())
+		}
+	})
+}
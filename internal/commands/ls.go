@@ -16,8 +16,124 @@ var AlgorithmDescriptions = map[string]string{
 Find a stable matching between two same-sized sets.
 Implements the Gale-Shapley (1962) algorithm.
 A stable solution is always guranteed, but it is non-deterministic
-and potentially one of many.
+and potentially one of many. Members may rank others equally (ties),
+in which case a weakly stable matching is found.
 
+https://en.wikipedia.org/wiki/Stable_marriage_problem.`,
+	"HR": `Hospitals/Residents Problem
+Find a stable many-to-one matching between residents and hospitals,
+where each hospital may accept multiple residents up to its capacity.
+Implements the Gale-Shapley (1962) deferred acceptance algorithm,
+which always finds a stable matching.
+https://en.wikipedia.org/wiki/National_Resident_Matching_Program.`,
+	"HRI": `Hospitals/Residents Problem with Incomplete Lists
+Find a stable matching of residents to hospitals like HR, where
+residents and hospitals may omit unacceptable members from their
+preferences and there may be more residents than places. Some
+residents may remain unmatched.
+Implements the Gale-Shapley (1962) deferred acceptance algorithm.
+https://en.wikipedia.org/wiki/National_Resident_Matching_Program.`,
+	"HRC": `Hospitals/Residents Problem with Couples
+Find a stable matching of residents to hospitals like HR, where
+couples of residents rank pairs of hospitals together. Expects files
+of residents and hospitals, and the couples' joint preferences with
+--couples. Implements a Roth-Peranson (1999) style heuristic that
+gives up after --search-limit proposals, so a stable matching is not
+guaranteed.
+https://en.wikipedia.org/wiki/National_Resident_Matching_Program.`,
+	"HRLQ": `Hospitals/Residents Problem with Lower Quotas
+Find a stable matching of residents to hospitals like HR, where each
+hospital also has a "lower_quota" of residents it must be assigned.
+Fails when no stable matching meets every lower quota, unless
+--close-underfilled is set, which closes hospitals that fall short
+one at a time and lists them as "closed". The result is always
+deterministic.
+Biró, Fleiner, Irving and Manlove (2010) "The College Admissions
+problem with lower and common quotas".`,
+	"SPA": `Student-Project Allocation Problem
+Find a stable allocation of students to projects, where each project
+is offered by a lecturer and both projects and lecturers have a
+capacity. Expects files of students, projects and lecturers, in order.
+Implements the Abraham-Irving-Manlove (2007) student-oriented
+algorithm, which always finds the student-optimal stable matching.
+Abraham, Irving and Manlove (2007) "Two algorithms for the
+Student-Project Allocation problem".`,
+	"TTC": `Top Trading Cycles
+Find a Pareto efficient assignment of students to schools, where
+each school has a capacity, ranks students by priority and may be
+owned by a student. Also solves housing markets, where each house
+is owned by a student. Expects files of students and schools.
+Implements Gale's Top Trading Cycles algorithm, as described by
+Shapley and Scarf (1974) and Abdulkadiroglu and Sonmez (2003).
+The result is not stable, but is always deterministic.
+https://en.wikipedia.org/wiki/Top_trading_cycle.`,
+	"SD": `Serial Dictatorship
+Allocate items to members, where only members have preferences
+and each item has a capacity. Expects files of members and items.
+Members pick their most preferred remaining item one at a time, in
+the order given with --priority (defaults to the order of the
+members file). The result is Pareto efficient and deterministic.
+https://en.wikipedia.org/wiki/Random_serial_dictatorship.`,
+	"POP": `Popular Matching Problem
+Find a popular matching of applicants to houses, which no other
+matching is preferred to by more applicants. Only applicants have
+preferences, which may contain ties. Expects files of applicants
+and houses. A popular matching does not always exist.
+Implements the Abraham-Irving-Kavitha-Mehlhorn (2007) algorithm.
+https://en.wikipedia.org/wiki/Popular_matching.`,
+	"RMM": `Rank-Maximal Matching
+Find a matching of applicants to houses that gives as many
+applicants as possible their first choice, then their second
+choice, and so on. Only applicants have preferences, which may
+contain ties, and houses may have capacities. Expects files of
+applicants and houses. The json format includes the signature:
+the number of applicants matched at each rank.
+Implements the Irving-Kavitha-Mehlhorn-Michail-Paluch (2006)
+algorithm.
+https://en.wikipedia.org/wiki/Rank-maximal_allocation.`,
+	"DA": `Deferred Acceptance for School Choice
+Assign students to schools, where schools have capacities and rank
+students in coarse priority classes. Expects files of students and
+schools. Ties in priority are broken by lottery: --lottery single
+draws one lottery for all schools, and --lottery multiple draws one
+per school, seeded with --seed. The json format includes the lottery
+numbers, so the same seed always reproduces the result.
+Implements the Abdulkadiroglu-Sonmez (2003) algorithm.
+https://en.wikipedia.org/wiki/School_choice.`,
+	"IA": `Immediate Acceptance (Boston Mechanism) for School Choice
+Assign students to schools like DA, from the same files and with
+the same --seed and --lottery, so the two can be compared side by
+side. Students apply to their first choice, then their second, and
+so on, and schools admit their highest priority applicants while
+seats are left. Admissions are final, so the result is not stable.
+https://en.wikipedia.org/wiki/School_choice.`,
+	"AP": `Assignment Problem
+Find the matching between two sets that maximises the total score of
+all matched pairs, rather than a stable matching. Members may list a
+score for each preference, and a pair scores the sum of the scores
+both members give each other. Use --costs to minimise the total
+instead.
+Implements the Hungarian (Kuhn-Munkres) algorithm.
+https://en.wikipedia.org/wiki/Hungarian_algorithm.`,
+	"RSD": `Random Serial Dictatorship
+Allocate items to members like SD, where members pick in a random
+order derived from --seed. Use --draws to average over many random
+orders and print the probability of each member picking each item.
+https://en.wikipedia.org/wiki/Random_serial_dictatorship.`,
+	"PS": `Probabilistic Serial
+Allocate items to members by "simultaneous eating", printing the
+probability of each member receiving each item. Use --format matrix
+to print the probabilities as a table, or --sample to draw a single
+matching from the lottery derived from --seed.
+Implements the Bogomolnaia-Moulin (2001) algorithm.
+https://en.wikipedia.org/wiki/Probabilistic-serial_procedure.`,
+	"SMI": `Stable Marriage Problem with Incomplete Lists
+Find a stable matching between two sets, where members may omit
+unacceptable members from their preferences and sets may differ in
+size. Some members may remain unmatched.
+Implements the Gale-Shapley (1962) algorithm. With ties, use
+--objective maximum-cardinality to approximate the largest weakly
+stable matching with the Király (2013) 3/2-approximation algorithm.
 https://en.wikipedia.org/wiki/Stable_marriage_problem.`,
 	"SRP": `Stable Roommates Problem
 
@@ -26,8 +142,19 @@ A stable solution is not guranteed, but is always deterministic if
 exists.
 Implements Irving's (1985) algorithm.
 
+Members with a capacity greater than 1 may be matched with that many
+others, in a set of any size (Stable Fixtures Problem).
+Implements Irving and Scott's (2007) algorithm.
+
 https://en.wikipedia.org/wiki/Stable_roommates_problem.
 `,
+	"SRI": `Stable Roommates Problem with Incomplete Lists
+Find a stable matching within a set, where members may omit
+unacceptable members from their preferences and the set may be
+odd-sized. Some members may remain unmatched.
+A stable solution is not guaranteed.
+Implements Irving's (1985) algorithm.
+https://en.wikipedia.org/wiki/Stable_roommates_problem.`,
 }
 
 // LsCommand generates the cli.Command definition for the `ls` subcommand.
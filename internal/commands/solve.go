@@ -18,11 +18,62 @@ var MATCHING_ALGORITHMS_CFG = map[string]struct {
 	"SMP": {
 		numInputFilesRequired: 2,
 	},
+	"SMI": {
+		numInputFilesRequired: 2,
+	},
 	"SRP": {
 		numInputFilesRequired: 1,
 	},
+	"SRI": {
+		numInputFilesRequired: 1,
+	},
+	"HR": {
+		numInputFilesRequired: 2,
+	},
+	"HRI": {
+		numInputFilesRequired: 2,
+	},
+	"HRC": {
+		numInputFilesRequired: 2,
+	},
+	"HRLQ": {
+		numInputFilesRequired: 2,
+	},
+	"SPA": {
+		numInputFilesRequired: 3,
+	},
+	"TTC": {
+		numInputFilesRequired: 2,
+	},
+	"SD": {
+		numInputFilesRequired: 2,
+	},
+	"RSD": {
+		numInputFilesRequired: 2,
+	},
+	"PS": {
+		numInputFilesRequired: 2,
+	},
+	"POP": {
+		numInputFilesRequired: 2,
+	},
+	"RMM": {
+		numInputFilesRequired: 2,
+	},
+	"AP": {
+		numInputFilesRequired: 2,
+	},
+	"DA": {
+		numInputFilesRequired: 2,
+	},
+	"IA": {
+		numInputFilesRequired: 2,
+	},
 }
-var OUTPUT_FORMATS = [2]string{"csv", "json"}
+var OUTPUT_FORMATS = [3]string{"csv", "json", "matrix"}
+var PROPOSERS = [2]string{"a", "b"}
+var LOTTERIES = [2]string{"single", "multiple"}
+var OBJECTIVES = [7]string{"proposer-optimal", "egalitarian", "minimum-regret", "sex-equal", "balanced", "median", "maximum-cardinality"}
 
 // SolveCommand generates the cli.Command definition for the `solve` subcommand.
 func SolveCommand() *cli.Command {
@@ -49,11 +100,77 @@ func SolveCommand() *cli.Command {
 			},
 			&cli.StringFlag{
 				Name:     "format",
-				Usage:    "Output format to print results. Must be one of 'csv', 'json'",
+				Usage:    "Output format to print results. Must be one of 'csv', 'json', 'matrix' (probabilities only)",
 				Required: false,
 				Value:    "csv",
 				Aliases:  []string{"o"},
 			},
+			&cli.StringFlag{
+				Name:     "proposer",
+				Usage:    "Side that proposes in two-sided algorithms, receiving its optimal matching. Must be one of 'a' (first --file), 'b' (second --file)",
+				Required: false,
+				Value:    "a",
+				Aliases:  []string{"p"},
+			},
+			&cli.StringFlag{
+				Name:     "objective",
+				Usage:    "Criterion used to choose among stable matchings in SMP and SMI. Must be one of 'proposer-optimal', 'egalitarian', 'minimum-regret', 'sex-equal', 'balanced', 'median', 'maximum-cardinality'",
+				Required: false,
+				Value:    "proposer-optimal",
+			},
+			&cli.BoolFlag{
+				Name:     "maximum-stable",
+				Usage:    "Return a maximum stable matching in SRP and SRI when no stable matching exists, leaving some members unmatched",
+				Required: false,
+			},
+			&cli.StringFlag{
+				Name:     "priority",
+				Usage:    "JSON-formatted file containing the list of member names in the order in which they pick in SD. Defaults to the order of the first --file",
+				Required: false,
+			},
+			&cli.Int64Flag{
+				Name:     "seed",
+				Usage:    "Seed of the random order in which members pick in RSD, of the lottery in DA and IA, or of the matching sampled in PS",
+				Required: false,
+				Value:    0,
+			},
+			&cli.IntFlag{
+				Name:     "draws",
+				Usage:    "Number of random orders drawn in RSD. When greater than 1, the probability of each member picking each item is printed instead",
+				Required: false,
+				Value:    1,
+			},
+			&cli.BoolFlag{
+				Name:     "sample",
+				Usage:    "Draw a single matching from the lottery computed by PS, using --seed, and print it instead of the probabilities",
+				Required: false,
+			},
+			&cli.StringFlag{
+				Name:     "lottery",
+				Usage:    "Lottery used to break ties in priority in DA and IA. Must be one of 'single' (one lottery for all schools), 'multiple' (one lottery per school)",
+				Required: false,
+				Value:    "single",
+			},
+			&cli.BoolFlag{
+				Name:     "costs",
+				Usage:    "Treat scores as costs to be minimised in AP, rather than utilities to be maximised",
+				Required: false,
+			},
+			&cli.StringFlag{
+				Name:     "couples",
+				Usage:    "JSON-formatted file containing the joint preferences of couples of residents in HRC",
+				Required: false,
+			},
+			&cli.IntFlag{
+				Name:     "search-limit",
+				Usage:    "Number of proposals made in HRC before giving up on finding a stable matching. Defaults to 10000",
+				Required: false,
+			},
+			&cli.BoolFlag{
+				Name:     "close-underfilled",
+				Usage:    "Close hospitals that can not meet their lower quota in HRLQ, rather than failing",
+				Required: false,
+			},
 		},
 	}
 }
@@ -84,21 +201,107 @@ func solveAction(ctx *cli.Context) error {
 	 * Call the appropriate `libmatch` API method for the specified
 	 * Matching Algorithm
 	 */
+	proposer := libmatch.WithProposer(libmatch.Side(cfg.Proposer))
+	objective := libmatch.WithObjective(libmatch.Objective(cfg.Objective))
+
+	roommatesOpts := []libmatch.Option{}
+	if cfg.MaximumStable {
+		roommatesOpts = append(roommatesOpts, libmatch.WithMaximumStableMatching())
+	}
+
+	dictatorshipOpts := []libmatch.Option{libmatch.WithSeed(cfg.Seed), libmatch.WithDraws(cfg.Draws)}
+	if cfg.PriorityFile != "" {
+		order, err := load.LoadOrderFromFile(cfg.PriorityFile)
+		if err != nil {
+			return err
+		}
+
+		dictatorshipOpts = append(dictatorshipOpts, libmatch.WithPriorityOrder(order))
+	}
+
+	probabilisticOpts := []libmatch.Option{libmatch.WithSeed(cfg.Seed)}
+	if cfg.Sample {
+		probabilisticOpts = append(probabilisticOpts, libmatch.WithSample())
+	}
+
+	assignmentOpts := []libmatch.Option{}
+	if cfg.Costs {
+		assignmentOpts = append(assignmentOpts, libmatch.WithScoresAsCosts())
+	}
+
+	schoolChoiceOpts := []libmatch.Option{
+		libmatch.WithSeed(cfg.Seed),
+		libmatch.WithLottery(libmatch.Lottery(cfg.Lottery)),
+	}
+
+	couplesOpts := []libmatch.Option{libmatch.WithSearchLimit(cfg.SearchLimit)}
+
+	lowerQuotasOpts := []libmatch.Option{}
+	if cfg.CloseUnderfilled {
+		lowerQuotasOpts = append(lowerQuotasOpts, libmatch.WithCloseUnderfilled())
+	}
+
+	var couples *[]core.CouplePreference
+	if cfg.CouplesFile != "" {
+		couples, err = load.LoadCouplesFromFile(cfg.CouplesFile)
+		if err != nil {
+			return err
+		}
+	}
+
 	switch cfg.Algorithm {
 	case "SMP":
-		result, err = libmatch.SolveSMP(prefsSet[0], prefsSet[1])
+		result, err = libmatch.SolveSMP(prefsSet[0], prefsSet[1], proposer, objective)
+	case "SMI":
+		result, err = libmatch.SolveSMP(prefsSet[0], prefsSet[1], proposer, objective, libmatch.WithIncompleteLists())
 	case "SRP":
-		result, err = libmatch.SolveSRP(prefsSet[0])
+		result, err = libmatch.SolveSRP(prefsSet[0], roommatesOpts...)
+	case "SRI":
+		result, err = libmatch.SolveSRP(prefsSet[0], append(roommatesOpts, libmatch.WithIncompleteLists())...)
+	case "HR":
+		result, err = libmatch.SolveHR(prefsSet[0], prefsSet[1], proposer)
+	case "HRI":
+		result, err = libmatch.SolveHR(prefsSet[0], prefsSet[1], proposer, libmatch.WithIncompleteLists())
+	case "HRC":
+		result, err = libmatch.SolveHRC(prefsSet[0], prefsSet[1], couples, couplesOpts...)
+	case "HRLQ":
+		result, err = libmatch.SolveHRLQ(prefsSet[0], prefsSet[1], lowerQuotasOpts...)
+	case "SPA":
+		result, err = libmatch.SolveSPA(prefsSet[0], prefsSet[1], prefsSet[2])
+	case "TTC":
+		result, err = libmatch.SolveTTC(prefsSet[0], prefsSet[1])
+	case "SD":
+		result, err = libmatch.SolveSD(prefsSet[0], prefsSet[1], dictatorshipOpts...)
+	case "RSD":
+		result, err = libmatch.SolveRSD(prefsSet[0], prefsSet[1], dictatorshipOpts...)
+	case "PS":
+		result, err = libmatch.SolvePS(prefsSet[0], prefsSet[1], probabilisticOpts...)
+	case "POP":
+		result, err = libmatch.SolvePOP(prefsSet[0], prefsSet[1])
+	case "RMM":
+		result, err = libmatch.SolveRMM(prefsSet[0], prefsSet[1])
+	case "AP":
+		result, err = libmatch.SolveAP(prefsSet[0], prefsSet[1], assignmentOpts...)
+	case "DA":
+		result, err = libmatch.SolveDA(prefsSet[0], prefsSet[1], schoolChoiceOpts...)
+	case "IA":
+		result, err = libmatch.SolveIA(prefsSet[0], prefsSet[1], schoolChoiceOpts...)
 	}
 
-	if err != nil {
+	// The last matching is still printed when no stable matching is found, so
+	// that its blocking pairs (or underfilled hospitals) can be inspected
+	if err != nil &&
+		!errors.Is(err, libmatch.ErrNoStableMatchingFound) &&
+		!errors.Is(err, libmatch.ErrLowerQuotasNotMet) {
 		return err
 	}
 
 	// Print the results in the desired output format
-	result.Print(cfg.OutputFormat)
+	if printErr := result.Print(cfg.OutputFormat); printErr != nil {
+		return printErr
+	}
 
-	return nil
+	return err
 }
 
 // validateConfig validates the configuration containing the CLI input flags
@@ -130,6 +333,68 @@ func validateConfig(cfg config.Config) error {
 		return errors.New(fmt.Sprintf("Unknown `--format` value: %v", cfg.OutputFormat))
 	}
 
+	// Verify `--proposer` value is valid
+	valid = false
+	for i := range PROPOSERS {
+		if cfg.Proposer == PROPOSERS[i] {
+			valid = true
+			break
+		}
+	}
+
+	if !(valid) {
+		return errors.New(fmt.Sprintf("Unknown `--proposer` value: %v", cfg.Proposer))
+	}
+
+	// Verify `--objective` value is valid
+	valid = false
+	for i := range OBJECTIVES {
+		if cfg.Objective == OBJECTIVES[i] {
+			valid = true
+			break
+		}
+	}
+
+	if !(valid) {
+		return errors.New(fmt.Sprintf("Unknown `--objective` value: %v", cfg.Objective))
+	}
+
+	// Verify options are only given to algorithms that use them
+	if cfg.Objective != "proposer-optimal" && cfg.Algorithm != "SMP" && cfg.Algorithm != "SMI" {
+		return errors.New(fmt.Sprintf("`--objective` is not supported by %v", cfg.Algorithm))
+	}
+
+	if cfg.MaximumStable && cfg.Algorithm != "SRP" && cfg.Algorithm != "SRI" {
+		return errors.New(fmt.Sprintf("`--maximum-stable` is not supported by %v", cfg.Algorithm))
+	}
+
+	if cfg.Costs && cfg.Algorithm != "AP" {
+		return errors.New(fmt.Sprintf("`--costs` is not supported by %v", cfg.Algorithm))
+	}
+
+	// Verify `--lottery` value is valid
+	valid = false
+	for i := range LOTTERIES {
+		if cfg.Lottery == LOTTERIES[i] {
+			valid = true
+			break
+		}
+	}
+
+	if !(valid) {
+		return errors.New(fmt.Sprintf("Unknown `--lottery` value: %v", cfg.Lottery))
+	}
+
+	// Verify `--draws` value is valid
+	if cfg.Draws < 1 {
+		return errors.New(fmt.Sprintf("Invalid `--draws` value: %v", cfg.Draws))
+	}
+
+	// Verify `--search-limit` value is valid
+	if cfg.SearchLimit < 0 {
+		return errors.New(fmt.Sprintf("Invalid `--search-limit` value: %v", cfg.SearchLimit))
+	}
+
 	return nil
 }
 
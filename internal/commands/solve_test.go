@@ -14,6 +14,7 @@ import (
 
 var testFile = "/tmp/libmatch_test.json"
 var otherFile = "/tmp/libmatch_test2.json"
+var thirdFile = "/tmp/libmatch_test3.json"
 
 func TestSolveAction(t *testing.T) {
 	t.Run("SMP", func(t *testing.T) {
@@ -45,27 +46,854 @@ func TestSolveAction(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("SMP with proposer", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"A", "preferences": ["C", "D"] },
+	    { "name":"B", "preferences": ["D", "C"] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		body = `
+	  [
+	    { "name":"C", "preferences": ["B", "A"] },
+	    { "name":"D", "preferences": ["A", "B"] }
+	  ]
+		`
+		writeToFile(otherFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "SMP", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.String("proposer", "B", "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+		err := solveAction(ctx)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("SMP with objective", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"A", "preferences": ["C", "D"] },
+	    { "name":"B", "preferences": ["D", "C"] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		body = `
+	  [
+	    { "name":"C", "preferences": ["B", "A"] },
+	    { "name":"D", "preferences": ["A", "B"] }
+	  ]
+		`
+		writeToFile(otherFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "SMP", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.String("objective", "egalitarian", "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+		err := solveAction(ctx)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("SMP with balanced objective", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"A", "preferences": ["C", "D"] },
+	    { "name":"B", "preferences": ["D", "C"] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		body = `
+	  [
+	    { "name":"C", "preferences": ["B", "A"] },
+	    { "name":"D", "preferences": ["A", "B"] }
+	  ]
+		`
+		writeToFile(otherFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "SMP", "doc")
+		globalSet.String("format", "json", "doc")
+		globalSet.String("objective", "balanced", "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+		err := solveAction(ctx)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("SMI with minimum regret objective", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"A", "preferences": ["C", "D"] },
+	    { "name":"B", "preferences": ["D"] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		body = `
+	  [
+	    { "name":"C", "preferences": ["A"] },
+	    { "name":"D", "preferences": ["A", "B"] }
+	  ]
+		`
+		writeToFile(otherFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "SMI", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.String("objective", "minimum-regret", "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+		err := solveAction(ctx)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("SMI with maximum cardinality objective", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"A", "preferences": [["C", "D"]] },
+	    { "name":"B", "preferences": ["C"] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		body = `
+	  [
+	    { "name":"C", "preferences": ["A", "B"] },
+	    { "name":"D", "preferences": ["A"] }
+	  ]
+		`
+		writeToFile(otherFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "SMI", "doc")
+		globalSet.String("format", "json", "doc")
+		globalSet.String("objective", "maximum-cardinality", "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+		err := solveAction(ctx)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("SMP with ties", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"A", "preferences": [["C", "D"]] },
+	    { "name":"B", "preferences": ["D", "C"] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		body = `
+	  [
+	    { "name":"C", "preferences": ["A", "B"] },
+	    { "name":"D", "preferences": [["B", "A"]] }
+	  ]
+		`
+		writeToFile(otherFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "SMP", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+		err := solveAction(ctx)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("SMI", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"A", "preferences": ["C"] },
+	    { "name":"B", "preferences": ["C", "D"] },
+	    { "name":"E", "preferences": [] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		body = `
+	  [
+	    { "name":"C", "preferences": ["A", "B"] },
+	    { "name":"D", "preferences": ["B"] }
+	  ]
+		`
+		writeToFile(otherFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "SMI", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+
+		err := solveAction(ctx)
+		assert.Nil(t, err)
+	})
+
 	t.Run("SRP", func(t *testing.T) {
 		body := `
 	  [
-	    { "name":"A", "preferences": ["B"] },
-	    { "name":"B", "preferences": ["A"] }
+	    { "name":"A", "preferences": ["B"] },
+	    { "name":"B", "preferences": ["A"] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "SRP", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.Var(cli.NewStringSlice(testFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+		err := solveAction(ctx)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("SRI", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"A", "preferences": ["B", "C"] },
+	    { "name":"B", "preferences": ["A"] },
+	    { "name":"C", "preferences": ["A"] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "SRI", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.Var(cli.NewStringSlice(testFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+		err := solveAction(ctx)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("HR", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"A", "preferences": ["C", "D"] },
+	    { "name":"B", "preferences": ["D", "C"] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		body = `
+	  [
+	    { "name":"C", "preferences": ["A", "B"], "capacity": 2 },
+	    { "name":"D", "preferences": ["B", "A"] }
+	  ]
+		`
+		writeToFile(otherFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "HR", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+
+		err := solveAction(ctx)
+		assert.Nil(t, err)
+	})
+
+	t.Run("HRI", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"A", "preferences": ["D"] },
+	    { "name":"B", "preferences": ["D"] },
+	    { "name":"C", "preferences": ["D"] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		body = `
+	  [
+	    { "name":"D", "preferences": ["A", "B"], "capacity": 2 }
+	  ]
+		`
+		writeToFile(otherFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "HRI", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+
+		err := solveAction(ctx)
+		assert.Nil(t, err)
+	})
+
+	t.Run("SPA", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"S1", "preferences": ["P1", "P2"] },
+	    { "name":"S2", "preferences": ["P1"] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		body = `
+	  [
+	    { "name":"P1", "owner": "L1" },
+	    { "name":"P2", "owner": "L1" }
+	  ]
+		`
+		writeToFile(otherFile, body)
+
+		body = `
+	  [
+	    { "name":"L1", "preferences": ["S2", "S1"], "capacity": 2 }
+	  ]
+		`
+		writeToFile(thirdFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "SPA", "doc")
+		globalSet.String("format", "json", "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile, thirdFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+
+		err := solveAction(ctx)
+		assert.Nil(t, err)
+	})
+
+	t.Run("TTC", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"A", "preferences": ["H2", "H1"] },
+	    { "name":"B", "preferences": ["H1", "H2"] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		body = `
+	  [
+	    { "name":"H1", "owner": "A" },
+	    { "name":"H2", "owner": "B" }
+	  ]
+		`
+		writeToFile(otherFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "TTC", "doc")
+		globalSet.String("format", "json", "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+
+		err := solveAction(ctx)
+		assert.Nil(t, err)
+	})
+
+	t.Run("SD", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"A", "preferences": ["X", "Y"] },
+	    { "name":"B", "preferences": ["X"] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		body = `
+	  [
+	    { "name":"X" },
+	    { "name":"Y" }
+	  ]
+		`
+		writeToFile(otherFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "SD", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+
+		err := solveAction(ctx)
+		assert.Nil(t, err)
+	})
+
+	t.Run("SD with priority", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"A", "preferences": ["X", "Y"] },
+	    { "name":"B", "preferences": ["X"] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		body = `
+	  [
+	    { "name":"X" },
+	    { "name":"Y" }
+	  ]
+		`
+		writeToFile(otherFile, body)
+
+		writeToFile(thirdFile, `["B", "A"]`)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "SD", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.String("priority", thirdFile, "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+
+		err := solveAction(ctx)
+		assert.Nil(t, err)
+	})
+
+	t.Run("SD with missing priority file", func(t *testing.T) {
+		_ = os.Remove(thirdFile)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "SD", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.String("priority", thirdFile, "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+
+		err := solveAction(ctx)
+
+		if assert.NotNil(t, err) {
+			assert.Equal(t,
+				fmt.Sprintf("open %v: no such file or directory", thirdFile), err.Error())
+		}
+	})
+
+	t.Run("POP", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"A1", "preferences": ["H1", "H2"] },
+	    { "name":"A2", "preferences": [["H1", "H2"]] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		body = `
+	  [
+	    { "name":"H1" },
+	    { "name":"H2" }
+	  ]
+		`
+		writeToFile(otherFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "POP", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+
+		err := solveAction(ctx)
+		assert.Nil(t, err)
+	})
+
+	t.Run("RMM", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"A1", "preferences": ["H1", "H2"] },
+	    { "name":"A2", "preferences": [["H1", "H2"]] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		body = `
+	  [
+	    { "name":"H1", "capacity": 2 },
+	    { "name":"H2" }
+	  ]
+		`
+		writeToFile(otherFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "RMM", "doc")
+		globalSet.String("format", "json", "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+
+		err := solveAction(ctx)
+		assert.Nil(t, err)
+	})
+
+	t.Run("AP", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"A", "preferences": ["X", "Y"], "scores": [3, 1] },
+	    { "name":"B", "preferences": ["X", "Y"], "scores": [2, 5] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		body = `
+	  [
+	    { "name":"X", "preferences": ["A", "B"] },
+	    { "name":"Y", "preferences": ["A", "B"] }
+	  ]
+		`
+		writeToFile(otherFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "AP", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.Bool("costs", true, "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+
+		err := solveAction(ctx)
+		assert.Nil(t, err)
+	})
+
+	t.Run("DA with multiple lotteries", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"S1", "preferences": ["X", "Y"] },
+	    { "name":"S2", "preferences": ["X", "Y"] },
+	    { "name":"S3", "preferences": ["Y"] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		body = `
+	  [
+	    { "name":"X", "preferences": [["S1", "S2"], "S3"] },
+	    { "name":"Y", "preferences": [["S1", "S2", "S3"]], "capacity": 2 }
+	  ]
+		`
+		writeToFile(otherFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "DA", "doc")
+		globalSet.String("format", "json", "doc")
+		globalSet.Int64("seed", 7, "doc")
+		globalSet.String("lottery", "multiple", "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+
+		err := solveAction(ctx)
+		assert.Nil(t, err)
+	})
+
+	t.Run("IA", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"S1", "preferences": ["X", "Y"] },
+	    { "name":"S2", "preferences": ["Y", "X"] },
+	    { "name":"S3", "preferences": ["Y", "X"] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		body = `
+	  [
+	    { "name":"X", "preferences": ["S2", ["S1", "S3"]] },
+	    { "name":"Y", "preferences": [["S1", "S2", "S3"]] }
+	  ]
+		`
+		writeToFile(otherFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "IA", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.Int64("seed", 3, "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+
+		err := solveAction(ctx)
+		assert.Nil(t, err)
+	})
+
+	t.Run("HRC", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"R1", "preferences": ["H2", "H3"] },
+	    { "name":"R2", "preferences": ["H1"] },
+	    { "name":"R3", "preferences": [] },
+	    { "name":"R4", "preferences": [] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		body = `
+	  [
+	    { "name":"H1", "preferences": ["R2", "R3"] },
+	    { "name":"H2", "preferences": ["R4", "R1"] },
+	    { "name":"H3", "preferences": ["R3", "R4", "R1"], "capacity": 2 }
+	  ]
+		`
+		writeToFile(otherFile, body)
+
+		body = `
+	  [
+	    { "name":"C1", "members": ["R3", "R4"], "preferences": [["H1", "H2"], ["H3", "H3"]] }
+	  ]
+		`
+		writeToFile(thirdFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "HRC", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.String("couples", thirdFile, "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+
+		err := solveAction(ctx)
+		assert.Nil(t, err)
+	})
+
+	t.Run("HRC with no stable matching", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"R1", "preferences": [] },
+	    { "name":"R2", "preferences": [] },
+	    { "name":"R3", "preferences": ["H1", "H2"] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		body = `
+	  [
+	    { "name":"H1", "preferences": ["R1", "R3"] },
+	    { "name":"H2", "preferences": ["R3", "R2"] }
+	  ]
+		`
+		writeToFile(otherFile, body)
+
+		body = `
+	  [
+	    { "name":"C1", "members": ["R1", "R2"], "preferences": [["H1", "H2"]] }
+	  ]
+		`
+		writeToFile(thirdFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "HRC", "doc")
+		globalSet.String("format", "json", "doc")
+		globalSet.String("couples", thirdFile, "doc")
+		globalSet.Int("search-limit", 20, "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+
+		err := solveAction(ctx)
+
+		if assert.NotNil(t, err) {
+			assert.Equal(t, "No stable matching found within the search limit", err.Error())
+		}
+	})
+
+	t.Run("HRLQ", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"R1", "preferences": ["H1"] },
+	    { "name":"R2", "preferences": ["H2", "H3"] },
+	    { "name":"R3", "preferences": ["H3"] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		body = `
+	  [
+	    { "name":"H1", "preferences": ["R1"] },
+	    { "name":"H2", "preferences": ["R2"], "capacity": 3, "lower_quota": 3 },
+	    { "name":"H3", "preferences": ["R3", "R2"], "capacity": 2, "lower_quota": 2 }
+	  ]
+		`
+		writeToFile(otherFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "HRLQ", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.Bool("close-underfilled", true, "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+
+		err := solveAction(ctx)
+		assert.Nil(t, err)
+	})
+
+	t.Run("HRLQ with lower quotas not met", func(t *testing.T) {
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "HRLQ", "doc")
+		globalSet.String("format", "json", "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+
+		err := solveAction(ctx)
+
+		if assert.NotNil(t, err) {
+			assert.Equal(t, "No stable matching meets every lower quota", err.Error())
+		}
+	})
+
+	t.Run("HRC with missing couples file", func(t *testing.T) {
+		_ = os.Remove(thirdFile)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "HRC", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.String("couples", thirdFile, "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+
+		err := solveAction(ctx)
+
+		if assert.NotNil(t, err) {
+			assert.Equal(t,
+				fmt.Sprintf("open %v: no such file or directory", thirdFile), err.Error())
+		}
+	})
+
+	t.Run("RSD with draws", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"A", "preferences": ["X", "Y"] },
+	    { "name":"B", "preferences": ["X"] }
 	  ]
 		`
 		writeToFile(testFile, body)
 
+		body = `
+	  [
+	    { "name":"X" },
+	    { "name":"Y" }
+	  ]
+		`
+		writeToFile(otherFile, body)
+
 		globalSet := flag.NewFlagSet("test", 0)
-		globalSet.String("algorithm", "SRP", "doc")
+		globalSet.String("algorithm", "RSD", "doc")
 		globalSet.String("format", "csv", "doc")
-		globalSet.Var(cli.NewStringSlice(testFile), "file", "doc")
+		globalSet.Int64("seed", 42, "doc")
+		globalSet.Int("draws", 100, "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+
+		err := solveAction(ctx)
+		assert.Nil(t, err)
+	})
+
+	t.Run("PS", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"A", "preferences": ["X", "Y"] },
+	    { "name":"B", "preferences": ["X"] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		body = `
+	  [
+	    { "name":"X" },
+	    { "name":"Y" }
+	  ]
+		`
+		writeToFile(otherFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "PS", "doc")
+		globalSet.String("format", "matrix", "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
 
 		app := cli.NewApp()
 		ctx := cli.NewContext(app, globalSet, nil)
+
 		err := solveAction(ctx)
+		assert.Nil(t, err)
+	})
+
+	t.Run("PS with sample", func(t *testing.T) {
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "PS", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.Int64("seed", 42, "doc")
+		globalSet.Bool("sample", true, "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
 
+		err := solveAction(ctx)
 		assert.Nil(t, err)
 	})
 
+	t.Run("matrix format without probabilities", func(t *testing.T) {
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "SD", "doc")
+		globalSet.String("format", "matrix", "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+
+		err := solveAction(ctx)
+
+		if assert.NotNil(t, err) {
+			assert.Equal(t, "The matrix format requires a result with probabilities", err.Error())
+		}
+	})
+
 	t.Run("error creating Config", func(t *testing.T) {
 		// Force a config error by specifying an invalid file
 		_ = os.Remove(testFile)
@@ -136,6 +964,57 @@ func TestSolveAction(t *testing.T) {
 			assert.Equal(t, "No stable solution exists", err.Error())
 		}
 	})
+
+	t.Run("SRP with maximum stable matching", func(t *testing.T) {
+		body := `
+	  [
+	    {"name":"A", "preferences": ["B", "E", "C", "F", "D"] },
+	    {"name":"B", "preferences": ["C", "F", "E", "A", "D"] },
+	    {"name":"C", "preferences": ["E", "A", "F", "D", "B"] },
+	    {"name":"D", "preferences": ["B", "A", "C", "F", "E"] },
+	    {"name":"E", "preferences": ["A", "C", "D", "B", "F"] },
+	    {"name":"F", "preferences": ["C", "A", "E", "B", "D"] }
+	  ]
+		`
+
+		writeToFile(testFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "SRP", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.Bool("maximum-stable", true, "doc")
+		globalSet.Var(cli.NewStringSlice(testFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+		err := solveAction(ctx)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("SRP with capacities", func(t *testing.T) {
+		body := `
+	  [
+	    {"name":"A", "preferences": ["B", "C", "D"], "capacity": 2 },
+	    {"name":"B", "preferences": ["C", "D", "A"], "capacity": 2 },
+	    {"name":"C", "preferences": ["D", "A", "B"], "capacity": 2 },
+	    {"name":"D", "preferences": ["A", "B", "C"], "capacity": 2 }
+	  ]
+		`
+
+		writeToFile(testFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "SRP", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.Var(cli.NewStringSlice(testFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+		err := solveAction(ctx)
+
+		assert.Nil(t, err)
+	})
 }
 
 func TestValidateConfig(t *testing.T) {
@@ -239,6 +1118,167 @@ func TestValidateConfig(t *testing.T) {
 			assert.Equal(t, "Unknown `--format` value: INVALID_VALUE", err.Error())
 		}
 	})
+
+	t.Run("invalid proposer", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"A", "preferences": ["B"] },
+	    { "name":"B", "preferences": ["A"] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "srp", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.String("proposer", "INVALID_VALUE", "doc")
+		globalSet.Bool("debug", false, "doc")
+		globalSet.Var(cli.NewStringSlice(testFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+		cfg, err := config.NewConfig(ctx)
+		err = validateConfig(*cfg)
+
+		if assert.NotNil(t, err) {
+			assert.Equal(t, "Unknown `--proposer` value: invalid_value", err.Error())
+		}
+	})
+
+	t.Run("invalid objective", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"A", "preferences": ["B"] },
+	    { "name":"B", "preferences": ["A"] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "srp", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.String("objective", "INVALID_VALUE", "doc")
+		globalSet.Bool("debug", false, "doc")
+		globalSet.Var(cli.NewStringSlice(testFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+		cfg, err := config.NewConfig(ctx)
+		err = validateConfig(*cfg)
+
+		if assert.NotNil(t, err) {
+			assert.Equal(t, "Unknown `--objective` value: invalid_value", err.Error())
+		}
+	})
+
+	t.Run("invalid draws", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"A", "preferences": ["X"] }
+	  ]
+		`
+		writeToFile(testFile, body)
+
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "rsd", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.Int("draws", -1, "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+		cfg, err := config.NewConfig(ctx)
+		err = validateConfig(*cfg)
+
+		if assert.NotNil(t, err) {
+			assert.Equal(t, "Invalid `--draws` value: -1", err.Error())
+		}
+	})
+
+	t.Run("invalid lottery", func(t *testing.T) {
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "da", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.String("lottery", "weekly", "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+		cfg, err := config.NewConfig(ctx)
+		err = validateConfig(*cfg)
+
+		if assert.NotNil(t, err) {
+			assert.Equal(t, "Unknown `--lottery` value: weekly", err.Error())
+		}
+	})
+
+	t.Run("invalid search limit", func(t *testing.T) {
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "hrc", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.Int("search-limit", -5, "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+		cfg, err := config.NewConfig(ctx)
+		err = validateConfig(*cfg)
+
+		if assert.NotNil(t, err) {
+			assert.Equal(t, "Invalid `--search-limit` value: -5", err.Error())
+		}
+	})
+
+	t.Run("unsupported objective", func(t *testing.T) {
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "sd", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.String("objective", "egalitarian", "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+		cfg, err := config.NewConfig(ctx)
+		err = validateConfig(*cfg)
+
+		if assert.NotNil(t, err) {
+			assert.Equal(t, "`--objective` is not supported by SD", err.Error())
+		}
+	})
+
+	t.Run("unsupported maximum stable", func(t *testing.T) {
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "smp", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.Bool("maximum-stable", true, "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+		cfg, err := config.NewConfig(ctx)
+		err = validateConfig(*cfg)
+
+		if assert.NotNil(t, err) {
+			assert.Equal(t, "`--maximum-stable` is not supported by SMP", err.Error())
+		}
+	})
+
+	t.Run("unsupported costs", func(t *testing.T) {
+		globalSet := flag.NewFlagSet("test", 0)
+		globalSet.String("algorithm", "hr", "doc")
+		globalSet.String("format", "csv", "doc")
+		globalSet.Bool("costs", true, "doc")
+		globalSet.Var(cli.NewStringSlice(testFile, otherFile), "file", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, globalSet, nil)
+		cfg, err := config.NewConfig(ctx)
+		err = validateConfig(*cfg)
+
+		if assert.NotNil(t, err) {
+			assert.Equal(t, "`--costs` is not supported by HR", err.Error())
+		}
+	})
 }
 
 func writeToFile(filename, body string) {
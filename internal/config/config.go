@@ -11,20 +11,80 @@ import (
 
 // Config defines the structure of the internal libmatch configuration
 type Config struct {
-	Algorithm    string
-	Debug        bool
-	Filenames    []string
-	OutputFormat string
-	CliContext   *cli.Context
+	Algorithm        string
+	Debug            bool
+	Filenames        []string
+	OutputFormat     string
+	Proposer         string
+	Objective        string
+	MaximumStable    bool
+	PriorityFile     string
+	Seed             int64
+	Draws            int
+	Sample           bool
+	Costs            bool
+	Lottery          string
+	CouplesFile      string
+	SearchLimit      int
+	CloseUnderfilled bool
+	CliContext       *cli.Context
 }
 
 // NewConfig returns a new Config structure
 func NewConfig(ctx *cli.Context) (*Config, error) {
 	cfg := &Config{
-		Algorithm:    strings.ToUpper(ctx.String("algorithm")),
-		Debug:        ctx.Bool("debug"),
-		OutputFormat: ctx.String("format"),
-		CliContext:   ctx,
+		Algorithm:        strings.ToUpper(ctx.String("algorithm")),
+		Debug:            ctx.Bool("debug"),
+		OutputFormat:     ctx.String("format"),
+		Proposer:         strings.ToLower(ctx.String("proposer")),
+		Objective:        strings.ToLower(ctx.String("objective")),
+		MaximumStable:    ctx.Bool("maximum-stable"),
+		Seed:             ctx.Int64("seed"),
+		Draws:            ctx.Int("draws"),
+		Sample:           ctx.Bool("sample"),
+		Costs:            ctx.Bool("costs"),
+		Lottery:          strings.ToLower(ctx.String("lottery")),
+		SearchLimit:      ctx.Int("search-limit"),
+		CloseUnderfilled: ctx.Bool("close-underfilled"),
+		CliContext:       ctx,
+	}
+
+	// The first table proposes by default
+	if cfg.Proposer == "" {
+		cfg.Proposer = "a"
+	}
+
+	// The proposer-optimal matching is returned by default
+	if cfg.Objective == "" {
+		cfg.Objective = "proposer-optimal"
+	}
+
+	// A single lottery is drawn by default
+	if cfg.Lottery == "" {
+		cfg.Lottery = "single"
+	}
+
+	// A single random order is drawn by default
+	if cfg.Draws == 0 {
+		cfg.Draws = 1
+	}
+
+	// Expand path of the `priority` flag
+	if priority := ctx.String("priority"); priority != "" {
+		expandedFiles, err := expandFilenames([]string{priority})
+		if err != nil {
+			return cfg, err
+		}
+		cfg.PriorityFile = expandedFiles[0]
+	}
+
+	// Expand path of the `couples` flag
+	if couples := ctx.String("couples"); couples != "" {
+		expandedFiles, err := expandFilenames([]string{couples})
+		if err != nil {
+			return cfg, err
+		}
+		cfg.CouplesFile = expandedFiles[0]
 	}
 
 	// Expand path of each `file` flag
@@ -27,10 +27,108 @@ func TestNewConfig(t *testing.T) {
 		assert.Equal(t, "SRP", cfg.Algorithm)
 		assert.Equal(t, false, cfg.Debug)
 		assert.Equal(t, "csv", cfg.OutputFormat)
+		assert.Equal(t, "a", cfg.Proposer)
+		assert.Equal(t, "proposer-optimal", cfg.Objective)
+		assert.Equal(t, false, cfg.MaximumStable)
+		assert.Equal(t, "", cfg.PriorityFile)
+		assert.Equal(t, int64(0), cfg.Seed)
+		assert.Equal(t, 1, cfg.Draws)
+		assert.Equal(t, false, cfg.Sample)
+		assert.Equal(t, false, cfg.Costs)
+		assert.Equal(t, "single", cfg.Lottery)
+		assert.Equal(t, "", cfg.CouplesFile)
+		assert.Equal(t, 0, cfg.SearchLimit)
+		assert.Equal(t, false, cfg.CloseUnderfilled)
 		assert.Equal(t, ctx, cfg.CliContext)
 		assert.Equal(t, []string{"/tmp/test.json"}, cfg.Filenames)
 	})
 
+	t.Run("serial dictatorship flags", func(t *testing.T) {
+		flagSet := flag.NewFlagSet("test", 0)
+		flagSet.String("priority", "priority.json", "doc")
+		flagSet.Int64("seed", 42, "doc")
+		flagSet.Int("draws", 100, "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, flagSet, nil)
+		cfg, err := NewConfig(ctx)
+
+		assert.Nil(t, err)
+
+		absPath, _ := filepath.Abs("priority.json")
+
+		assert.Equal(t, absPath, cfg.PriorityFile)
+		assert.Equal(t, int64(42), cfg.Seed)
+		assert.Equal(t, 100, cfg.Draws)
+	})
+
+	t.Run("probabilistic serial flags", func(t *testing.T) {
+		flagSet := flag.NewFlagSet("test", 0)
+		flagSet.Int64("seed", 7, "doc")
+		flagSet.Bool("sample", true, "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, flagSet, nil)
+		cfg, err := NewConfig(ctx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(7), cfg.Seed)
+		assert.Equal(t, true, cfg.Sample)
+	})
+
+	t.Run("assignment flags", func(t *testing.T) {
+		flagSet := flag.NewFlagSet("test", 0)
+		flagSet.Bool("costs", true, "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, flagSet, nil)
+		cfg, err := NewConfig(ctx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, true, cfg.Costs)
+	})
+
+	t.Run("school choice flags", func(t *testing.T) {
+		flagSet := flag.NewFlagSet("test", 0)
+		flagSet.String("lottery", "Multiple", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, flagSet, nil)
+		cfg, err := NewConfig(ctx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "multiple", cfg.Lottery)
+	})
+
+	t.Run("couples flags", func(t *testing.T) {
+		flagSet := flag.NewFlagSet("test", 0)
+		flagSet.String("couples", "couples.json", "doc")
+		flagSet.Int("search-limit", 500, "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, flagSet, nil)
+		cfg, err := NewConfig(ctx)
+
+		assert.Nil(t, err)
+
+		absPath, _ := filepath.Abs("couples.json")
+
+		assert.Equal(t, absPath, cfg.CouplesFile)
+		assert.Equal(t, 500, cfg.SearchLimit)
+	})
+
+	t.Run("lower quotas flags", func(t *testing.T) {
+		flagSet := flag.NewFlagSet("test", 0)
+		flagSet.Bool("close-underfilled", true, "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, flagSet, nil)
+		cfg, err := NewConfig(ctx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, true, cfg.CloseUnderfilled)
+	})
+
 	t.Run("`algorithm` flag is case insensitive", func(t *testing.T) {
 		flagSet := flag.NewFlagSet("test", 0)
 		flagSet.String("algorithm", "sRp", "doc")
@@ -44,6 +142,34 @@ func TestNewConfig(t *testing.T) {
 		assert.IsType(t, new(Config), cfg)
 		assert.Equal(t, "SRP", cfg.Algorithm)
 	})
+
+	t.Run("`proposer` flag is case insensitive", func(t *testing.T) {
+		flagSet := flag.NewFlagSet("test", 0)
+		flagSet.String("proposer", "B", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, flagSet, nil)
+		cfg, err := NewConfig(ctx)
+
+		assert.Nil(t, err)
+
+		assert.IsType(t, new(Config), cfg)
+		assert.Equal(t, "b", cfg.Proposer)
+	})
+
+	t.Run("`objective` flag is case insensitive", func(t *testing.T) {
+		flagSet := flag.NewFlagSet("test", 0)
+		flagSet.String("objective", "Egalitarian", "doc")
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, flagSet, nil)
+		cfg, err := NewConfig(ctx)
+
+		assert.Nil(t, err)
+
+		assert.IsType(t, new(Config), cfg)
+		assert.Equal(t, "egalitarian", cfg.Objective)
+	})
 }
 
 func TestExpandFilenames(t *testing.T) {
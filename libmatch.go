@@ -21,15 +21,36 @@ package libmatch
 import (
 	"io"
 
+	"github.com/abhchand/libmatch/pkg/algo/ap"
+	"github.com/abhchand/libmatch/pkg/algo/da"
+	"github.com/abhchand/libmatch/pkg/algo/hr"
+	"github.com/abhchand/libmatch/pkg/algo/hrc"
+	"github.com/abhchand/libmatch/pkg/algo/hrlq"
+	"github.com/abhchand/libmatch/pkg/algo/ia"
+	"github.com/abhchand/libmatch/pkg/algo/pop"
+	"github.com/abhchand/libmatch/pkg/algo/ps"
+	"github.com/abhchand/libmatch/pkg/algo/rmm"
+	"github.com/abhchand/libmatch/pkg/algo/sd"
 	"github.com/abhchand/libmatch/pkg/algo/smp"
+	"github.com/abhchand/libmatch/pkg/algo/spa"
 	"github.com/abhchand/libmatch/pkg/algo/srp"
+	"github.com/abhchand/libmatch/pkg/algo/ttc"
 	"github.com/abhchand/libmatch/pkg/core"
 	"github.com/abhchand/libmatch/pkg/load"
 	"github.com/abhchand/libmatch/pkg/validate"
 )
 
 type MatchPreference = core.MatchPreference
+type CouplePreference = core.CouplePreference
 type MatchResult = core.MatchResult
+type WeightedMatching = core.WeightedMatching
+
+var (
+	ErrNoStronglyStableSolution = smp.ErrNoStronglyStableSolution
+	ErrNoSuperStableSolution    = smp.ErrNoSuperStableSolution
+	ErrNoStableMatchingFound    = hrc.ErrNoStableMatchingFound
+	ErrLowerQuotasNotMet        = hrlq.ErrLowerQuotasNotMet
+)
 
 // Load reads match preference data from an `io.Reader`.
 //
@@ -64,6 +85,26 @@ func Load(r io.Reader) (*[]MatchPreference, error) {
 	return mp, err
 }
 
+// LoadCouples reads the joint preferences of couples from an `io.Reader`.
+//
+// The expected data is a JSON formatted list of the format:
+//
+// 		[
+// 		  { "name":"C1", "members": ["R1", "R2"], "preferences": [["H1", "H1"], ["H1", "H2"]] },
+// 		  { "name":"C2", "members": ["R3", "R4"], "preferences": [["H2", "H3"]] }
+// 		]
+//
+// The return value is an array of `CouplePreference` structs containing the
+// loaded JSON data
+//
+// 		*[]libmatch.CouplePreference{
+// 		  {Name: "C1", Members: []string{"R1", "R2"}, Preferences: [][]string{{"H1", "H1"}, {"H1", "H2"}}},
+// 		  {Name: "C2", Members: []string{"R3", "R4"}, Preferences: [][]string{{"H2", "H3"}}},
+// 		}
+func LoadCouples(r io.Reader) (*[]CouplePreference, error) {
+	return load.LoadCouplesFromIO(r)
+}
+
 // SolveSMP solves the Stable Marriage Problem for a set of preferences.
 //
 // See: https://en.wikipedia.org/wiki/Stable_marriage_problem
@@ -110,28 +151,142 @@ func Load(r io.Reader) (*[]MatchPreference, error) {
 // 				"J": "D",
 // 			},
 // 		}
-func SolveSMP(prefsA, prefsB *[]MatchPreference) (MatchResult, error) {
+//
+// Proposing Side:
+//
+// By default members of the first table propose, and the matching is optimal
+// for them. Use `WithProposer(SideB)` to let members of the second table
+// propose instead. The result's `OptimalSide` records which side the matching
+// is optimal for.
+//
+// 		result, err := libmatch.SolveSMP(&prefTableA, &prefTableB, libmatch.WithProposer(libmatch.SideB))
+//
+// Objectives:
+//
+// The proposer-optimal matching can be visibly unfair to the other side. Use
+// `WithObjective(ObjectiveEgalitarian)` to find the stable matching that
+// minimises the sum of the ranks that all members give their partners,
+// `WithObjective(ObjectiveMinimumRegret)` to minimise the worst rank that any
+// member gives their partner, or `WithPairCost()` to minimise the total of a
+// custom cost over matched pairs.
+//
+// `WithObjective(ObjectiveSexEqual)` and `WithObjective(ObjectiveBalanced)`
+// search for the stable matching that minimises the difference between (or the
+// larger of) the total rank costs of both sides, using a heuristic.
+// `WithObjective(ObjectiveMedian)` matches each member with their median
+// partner across all stable matchings, which requires enumerating them. The
+// result's `Costs` field records the total rank cost of each side, with or
+// without an objective, so that the outcome for both sides can be compared.
+//
+// 		result, err := libmatch.SolveSMP(&prefTableA, &prefTableB, libmatch.WithObjective(libmatch.ObjectiveEgalitarian))
+//
+// Incomplete Lists:
+//
+// Use `WithIncompleteLists()` to solve the "Stable Marriage Problem with
+// Incomplete Lists" (SMI), where members may omit unacceptable members from
+// their preference lists and the tables may be of different sizes. Members
+// left without a match are listed in the result's `Unmatched` field.
+//
+// 		result, err := libmatch.SolveSMP(&prefTableA, &prefTableB, libmatch.WithIncompleteLists())
+//
+// Ties:
+//
+// Members may rank several members of the other table equally by specifying
+// `RankedPreferences` (the "Stable Marriage Problem with Ties", or SMT). Ties
+// are broken with `TieBreakByOrder` unless another policy is specified with
+// `WithTieBreaker()`, and the resulting matching is weakly stable.
+//
+// 		prefTableA := []libmatch.MatchPreference{
+// 			{Name: "A", RankedPreferences: [][]string{{"F", "J"}, {"H", "G", "I"}}},
+// 			...
+// 		}
+//
+// 		result, err := libmatch.SolveSMP(&prefTableA, &prefTableB, libmatch.WithTieBreaker(libmatch.TieBreakRandom(42)))
+//
+// Use `WithStability()` to require a strongly stable (`StabilityStrong`) or
+// super-stable (`StabilitySuper`) matching instead. Such a matching may not
+// exist, in which case `ErrNoStronglyStableSolution` or
+// `ErrNoSuperStableSolution` is returned.
+//
+// 		result, err := libmatch.SolveSMP(&prefTableA, &prefTableB, libmatch.WithStability(libmatch.StabilityStrong))
+//
+// Maximum Cardinality:
+//
+// When preference lists contain ties and are incomplete (SMTI), weakly stable
+// matchings may differ in size, and finding the largest is NP-hard. Use
+// `WithObjective(ObjectiveMaximumCardinality)` to approximate it with Király's
+// (2013) 3/2-approximation algorithm. The result's `Size` records the number
+// of matched pairs, and `SizeUpperBound` the size of a maximum matching of
+// all acceptable pairs, which no weakly stable matching can exceed.
+//
+// 		result, err := libmatch.SolveSMP(&prefTableA, &prefTableB, libmatch.WithIncompleteLists(), libmatch.WithObjective(libmatch.ObjectiveMaximumCardinality))
+func SolveSMP(prefsA, prefsB *[]MatchPreference, opts ...Option) (MatchResult, error) {
 	var res MatchResult
 	var err error
 
 	tables := core.NewPreferenceTablePair(prefsA, prefsB)
+
+	algoCtx := core.AlgorithmContext{
+		TableA: &tables[0],
+		TableB: &tables[1],
+	}
+	applyOptions(&algoCtx, opts)
+
 	validator := validate.DoubleTableValidator{
-		PrefsSet: []*[]core.MatchPreference{prefsA, prefsB},
-		Tables:   []*core.PreferenceTable{&tables[0], &tables[1]},
+		PrefsSet:        []*[]core.MatchPreference{prefsA, prefsB},
+		Tables:          []*core.PreferenceTable{&tables[0], &tables[1]},
+		IncompleteLists: algoCtx.IncompleteLists,
+		Ties:            true,
 	}
 
 	if err = validator.Validate(); err != nil {
 		return res, err
 	}
 
+	res, err = smp.Run(algoCtx)
+
+	return res, err
+}
+
+// EnumerateSMP lists every stable matching of a Stable Marriage Problem, using
+// the rotation poset described by Gusfield and Irving (1989).
+//
+// It accepts the same preference tables and options as `SolveSMP`, and calls
+// `visit` with each stable matching in turn. Matchings are generated one at a
+// time, so instances with very many stable matchings do not need to fit in
+// memory. Return false from `visit` to stop the enumeration early.
+//
+// The first matching is optimal for the proposing side (see `WithProposer()`)
+// and the last is optimal for the other side. Ties are broken as in
+// `SolveSMP`, and only weakly stable matchings can be enumerated.
+//
+// Example:
+//
+// 		err := libmatch.EnumerateSMP(&prefTableA, &prefTableB, func(result libmatch.MatchResult) bool {
+// 			fmt.Println(result.Mapping)
+// 			return true
+// 		})
+func EnumerateSMP(prefsA, prefsB *[]MatchPreference, visit func(MatchResult) bool, opts ...Option) error {
+	tables := core.NewPreferenceTablePair(prefsA, prefsB)
+
 	algoCtx := core.AlgorithmContext{
 		TableA: &tables[0],
 		TableB: &tables[1],
 	}
+	applyOptions(&algoCtx, opts)
 
-	res, err = smp.Run(algoCtx)
+	validator := validate.DoubleTableValidator{
+		PrefsSet:        []*[]core.MatchPreference{prefsA, prefsB},
+		Tables:          []*core.PreferenceTable{&tables[0], &tables[1]},
+		IncompleteLists: algoCtx.IncompleteLists,
+		Ties:            true,
+	}
 
-	return res, err
+	if err := validator.Validate(); err != nil {
+		return err
+	}
+
+	return smp.Enumerate(algoCtx, visit)
 }
 
 // SolveSRP solves the Stable Roommates Problem for a set of preferences.
@@ -169,22 +324,1134 @@ func SolveSMP(prefsA, prefsB *[]MatchPreference) (MatchResult, error) {
 // 				"F": "A",
 // 			},
 // 		}
-func SolveSRP(prefs *[]MatchPreference) (MatchResult, error) {
+//
+// Incomplete Lists:
+//
+// Use `WithIncompleteLists()` to solve the "Stable Roommates Problem with
+// Incomplete Lists" (SRI), where members may omit unacceptable members from
+// their preference lists and the table may be of odd size. Members left
+// without a match are listed in the result's `Unmatched` field.
+//
+// 		result, err := libmatch.SolveSRP(&prefTable, libmatch.WithIncompleteLists())
+//
+// Maximum Stable Matching:
+//
+// Use `WithMaximumStableMatching()` to return a maximum stable matching when no
+// stable matching exists, instead of an error. Implements Tan's (1991) stable
+// partition algorithm. One member of each "odd party" of the stable partition
+// is listed in the result's `Unmatched` field, which is the fewest members
+// that can be left out. No two matched members prefer each other to their
+// partners. When a stable matching exists, it is returned as usual.
+//
+// 		result, err := libmatch.SolveSRP(&prefTable, libmatch.WithMaximumStableMatching())
+//
+// Capacities:
+//
+// Give members a `Capacity` greater than 1 to solve the "Stable Fixtures
+// Problem" (SF), where each member may be matched with as many other members
+// as their capacity. Implements the Irving and Scott (2007) algorithm. The
+// table may be of any size, and the result's `Assignments` field lists the
+// members each member is matched with, in their order of preference.
+//
+// 		prefTable := []MatchPreference{
+// 		  {Name: "A", Preferences: []string{"B", "C", "D"}, Capacity: 2},
+// 		  {Name: "B", Preferences: []string{"C", "D", "A"}, Capacity: 2},
+// 		  {Name: "C", Preferences: []string{"D", "A", "B"}, Capacity: 2},
+// 		  {Name: "D", Preferences: []string{"A", "B", "C"}, Capacity: 2},
+// 		}
+//
+// 		MatchResult{
+// 			Mapping: map[string]string{},
+// 			Assignments: map[string][]string{
+// 				"A": {"C", "D"},
+// 				"B": {"C", "D"},
+// 				"C": {"A", "B"},
+// 				"D": {"A", "B"},
+// 			},
+// 		}
+func SolveSRP(prefs *[]MatchPreference, opts ...Option) (MatchResult, error) {
 	var res MatchResult
 	var err error
 
 	table := core.NewPreferenceTable(prefs)
-	validator := validate.SingleTableValidator{Prefs: prefs, Table: &table}
+
+	algoCtx := core.AlgorithmContext{
+		TableA: &table,
+	}
+	applyOptions(&algoCtx, opts)
+
+	validator := validate.SingleTableValidator{
+		Prefs:           prefs,
+		Table:           &table,
+		Capacitated:     true,
+		IncompleteLists: algoCtx.IncompleteLists,
+	}
 
 	if err = validator.Validate(); err != nil {
 		return res, err
 	}
 
+	res, err = srp.Run(algoCtx)
+
+	return res, err
+}
+
+// SolveHR solves the Hospitals/Residents Problem for a set of preferences.
+//
+// See: https://en.wikipedia.org/wiki/National_Resident_Matching_Program
+//
+// The algorithm finds a stable many-to-one matching between a set of residents
+// and a set of hospitals, where each hospital can accept as many residents as
+// its capacity. Implements the deferred acceptance algorithm of Gale-Shapley
+// (1962), which always finds a stable matching.
+//
+// By default residents propose, which yields the unique resident-optimal
+// matching.
+// Use `WithProposer(SideB)` to let hospitals propose and find the
+// hospital-optimal matching instead.
+//
+// Residents who do not get a place are listed in `Unmatched`, such as when
+// there are more residents than places. Use `WithIncompleteLists()` to let
+// residents and hospitals only rank the members they find acceptable.
+//
+// Example:
+//
+// SolveHR takes a pair of preference tables as inputs. The first table lists
+// the residents and the second lists the hospitals, each with an optional
+// capacity (defaults to 1).
+//
+// 		residents := []libmatch.MatchPreference{
+// 			{Name: "R1", Preferences: []string{"H1", "H2"}},
+// 			{Name: "R2", Preferences: []string{"H1", "H2"}},
+// 			{Name: "R3", Preferences: []string{"H2", "H1"}},
+// 		}
+//
+// 		hospitals := []libmatch.MatchPreference{
+// 			{Name: "H1", Preferences: []string{"R3", "R2", "R1"}, Capacity: 2},
+// 			{Name: "H2", Preferences: []string{"R1", "R2", "R3"}, Capacity: 1},
+// 		}
+//
+// On success, the return value will be a MatchResult containing the hospital
+// of each resident, as well as the residents assigned to each hospital in the
+// hospital's order of preference.
+//
+// 		MatchResult{
+// 			Mapping: map[string]string{
+// 				"R1": "H1",
+// 				"R2": "H1",
+// 				"R3": "H2",
+// 			},
+// 			Assignments: map[string][]string{
+// 				"H1": []string{"R2", "R1"},
+// 				"H2": []string{"R3"},
+// 			},
+// 		}
+func SolveHR(residents, hospitals *[]MatchPreference, opts ...Option) (MatchResult, error) {
+	var res MatchResult
+	var err error
+
+	tables := core.NewPreferenceTablePair(residents, hospitals)
+
 	algoCtx := core.AlgorithmContext{
-		TableA: &table,
+		TableA: &tables[0],
+		TableB: &tables[1],
 	}
+	applyOptions(&algoCtx, opts)
 
-	res, err = srp.Run(algoCtx)
+	validator := validate.DoubleTableValidator{
+		PrefsSet:        []*[]core.MatchPreference{residents, hospitals},
+		Tables:          []*core.PreferenceTable{&tables[0], &tables[1]},
+		Capacitated:     true,
+		IncompleteLists: algoCtx.IncompleteLists,
+	}
+
+	if err = validator.Validate(); err != nil {
+		return res, err
+	}
+
+	res, err = hr.Run(algoCtx)
+
+	return res, err
+}
+
+// SolveHRC solves the Hospitals/Residents Problem with Couples for a set of
+// preferences.
+//
+// See: Roth and Peranson (1999) "The Redesign of the Matching Market for
+// American Physicians"
+//
+// The algorithm finds a stable many-to-one matching between a set of residents
+// and a set of hospitals, where some residents apply together as couples with
+// a joint preference list over pairs of hospitals. Residents and hospitals
+// rank the members they find acceptable, so some residents may remain
+// unmatched. Implements a heuristic in the style of Roth-Peranson, which is
+// deterministic.
+//
+// A stable solution is not guaranteed. When none is found within the search
+// limit (see `WithSearchLimit`), the last matching is returned along with
+// `ErrNoStableMatchingFound`, and its `BlockingPairs` list each resident or
+// couple that would rather be matched with a hospital, or pair of hospitals,
+// that would take them.
+//
+// Example:
+//
+// SolveHRC takes a pair of preference tables and a list of couples as inputs.
+// The first table lists the residents and the second lists the hospitals, each
+// with an optional capacity (defaults to 1). Residents in a couple have an
+// empty preference list of their own, and the couple instead ranks pairs of
+// hospitals, one for each of its members.
+//
+// 		residents := []libmatch.MatchPreference{
+// 			{Name: "R1", Preferences: []string{"H2", "H3"}},
+// 			{Name: "R2", Preferences: []string{"H1"}},
+// 			{Name: "R3"},
+// 			{Name: "R4"},
+// 		}
+//
+// 		hospitals := []libmatch.MatchPreference{
+// 			{Name: "H1", Preferences: []string{"R2", "R3"}},
+// 			{Name: "H2", Preferences: []string{"R4", "R1"}},
+// 			{Name: "H3", Preferences: []string{"R3", "R4", "R1"}, Capacity: 2},
+// 		}
+//
+// 		couples := []libmatch.CouplePreference{
+// 			{Name: "C1", Members: []string{"R3", "R4"}, Preferences: [][]string{{"H1", "H2"}, {"H3", "H3"}}},
+// 		}
+//
+// On success, the return value will be a MatchResult containing the hospital
+// of each resident, as well as the residents assigned to each hospital in the
+// hospital's order of preference. Residents who can not be assigned to any
+// hospital are listed as unmatched.
+//
+// 		MatchResult{
+// 			Mapping: map[string]string{
+// 				"R1": "H2",
+// 				"R2": "H1",
+// 				"R3": "H3",
+// 				"R4": "H3",
+// 			},
+// 			Assignments: map[string][]string{
+// 				"H1": []string{"R2"},
+// 				"H2": []string{"R1"},
+// 				"H3": []string{"R3", "R4"},
+// 			},
+// 		}
+func SolveHRC(residents, hospitals *[]MatchPreference, couples *[]CouplePreference, opts ...Option) (MatchResult, error) {
+	var res MatchResult
+	var err error
+
+	tables := core.NewPreferenceTablePair(residents, hospitals)
+
+	algoCtx := core.AlgorithmContext{
+		TableA:  &tables[0],
+		TableB:  &tables[1],
+		Couples: couples,
+	}
+	applyOptions(&algoCtx, opts)
+
+	validator := validate.DoubleTableValidator{
+		PrefsSet:        []*[]core.MatchPreference{residents, hospitals},
+		Tables:          []*core.PreferenceTable{&tables[0], &tables[1]},
+		Capacitated:     true,
+		IncompleteLists: true,
+	}
+
+	if err = validator.Validate(); err != nil {
+		return res, err
+	}
+
+	couplesValidator := validate.CouplesValidator{
+		Couples: couples,
+		Tables:  []*core.PreferenceTable{&tables[0], &tables[1]},
+	}
+
+	if err = couplesValidator.Validate(); err != nil {
+		return res, err
+	}
+
+	res, err = hrc.Run(algoCtx)
+
+	return res, err
+}
+
+// SolveHRLQ solves the Hospitals/Residents Problem with Lower Quotas for a set
+// of preferences.
+//
+// See: Biró, Fleiner, Irving and Manlove (2010) "The College Admissions
+// problem with lower and common quotas"
+//
+// The algorithm finds a stable many-to-one matching between a set of residents
+// and a set of hospitals, where each hospital can accept as many residents as
+// its capacity and must accept at least as many as its lower quota. Residents
+// and hospitals rank the members they find acceptable, so some residents may
+// remain unmatched. Implements the deferred acceptance algorithm of
+// Gale-Shapley (1962), which is deterministic.
+//
+// A solution is not guaranteed. When some hospital can not meet its lower
+// quota, the resident-optimal stable matching is returned along with
+// `ErrLowerQuotasNotMet`. Use `WithCloseUnderfilled()` to close such
+// hospitals instead, in which case the result's `Closed` list records each
+// closed hospital in the order it was closed.
+//
+// Example:
+//
+// SolveHRLQ takes a pair of preference tables as inputs. The first table lists
+// the residents and the second lists the hospitals, each with an optional
+// capacity (defaults to 1) and an optional lower quota (defaults to 0).
+//
+// 		residents := []libmatch.MatchPreference{
+// 			{Name: "R1", Preferences: []string{"H1"}},
+// 			{Name: "R2", Preferences: []string{"H2", "H3"}},
+// 			{Name: "R3", Preferences: []string{"H3"}},
+// 		}
+//
+// 		hospitals := []libmatch.MatchPreference{
+// 			{Name: "H1", Preferences: []string{"R1"}},
+// 			{Name: "H2", Preferences: []string{"R2"}, Capacity: 3, LowerQuota: 3},
+// 			{Name: "H3", Preferences: []string{"R3", "R2"}, Capacity: 2, LowerQuota: 2},
+// 		}
+//
+// 		libmatch.SolveHRLQ(&residents, &hospitals, libmatch.WithCloseUnderfilled())
+//
+// On success, the return value will be a MatchResult containing the hospital
+// of each resident, as well as the residents assigned to each hospital in the
+// hospital's order of preference. Residents who can not be assigned to any
+// hospital are listed as unmatched.
+//
+// 		MatchResult{
+// 			Mapping: map[string]string{
+// 				"R1": "H1",
+// 				"R2": "H3",
+// 				"R3": "H3",
+// 			},
+// 			Assignments: map[string][]string{
+// 				"H1": []string{"R1"},
+// 				"H2": []string{},
+// 				"H3": []string{"R3", "R2"},
+// 			},
+// 			Closed: []string{"H2"},
+// 		}
+func SolveHRLQ(residents, hospitals *[]MatchPreference, opts ...Option) (MatchResult, error) {
+	var res MatchResult
+	var err error
+
+	tables := core.NewPreferenceTablePair(residents, hospitals)
+
+	algoCtx := core.AlgorithmContext{
+		TableA: &tables[0],
+		TableB: &tables[1],
+	}
+	applyOptions(&algoCtx, opts)
+
+	validator := validate.DoubleTableValidator{
+		PrefsSet:        []*[]core.MatchPreference{residents, hospitals},
+		Tables:          []*core.PreferenceTable{&tables[0], &tables[1]},
+		Capacitated:     true,
+		LowerQuotas:     true,
+		IncompleteLists: true,
+	}
+
+	if err = validator.Validate(); err != nil {
+		return res, err
+	}
+
+	res, err = hrlq.Run(algoCtx)
+
+	return res, err
+}
+
+// SolveSPA solves the Student-Project Allocation Problem for a set of
+// preferences.
+//
+// See: Abraham, Irving and Manlove (2007) "Two algorithms for the
+// Student-Project Allocation problem"
+//
+// The algorithm finds a stable allocation of students to projects, where each
+// project is offered by a lecturer and both projects and lecturers have a
+// capacity. Students rank the projects they find acceptable and lecturers rank
+// the students who find one of their projects acceptable. Implements the
+// student-oriented algorithm for the SPA-S model, which always returns the
+// student-optimal stable matching.
+//
+// Example:
+//
+// SolveSPA takes three preference tables as inputs. The first table lists the
+// students, the second lists the projects, each with the lecturer that offers
+// it as its `Owner`, and the third lists the lecturers. Projects and lecturers
+// have an optional capacity (defaults to 1), and projects have no preferences
+// of their own.
+//
+// 		students := []libmatch.MatchPreference{
+// 			{Name: "S1", Preferences: []string{"P1", "P2"}},
+// 			{Name: "S2", Preferences: []string{"P1"}},
+// 			{Name: "S3", Preferences: []string{"P2", "P3"}},
+// 		}
+//
+// 		projects := []libmatch.MatchPreference{
+// 			{Name: "P1", Owner: "L1"},
+// 			{Name: "P2", Owner: "L1"},
+// 			{Name: "P3", Owner: "L2"},
+// 		}
+//
+// 		lecturers := []libmatch.MatchPreference{
+// 			{Name: "L1", Preferences: []string{"S2", "S1", "S3"}, Capacity: 2},
+// 			{Name: "L2", Preferences: []string{"S3"}},
+// 		}
+//
+// On success, the return value will be a MatchResult containing the project
+// of each student, the students assigned to each project in the lecturer's
+// order of preference, and those same assignments grouped by lecturer.
+// Students who can not be assigned to any project are listed as unmatched.
+//
+// 		MatchResult{
+// 			Mapping: map[string]string{
+// 				"S1": "P2",
+// 				"S2": "P1",
+// 				"S3": "P3",
+// 			},
+// 			Assignments: map[string][]string{
+// 				"P1": []string{"S2"},
+// 				"P2": []string{"S1"},
+// 				"P3": []string{"S3"},
+// 			},
+// 			Allocations: map[string]map[string][]string{
+// 				"L1": {"P1": []string{"S2"}, "P2": []string{"S1"}},
+// 				"L2": {"P3": []string{"S3"}},
+// 			},
+// 		}
+func SolveSPA(students, projects, lecturers *[]MatchPreference, opts ...Option) (MatchResult, error) {
+	var res MatchResult
+	var err error
+
+	tables := core.NewPreferenceTableTriple(students, projects, lecturers)
+
+	algoCtx := core.AlgorithmContext{
+		TableA: &tables[0],
+		TableB: &tables[1],
+		TableC: &tables[2],
+	}
+	applyOptions(&algoCtx, opts)
+
+	validator := validate.TripleTableValidator{
+		PrefsSet: []*[]core.MatchPreference{students, projects, lecturers},
+		Tables:   []*core.PreferenceTable{&tables[0], &tables[1], &tables[2]},
+	}
+
+	if err = validator.Validate(); err != nil {
+		return res, err
+	}
+
+	res, err = spa.Run(algoCtx)
+
+	return res, err
+}
+
+// SolveTTC solves the school choice and housing market problems for a set of
+// preferences using Top Trading Cycles.
+//
+// See: Shapley and Scarf (1974) "On cores and indivisibility", and
+// Abdulkadiroğlu and Sönmez (2003) "School Choice: A Mechanism Design
+// Approach"
+//
+// The algorithm assigns each student to at most one school, where each school
+// ranks students by priority and has a capacity. Students may rank as few
+// schools as they like. Implements the Top Trading Cycles algorithm of Gale.
+// The result is not stable, but it is Pareto efficient and deterministic.
+//
+// A school may be owned by a student, who then takes priority over everyone
+// else. In a housing market, every school is a house with a capacity of 1 that
+// is owned by a student and ranks no one.
+//
+// Example:
+//
+// SolveTTC takes a pair of preference tables as inputs. The first table lists
+// the students and the second lists the schools, each with an optional
+// capacity (defaults to 1) and an optional `Owner` from the students.
+//
+// 		students := []libmatch.MatchPreference{
+// 			{Name: "A", Preferences: []string{"H3", "H2", "H1"}},
+// 			{Name: "B", Preferences: []string{"H1", "H4", "H2"}},
+// 			{Name: "C", Preferences: []string{"H1", "H3"}},
+// 			{Name: "D", Preferences: []string{"H3", "H2", "H4"}},
+// 		}
+//
+// 		houses := []libmatch.MatchPreference{
+// 			{Name: "H1", Owner: "A"},
+// 			{Name: "H2", Owner: "B"},
+// 			{Name: "H3", Owner: "C"},
+// 			{Name: "H4", Owner: "D"},
+// 		}
+//
+// On success, the return value will be a MatchResult containing the school
+// of each student, as well as the students assigned to each school in the
+// school's order of priority. Students who can not be assigned to any school
+// are listed as unmatched.
+//
+// 		MatchResult{
+// 			Mapping: map[string]string{
+// 				"A": "H3",
+// 				"B": "H4",
+// 				"C": "H1",
+// 				"D": "H2",
+// 			},
+// 			Assignments: map[string][]string{
+// 				"H1": []string{"C"},
+// 				"H2": []string{"D"},
+// 				"H3": []string{"A"},
+// 				"H4": []string{"B"},
+// 			},
+// 		}
+func SolveTTC(students, schools *[]MatchPreference, opts ...Option) (MatchResult, error) {
+	var res MatchResult
+	var err error
+
+	tables := core.NewPreferenceTablePair(students, schools)
+
+	algoCtx := core.AlgorithmContext{
+		TableA: &tables[0],
+		TableB: &tables[1],
+	}
+	applyOptions(&algoCtx, opts)
+
+	validator := validate.DoubleTableValidator{
+		PrefsSet:        []*[]core.MatchPreference{students, schools},
+		Tables:          []*core.PreferenceTable{&tables[0], &tables[1]},
+		Capacitated:     true,
+		IncompleteLists: true,
+		Owners:          true,
+	}
+
+	if err = validator.Validate(); err != nil {
+		return res, err
+	}
+
+	res, err = ttc.Run(algoCtx)
+
+	return res, err
+}
+
+// SolveSD allocates items to members for a set of preferences using Serial
+// Dictatorship.
+//
+// See: https://en.wikipedia.org/wiki/Random_serial_dictatorship
+//
+// Members pick one at a time in a priority order, each picking their most
+// preferred item with capacity left. Members may rank as few items as they
+// like, and items have a capacity but no preferences of their own. The result
+// is not stable, but it is Pareto efficient and deterministic.
+//
+// By default members pick in the order in which they are listed. Use
+// `WithPriorityOrder(order)` to specify another order.
+//
+// Example:
+//
+// SolveSD takes a pair of preference tables as inputs. The first table lists
+// the members and the second lists the items, each with an optional capacity
+// (defaults to 1).
+//
+// 		members := []libmatch.MatchPreference{
+// 			{Name: "A", Preferences: []string{"Y", "X"}},
+// 			{Name: "B", Preferences: []string{"Y", "Z"}},
+// 			{Name: "C", Preferences: []string{"X"}},
+// 			{Name: "D", Preferences: []string{"Y", "X", "Z"}},
+// 			{Name: "E", Preferences: []string{"Z"}},
+// 		}
+//
+// 		items := []libmatch.MatchPreference{
+// 			{Name: "X", Capacity: 2},
+// 			{Name: "Y"},
+// 			{Name: "Z"},
+// 		}
+//
+// 		order := libmatch.WithPriorityOrder([]string{"D", "A", "B", "C", "E"})
+//
+// On success, the return value will be a MatchResult containing the item of
+// each member, as well as the members assigned to each item in the order in
+// which they picked. Members who can not pick any item are listed as
+// unmatched.
+//
+// 		MatchResult{
+// 			Mapping: map[string]string{
+// 				"A": "X",
+// 				"B": "Z",
+// 				"C": "X",
+// 				"D": "Y",
+// 			},
+// 			Assignments: map[string][]string{
+// 				"X": []string{"A", "C"},
+// 				"Y": []string{"D"},
+// 				"Z": []string{"B"},
+// 			},
+// 			Unmatched: []string{"E"},
+// 		}
+func SolveSD(members, items *[]MatchPreference, opts ...Option) (MatchResult, error) {
+	var res MatchResult
+	var err error
+
+	tables := core.NewPreferenceTablePair(members, items)
+
+	algoCtx := core.AlgorithmContext{
+		TableA: &tables[0],
+		TableB: &tables[1],
+	}
+	applyOptions(&algoCtx, opts)
+
+	// Members pick in the order in which they are listed by default
+	if algoCtx.PriorityOrder == nil {
+		algoCtx.PriorityOrder = make([]string, len(*members))
+		for i := range *members {
+			algoCtx.PriorityOrder[i] = (*members)[i].Name
+		}
+	}
+
+	validator := validate.DoubleTableValidator{
+		PrefsSet:        []*[]core.MatchPreference{members, items},
+		Tables:          []*core.PreferenceTable{&tables[0], &tables[1]},
+		Capacitated:     true,
+		IncompleteLists: true,
+		Priority:        algoCtx.PriorityOrder,
+	}
+
+	if err = validator.Validate(); err != nil {
+		return res, err
+	}
+
+	res, err = sd.Run(algoCtx)
+
+	return res, err
+}
+
+// SolveRSD allocates items to members for a set of preferences using Random
+// Serial Dictatorship.
+//
+// See: https://en.wikipedia.org/wiki/Random_serial_dictatorship
+//
+// Works like `SolveSD`, except that members pick in a random priority order.
+// Use `WithSeed(seed)` to seed the random order, so that the same seed always
+// produces the same result.
+//
+// Use `WithDraws(n)` to draw `n` random orders instead of one. The result then
+// holds the probability of each member picking each item over all draws in
+// `Probabilities`, and no single matching.
+//
+// Example:
+//
+// SolveRSD takes the same preference tables as `SolveSD`.
+//
+// 		members := []libmatch.MatchPreference{
+// 			{Name: "A", Preferences: []string{"X", "Y"}},
+// 			{Name: "B", Preferences: []string{"X", "Y"}},
+// 		}
+//
+// 		items := []libmatch.MatchPreference{
+// 			{Name: "X"},
+// 			{Name: "Y"},
+// 		}
+//
+// 		result, err := libmatch.SolveRSD(&members, &items, libmatch.WithDraws(1000))
+//
+// On success, the return value will be a MatchResult containing the
+// probability of each member picking each item, which approaches an equal
+// chance of picking "X" as the number of draws grows.
+//
+// 		MatchResult{
+// 			Mapping: map[string]string{},
+// 			Probabilities: map[string]map[string]float64{
+// 				"A": {"X": 0.515, "Y": 0.485},
+// 				"B": {"X": 0.485, "Y": 0.515},
+// 			},
+// 		}
+func SolveRSD(members, items *[]MatchPreference, opts ...Option) (MatchResult, error) {
+	var res MatchResult
+	var err error
+
+	tables := core.NewPreferenceTablePair(members, items)
+
+	algoCtx := core.AlgorithmContext{
+		TableA: &tables[0],
+		TableB: &tables[1],
+	}
+	applyOptions(&algoCtx, opts)
+
+	validator := validate.DoubleTableValidator{
+		PrefsSet:        []*[]core.MatchPreference{members, items},
+		Tables:          []*core.PreferenceTable{&tables[0], &tables[1]},
+		Capacitated:     true,
+		IncompleteLists: true,
+	}
+
+	if err = validator.Validate(); err != nil {
+		return res, err
+	}
+
+	res, err = sd.RunRandom(algoCtx)
+
+	return res, err
+}
+
+// SolvePS allocates items to members for a set of preferences using the
+// Probabilistic Serial mechanism.
+//
+// See: https://en.wikipedia.org/wiki/Probabilistic-serial_procedure
+//
+// Implements the Bogomolnaia-Moulin (2001) "simultaneous eating" algorithm.
+// Every member eats their most preferred item that has supply left at the same
+// speed, and the fraction of each item eaten is the probability of receiving
+// it. The result is ordinally efficient and envy-free.
+//
+// The probabilities are also decomposed into a lottery over deterministic
+// matchings in `Decomposition`, so that drawing a matching from the lottery
+// gives every member each item with exactly those probabilities. Use
+// `WithSample()` to draw a single matching, and `WithSeed(seed)` to seed the
+// draw.
+//
+// Example:
+//
+// SolvePS takes the same preference tables as `SolveSD`.
+//
+// 		members := []libmatch.MatchPreference{
+// 			{Name: "A", Preferences: []string{"X", "Y", "Z"}},
+// 			{Name: "B", Preferences: []string{"X", "Z", "Y"}},
+// 			{Name: "C", Preferences: []string{"Y", "X", "Z"}},
+// 		}
+//
+// 		items := []libmatch.MatchPreference{
+// 			{Name: "X"},
+// 			{Name: "Y"},
+// 			{Name: "Z"},
+// 		}
+//
+// 		result, err := libmatch.SolvePS(&members, &items)
+//
+// On success, the return value will be a MatchResult containing the
+// probability of each member receiving each item, and the lottery that
+// produces them. Matchings are listed from most to least likely.
+//
+// 		MatchResult{
+// 			Mapping: map[string]string{},
+// 			Probabilities: map[string]map[string]float64{
+// 				"A": {"X": 0.5, "Y": 0.25, "Z": 0.25},
+// 				"B": {"X": 0.5, "Z": 0.5},
+// 				"C": {"Y": 0.75, "Z": 0.25},
+// 			},
+// 			Decomposition: []libmatch.WeightedMatching{
+// 				{Probability: 0.5, Mapping: map[string]string{"A": "X", "B": "Z", "C": "Y"}},
+// 				{Probability: 0.25, Mapping: map[string]string{"A": "Y", "B": "X", "C": "Z"}},
+// 				{Probability: 0.25, Mapping: map[string]string{"A": "Z", "B": "X", "C": "Y"}},
+// 			},
+// 		}
+func SolvePS(members, items *[]MatchPreference, opts ...Option) (MatchResult, error) {
+	var res MatchResult
+	var err error
+
+	tables := core.NewPreferenceTablePair(members, items)
+
+	algoCtx := core.AlgorithmContext{
+		TableA: &tables[0],
+		TableB: &tables[1],
+	}
+	applyOptions(&algoCtx, opts)
+
+	validator := validate.DoubleTableValidator{
+		PrefsSet:        []*[]core.MatchPreference{members, items},
+		Tables:          []*core.PreferenceTable{&tables[0], &tables[1]},
+		Capacitated:     true,
+		IncompleteLists: true,
+	}
+
+	if err = validator.Validate(); err != nil {
+		return res, err
+	}
+
+	res, err = ps.Run(algoCtx)
+
+	return res, err
+}
+
+// SolvePOP solves the Popular Matching problem for a set of one-sided
+// preferences.
+//
+// See: Abraham, Irving, Kavitha and Mehlhorn (2007) "Popular Matchings"
+//
+// The algorithm finds a matching of applicants to houses such that no other
+// matching is preferred by more applicants than prefer it. Only applicants
+// have preferences, which may omit houses and may rank houses equally (ties).
+// A popular matching does not always exist.
+//
+// Example:
+//
+// SolvePOP takes a pair of preference tables as inputs. The first table lists
+// the applicants and the second lists the houses, which have no preferences.
+//
+// 		applicants := []libmatch.MatchPreference{
+// 			{Name: "A1", Preferences: []string{"H1", "H2", "H3"}},
+// 			{Name: "A2", Preferences: []string{"H1", "H3"}},
+// 			{Name: "A3", Preferences: []string{"H2", "H1"}},
+// 			{Name: "A4", Preferences: []string{"H2", "H4"}},
+// 		}
+//
+// 		houses := []libmatch.MatchPreference{
+// 			{Name: "H1"},
+// 			{Name: "H2"},
+// 			{Name: "H3"},
+// 			{Name: "H4"},
+// 		}
+//
+// On success, the return value will be a MatchResult containing the house of
+// each applicant. Applicants who are not matched with any house are listed as
+// unmatched.
+//
+// 		MatchResult{
+// 			Mapping: map[string]string{
+// 				"A1": "H3",
+// 				"A2": "H1",
+// 				"A4": "H2",
+// 			},
+// 			Unmatched: []string{"A3"},
+// 		}
+//
+// When no popular matching exists, an error is returned instead.
+func SolvePOP(applicants, houses *[]MatchPreference, opts ...Option) (MatchResult, error) {
+	var res MatchResult
+	var err error
+
+	tables := core.NewPreferenceTablePair(applicants, houses)
+
+	algoCtx := core.AlgorithmContext{
+		TableA: &tables[0],
+		TableB: &tables[1],
+	}
+	applyOptions(&algoCtx, opts)
+
+	validator := validate.OneSidedValidator{
+		PrefsSet: []*[]core.MatchPreference{applicants, houses},
+		Tables:   []*core.PreferenceTable{&tables[0], &tables[1]},
+		Ties:     true,
+	}
+
+	if err = validator.Validate(); err != nil {
+		return res, err
+	}
+
+	res, err = pop.Run(algoCtx)
+
+	return res, err
+}
+
+// SolveRMM solves the Rank-Maximal Matching problem for a set of one-sided
+// preferences.
+//
+// See: Irving, Kavitha, Mehlhorn, Michail and Paluch (2006) "Rank-Maximal
+// Matchings"
+//
+// The algorithm finds a matching of applicants to houses that matches as many
+// applicants as possible with their first choice, then as many as possible
+// with their second choice, and so on. Only applicants have preferences, which
+// may omit houses and may rank houses equally (ties). A rank-maximal matching
+// always exists.
+//
+// Example:
+//
+// SolveRMM takes a pair of preference tables as inputs. The first table lists
+// the applicants and the second lists the houses, each with an optional
+// capacity (defaults to 1) and no preferences.
+//
+// 		applicants := []libmatch.MatchPreference{
+// 			{Name: "A1", Preferences: []string{"H1", "H2"}},
+// 			{Name: "A2", Preferences: []string{"H3", "H1"}},
+// 			{Name: "A3", Preferences: []string{"H3"}},
+// 		}
+//
+// 		houses := []libmatch.MatchPreference{
+// 			{Name: "H1"},
+// 			{Name: "H2"},
+// 			{Name: "H3"},
+// 		}
+//
+// On success, the return value will be a MatchResult containing the house of
+// each applicant, the applicants assigned to each house by name, and the
+// signature of the matching: the number of applicants matched with their
+// first choice, second choice, and so on. Applicants who are not matched with
+// any house are listed as unmatched.
+//
+// 		MatchResult{
+// 			Mapping: map[string]string{
+// 				"A1": "H1",
+// 				"A2": "H3",
+// 			},
+// 			Assignments: map[string][]string{
+// 				"H1": []string{"A1"},
+// 				"H2": []string{},
+// 				"H3": []string{"A2"},
+// 			},
+// 			Unmatched: []string{"A3"},
+// 			Signature: []int{2, 0},
+// 		}
+func SolveRMM(applicants, houses *[]MatchPreference, opts ...Option) (MatchResult, error) {
+	var res MatchResult
+	var err error
+
+	tables := core.NewPreferenceTablePair(applicants, houses)
+
+	algoCtx := core.AlgorithmContext{
+		TableA: &tables[0],
+		TableB: &tables[1],
+	}
+	applyOptions(&algoCtx, opts)
+
+	validator := validate.OneSidedValidator{
+		PrefsSet:    []*[]core.MatchPreference{applicants, houses},
+		Tables:      []*core.PreferenceTable{&tables[0], &tables[1]},
+		Capacitated: true,
+		Ties:        true,
+	}
+
+	if err = validator.Validate(); err != nil {
+		return res, err
+	}
+
+	res, err = rmm.Run(algoCtx)
+
+	return res, err
+}
+
+// SolveAP solves the Assignment Problem for a pair of preference tables, using
+// the Hungarian algorithm.
+//
+// See: Kuhn (1955) "The Hungarian Method for the Assignment Problem"
+//
+// Members may give a numeric score to each member of their preference list,
+// and the score of a pair is the sum of the scores both members give each
+// other. The algorithm finds the matching that maximises the total score of all
+// matched pairs (its "welfare"), rather than a stable matching. The order of
+// each preference list does not matter. A solution always exists and is
+// deterministic.
+//
+// Example:
+//
+// SolveAP takes a pair of preference tables as inputs, like `SolveSMP`. Each
+// member may list a score for every member of their preference list, in the
+// same order. Members that give no scores score every member 0.
+//
+// 		workers := []libmatch.MatchPreference{
+// 			{Name: "A", Preferences: []string{"X", "Y", "Z"}, Scores: []float64{9, 8, 1}},
+// 			{Name: "B", Preferences: []string{"X", "Y", "Z"}, Scores: []float64{8, 3, 2}},
+// 			{Name: "C", Preferences: []string{"X", "Y", "Z"}, Scores: []float64{7, 6, 5}},
+// 		}
+//
+// 		tasks := []libmatch.MatchPreference{
+// 			{Name: "X", Preferences: []string{"A", "B", "C"}},
+// 			{Name: "Y", Preferences: []string{"A", "B", "C"}},
+// 			{Name: "Z", Preferences: []string{"A", "B", "C"}},
+// 		}
+//
+// On success, the return value will be a MatchResult containing the mapping
+// between pairs of members.
+//
+// 		MatchResult{
+// 			Mapping: map[string]string{
+// 				"A": "Y",
+// 				"B": "X",
+// 				"C": "Z",
+// 				"X": "B",
+// 				"Y": "A",
+// 				"Z": "C",
+// 			},
+// 		}
+//
+// Use `WithScoresAsCosts()` to minimise the total score instead, when scores
+// are costs. Use `WithIncompleteLists()` to only match pairs of members that
+// both list each other, in which case members are left unmatched whenever that
+// gives a higher total score, and are listed as unmatched. Add
+// `WithObjective(ObjectiveMaximumCardinality)` to match as many members as
+// possible first, and only then maximise the total score.
+func SolveAP(prefsA, prefsB *[]MatchPreference, opts ...Option) (MatchResult, error) {
+	var res MatchResult
+	var err error
+
+	tables := core.NewPreferenceTablePair(prefsA, prefsB)
+
+	algoCtx := core.AlgorithmContext{
+		TableA: &tables[0],
+		TableB: &tables[1],
+	}
+	applyOptions(&algoCtx, opts)
+
+	validator := validate.DoubleTableValidator{
+		PrefsSet:        []*[]core.MatchPreference{prefsA, prefsB},
+		Tables:          []*core.PreferenceTable{&tables[0], &tables[1]},
+		IncompleteLists: algoCtx.IncompleteLists,
+		Scores:          true,
+		Ties:            true,
+	}
+
+	if err = validator.Validate(); err != nil {
+		return res, err
+	}
+
+	res, err = ap.Run(algoCtx)
+
+	return res, err
+}
+
+// SolveDA solves school choice with the student-proposing deferred acceptance
+// algorithm, breaking ties in priority by lottery.
+//
+// See: Abdulkadiroğlu, Pathak and Roth (2009) "Strategy-Proofness versus
+// Efficiency in Matching with Indifferences: Redesigning the NYC High School
+// Match"
+//
+// Students rank schools, and schools rank students in coarse priority classes
+// with a capacity. Ties between students of the same priority class are broken
+// by a lottery drawn from a seed (see `WithSeed()`), and the lottery numbers
+// are recorded in the result so that it can be audited and reproduced. The
+// result is stable with respect to the priorities after tie-breaking, and the
+// same seed always produces the same result.
+//
+// Example:
+//
+// SolveDA takes a pair of preference tables as inputs. The first table lists
+// the students and the second lists the schools, each with an optional
+// capacity (defaults to 1). Students a school ranks equally share a priority
+// class, and students it does not rank form its lowest priority class.
+//
+// 		students := []libmatch.MatchPreference{
+// 			{Name: "S1", Preferences: []string{"X", "Y"}},
+// 			{Name: "S2", Preferences: []string{"X", "Y"}},
+// 			{Name: "S3", Preferences: []string{"X", "Y"}},
+// 			{Name: "S4", Preferences: []string{"Y", "X"}},
+// 		}
+//
+// 		schools := []libmatch.MatchPreference{
+// 			{Name: "X", RankedPreferences: [][]string{{"S4"}, {"S1", "S2", "S3"}}},
+// 			{Name: "Y", RankedPreferences: [][]string{{"S1", "S2", "S3", "S4"}}, Capacity: 2},
+// 		}
+//
+// On success, the return value will be a MatchResult containing the school of
+// each student, the students assigned to each school in order of priority,
+// and the lottery number each school drew for each student. Students who are
+// rejected by every school they ranked are listed as unmatched.
+//
+// 		MatchResult{
+// 			Mapping: map[string]string{
+// 				"S1": "X",
+// 				"S2": "Y",
+// 				"S4": "Y",
+// 			},
+// 			Assignments: map[string][]string{
+// 				"X": []string{"S1"},
+// 				"Y": []string{"S4", "S2"},
+// 			},
+// 			Unmatched: []string{"S3"},
+// 			Lottery: map[string]map[string]int{
+// 				"X": map[string]int{"S1": 2, "S2": 3, "S3": 4, "S4": 1},
+// 				"Y": map[string]int{"S1": 2, "S2": 3, "S3": 4, "S4": 1},
+// 			},
+// 		}
+//
+// By default a single lottery is drawn, which every school uses. Use
+// `WithLottery(LotteryMultiple)` to draw a separate lottery at each school.
+func SolveDA(students, schools *[]MatchPreference, opts ...Option) (MatchResult, error) {
+	var res MatchResult
+	var err error
+
+	tables := core.NewPreferenceTablePair(students, schools)
+
+	algoCtx := core.AlgorithmContext{
+		TableA: &tables[0],
+		TableB: &tables[1],
+	}
+	applyOptions(&algoCtx, opts)
+
+	validator := validate.DoubleTableValidator{
+		PrefsSet:        []*[]core.MatchPreference{students, schools},
+		Tables:          []*core.PreferenceTable{&tables[0], &tables[1]},
+		Capacitated:     true,
+		IncompleteLists: true,
+		PriorityClasses: true,
+	}
+
+	if err = validator.Validate(); err != nil {
+		return res, err
+	}
+
+	res, err = da.Run(algoCtx)
+
+	return res, err
+}
+
+// SolveIA solves school choice with the immediate acceptance algorithm,
+// commonly known as the Boston mechanism, breaking ties in priority by lottery.
+//
+// See: Abdulkadiroğlu and Sönmez (2003) "School Choice: A Mechanism Design
+// Approach"
+//
+// It takes the same preference tables and options as `SolveDA`, and draws the
+// same lottery from the same seed, so that the two mechanisms can be run side
+// by side. Students apply to their first choice, then their second choice, and
+// so on, and each school admits its highest priority applicants while seats
+// are left. Admissions are final, so the result is not stable.
+//
+// Example:
+//
+// 		students := []libmatch.MatchPreference{
+// 			{Name: "S1", Preferences: []string{"X", "Y"}},
+// 			{Name: "S2", Preferences: []string{"Y", "X"}},
+// 			{Name: "S3", Preferences: []string{"Y", "X"}},
+// 		}
+//
+// 		schools := []libmatch.MatchPreference{
+// 			{Name: "X", RankedPreferences: [][]string{{"S2"}, {"S1", "S3"}}},
+// 			{Name: "Y", RankedPreferences: [][]string{{"S1", "S2", "S3"}}},
+// 		}
+//
+// On success, the return value will be a MatchResult containing the school of
+// each student, the students assigned to each school in order of priority,
+// and the lottery number each school drew for each student.
+//
+// 		MatchResult{
+// 			Mapping: map[string]string{
+// 				"S1": "X",
+// 				"S3": "Y",
+// 			},
+// 			Assignments: map[string][]string{
+// 				"X": []string{"S1"},
+// 				"Y": []string{"S3"},
+// 			},
+// 			Unmatched: []string{"S2"},
+// 			Lottery: map[string]map[string]int{
+// 				"X": map[string]int{"S1": 2, "S2": 3, "S3": 1},
+// 				"Y": map[string]int{"S1": 2, "S2": 3, "S3": 1},
+// 			},
+// 		}
+//
+// With the same seed, `SolveDA` gives "X" to "S2" instead, who has the highest
+// priority there, and leaves "S1" unmatched.
+func SolveIA(students, schools *[]MatchPreference, opts ...Option) (MatchResult, error) {
+	var res MatchResult
+	var err error
+
+	tables := core.NewPreferenceTablePair(students, schools)
+
+	algoCtx := core.AlgorithmContext{
+		TableA: &tables[0],
+		TableB: &tables[1],
+	}
+	applyOptions(&algoCtx, opts)
+
+	validator := validate.DoubleTableValidator{
+		PrefsSet:        []*[]core.MatchPreference{students, schools},
+		Tables:          []*core.PreferenceTable{&tables[0], &tables[1]},
+		Capacitated:     true,
+		IncompleteLists: true,
+		PriorityClasses: true,
+	}
+
+	if err = validator.Validate(); err != nil {
+		return res, err
+	}
+
+	res, err = ia.Run(algoCtx)
 
 	return res, err
 }
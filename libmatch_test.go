@@ -1,6 +1,7 @@
 package libmatch
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"reflect"
@@ -52,6 +53,38 @@ func TestLoad(t *testing.T) {
 	})
 }
 
+func TestLoadCouples(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		body := `
+	  [
+	    { "name":"C1", "members": ["R1", "R2"], "preferences": [["H1", "H1"], ["H1", "H2"]] }
+	  ]
+	  `
+
+		result, err := LoadCouples(strings.NewReader(body))
+
+		wanted := &[]core.CouplePreference{
+			{Name: "C1", Members: []string{"R1", "R2"}, Preferences: [][]string{{"H1", "H1"}, {"H1", "H2"}}},
+		}
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// Note missing `:` after "members"
+		body := `
+	  [
+	    { "name":"C1", "members" ["R1", "R2"], "preferences": [["H1", "H1"]] }
+	  ]
+		`
+
+		_, err := LoadCouples(strings.NewReader(body))
+
+		assert.Equal(t, "invalid character '[' after object key", err.Error())
+	})
+}
+
 func ExampleLoad() {
 
 	// Load JSON contents as an `io.Reader`
@@ -131,6 +164,8 @@ func TestSolveSMP(t *testing.T) {
 				"M": "F",
 				"H": "E",
 			},
+			OptimalSide: core.SideA,
+			Costs:       map[core.Side]int{core.SideA: 16, core.SideB: 11},
 		}
 
 		result, err := SolveSMP(&prefsA, &prefsB)
@@ -173,6 +208,8 @@ func TestSolveSMP(t *testing.T) {
 				"M": "E",
 				"H": "F",
 			},
+			OptimalSide: core.SideA,
+			Costs:       map[core.Side]int{core.SideA: 8, core.SideB: 21},
 		}
 
 		result, err := SolveSMP(&prefsB, &prefsA)
@@ -181,6 +218,70 @@ func TestSolveSMP(t *testing.T) {
 		assert.True(t, reflect.DeepEqual(wanted, result))
 	})
 
+	t.Run("second table proposes", func(t *testing.T) {
+		prefsA := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"L", "J", "H", "K", "I", "M"}},
+			{Name: "B", Preferences: []string{"L", "J", "K", "M", "I", "H"}},
+			{Name: "C", Preferences: []string{"L", "J", "M", "I", "K", "H"}},
+			{Name: "D", Preferences: []string{"L", "K", "J", "I", "M", "H"}},
+			{Name: "E", Preferences: []string{"H", "I", "L", "K", "M", "J"}},
+			{Name: "F", Preferences: []string{"J", "K", "L", "M", "H", "I"}},
+		}
+
+		prefsB := []core.MatchPreference{
+			{Name: "H", Preferences: []string{"F", "E", "C", "A", "D", "B"}},
+			{Name: "I", Preferences: []string{"B", "D", "A", "E", "C", "F"}},
+			{Name: "J", Preferences: []string{"B", "A", "F", "E", "D", "C"}},
+			{Name: "K", Preferences: []string{"A", "E", "C", "F", "D", "B"}},
+			{Name: "L", Preferences: []string{"C", "F", "E", "B", "D", "A"}},
+			{Name: "M", Preferences: []string{"B", "E", "D", "F", "C", "A"}},
+		}
+
+		// Equivalent to reversing the table order, but the original order of
+		// the tables is preserved
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "K",
+				"B": "J",
+				"C": "L",
+				"D": "I",
+				"E": "M",
+				"F": "H",
+				"K": "A",
+				"J": "B",
+				"L": "C",
+				"I": "D",
+				"M": "E",
+				"H": "F",
+			},
+			OptimalSide: core.SideB,
+			Costs:       map[core.Side]int{core.SideA: 21, core.SideB: 8},
+		}
+
+		result, err := SolveSMP(&prefsA, &prefsB, WithProposer(SideB))
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("records the cost of each side", func(t *testing.T) {
+		prefTableA := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"C", "D"}},
+			{Name: "B", Preferences: []string{"C", "D"}},
+		}
+
+		prefTableB := []core.MatchPreference{
+			{Name: "C", Preferences: []string{"B", "A"}},
+			{Name: "D", Preferences: []string{"A", "B"}},
+		}
+
+		result, err := SolveSMP(&prefTableA, &prefTableB)
+
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{"A": "D", "B": "C", "C": "B", "D": "A"}, result.Mapping)
+		assert.Equal(t, map[core.Side]int{core.SideA: 3, core.SideB: 2}, result.Costs)
+	})
+
 	t.Run("validates match prefs", func(t *testing.T) {
 		prefsA := []core.MatchPreference{
 			{Name: "A", Preferences: []string{"L", "J", "H", "K", "I", "M"}},
@@ -228,187 +329,2323 @@ func TestSolveSMP(t *testing.T) {
 
 		assert.Equal(t, "Tables must be the same size", err.Error())
 	})
-}
 
-func ExampleSolveSMP() {
+	t.Run("incomplete lists", func(t *testing.T) {
+		prefsA := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"K", "L"}},
+			{Name: "B", Preferences: []string{"K"}},
+			{Name: "C", Preferences: []string{"L", "K"}},
+		}
 
-	prefTableA := []MatchPreference{
-		{Name: "A", Preferences: []string{"F", "J", "H", "G", "I"}},
-		{Name: "B", Preferences: []string{"F", "J", "H", "G", "I"}},
-		{Name: "C", Preferences: []string{"F", "G", "H", "J", "I"}},
-		{Name: "D", Preferences: []string{"H", "J", "F", "I", "G"}},
-		{Name: "E", Preferences: []string{"H", "F", "G", "I", "J"}},
-	}
+		prefsB := []core.MatchPreference{
+			{Name: "K", Preferences: []string{"C", "A", "B"}},
+			{Name: "L", Preferences: []string{"A"}},
+		}
 
-	prefTableB := []MatchPreference{
-		{Name: "F", Preferences: []string{"A", "E", "C", "B", "D"}},
-		{Name: "G", Preferences: []string{"D", "E", "C", "B", "A"}},
-		{Name: "H", Preferences: []string{"A", "B", "C", "D", "E"}},
-		{Name: "I", Preferences: []string{"B", "E", "C", "D", "A"}},
-		{Name: "J", Preferences: []string{"E", "A", "D", "B", "C"}},
-	}
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "L",
+				"C": "K",
+				"K": "C",
+				"L": "A",
+			},
+			Unmatched:   []string{"B"},
+			OptimalSide: core.SideA,
+			Costs:       map[core.Side]int{core.SideA: 4, core.SideB: 2},
+		}
 
-	// Call `libmatch`
-	result, err := SolveSMP(&prefTableA, &prefTableB)
-	if err != nil {
-		fmt.Println(err)
-		os.Exit(1)
-	}
+		result, err := SolveSMP(&prefsA, &prefsB, WithIncompleteLists())
 
-	// Iterate through the result mapping
-	for x, y := range result.Mapping {
-		fmt.Printf("%v => %v\n", x, y)
-	}
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
 
-	// Unordered output:
-	// A => F
-	// B => H
-	// C => I
-	// D => J
-	// E => G
-	// F => A
-	// G => E
-	// H => B
-	// I => C
-	// J => D
-}
+	t.Run("incomplete lists are validated", func(t *testing.T) {
+		prefsA := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"K", "X"}},
+			{Name: "B", Preferences: []string{"K"}},
+		}
 
-func TestSolveSRP(t *testing.T) {
-	t.Run("success", func(t *testing.T) {
-		prefs := []core.MatchPreference{
-			{Name: "A", Preferences: []string{"B", "C", "D"}},
-			{Name: "B", Preferences: []string{"A", "C", "D"}},
-			{Name: "C", Preferences: []string{"A", "B", "D"}},
-			{Name: "D", Preferences: []string{"A", "B", "C"}},
+		prefsB := []core.MatchPreference{
+			{Name: "K", Preferences: []string{"A", "B"}},
+		}
+
+		_, err := SolveSMP(&prefsA, &prefsB, WithIncompleteLists())
+
+		assert.Equal(t, "Preference list for 'A' contains at least one unknown member", err.Error())
+	})
+
+	t.Run("ties", func(t *testing.T) {
+		prefsA := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"K", "L"}},
+			{Name: "B", Preferences: []string{"K", "L"}},
+		}
+
+		prefsB := []core.MatchPreference{
+			{Name: "K", RankedPreferences: [][]string{{"B", "A"}}},
+			{Name: "L", Preferences: []string{"A", "B"}},
 		}
 
 		wanted := core.MatchResult{
 			Mapping: map[string]string{
-				"A": "B",
-				"B": "A",
-				"C": "D",
-				"D": "C",
+				"A": "L",
+				"B": "K",
+				"K": "B",
+				"L": "A",
 			},
+			OptimalSide: core.SideA,
+			Costs:       map[core.Side]int{core.SideA: 3, core.SideB: 2},
 		}
 
-		result, err := SolveSRP(&prefs)
+		result, err := SolveSMP(&prefsA, &prefsB)
 
 		assert.Nil(t, err)
 		assert.True(t, reflect.DeepEqual(wanted, result))
 	})
 
-	t.Run("no stable solution", func(t *testing.T) {
-		prefs := []core.MatchPreference{
-			{Name: "A", Preferences: []string{"B", "E", "C", "F", "D"}},
-			{Name: "B", Preferences: []string{"C", "F", "E", "A", "D"}},
-			{Name: "C", Preferences: []string{"E", "A", "F", "D", "B"}},
-			{Name: "D", Preferences: []string{"B", "A", "C", "F", "E"}},
-			{Name: "E", Preferences: []string{"A", "C", "D", "B", "F"}},
-			{Name: "F", Preferences: []string{"C", "A", "E", "B", "D"}},
+	t.Run("ties with a tie breaker", func(t *testing.T) {
+		prefsA := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"K", "L"}},
+			{Name: "B", Preferences: []string{"K", "L"}},
 		}
 
-		_, err := SolveSRP(&prefs)
-
-		assert.Equal(t, "No stable solution exists", err.Error())
-	})
-
-	t.Run("is not dependent on order", func(t *testing.T) {
-		prefs := []core.MatchPreference{
-			{Name: "A", Preferences: []string{"B", "C", "D"}},
-			{Name: "D", Preferences: []string{"A", "B", "C"}},
-			{Name: "C", Preferences: []string{"A", "B", "D"}},
-			{Name: "B", Preferences: []string{"A", "C", "D"}},
+		prefsB := []core.MatchPreference{
+			{Name: "K", RankedPreferences: [][]string{{"B", "A"}}},
+			{Name: "L", Preferences: []string{"A", "B"}},
 		}
 
 		wanted := core.MatchResult{
 			Mapping: map[string]string{
-				"A": "B",
-				"B": "A",
-				"C": "D",
-				"D": "C",
+				"A": "K",
+				"B": "L",
+				"K": "A",
+				"L": "B",
 			},
+			OptimalSide: core.SideA,
+			Costs:       map[core.Side]int{core.SideA: 3, core.SideB: 3},
 		}
 
-		result, err := SolveSRP(&prefs)
+		result, err := SolveSMP(&prefsA, &prefsB, WithTieBreaker(TieBreakByName))
 
 		assert.Nil(t, err)
 		assert.True(t, reflect.DeepEqual(wanted, result))
 	})
 
-	t.Run("validates match prefs", func(t *testing.T) {
-		prefs := []core.MatchPreference{
-			{Name: "A", Preferences: []string{"B", "C", "D"}},
-			{Name: "B", Preferences: []string{"A", "C", "D"}},
-			{Name: "C", Preferences: []string{"A", "B", "D"}},
-			{Name: "A", Preferences: []string{"C", "B", "D"}},
+	t.Run("strong stability", func(t *testing.T) {
+		prefsA := []core.MatchPreference{
+			{Name: "A", RankedPreferences: [][]string{{"K", "L"}}},
+			{Name: "B", RankedPreferences: [][]string{{"K", "L"}}},
 		}
 
-		_, err := SolveSRP(&prefs)
+		prefsB := []core.MatchPreference{
+			{Name: "K", Preferences: []string{"A", "B"}},
+			{Name: "L", Preferences: []string{"A", "B"}},
+		}
 
-		assert.Equal(t, "Member names must be unique. Found duplicate entry 'A'", err.Error())
+		_, err := SolveSMP(&prefsA, &prefsB, WithStability(StabilityStrong))
+
+		assert.True(t, errors.Is(err, ErrNoStronglyStableSolution))
 	})
 
-	t.Run("validates preference table", func(t *testing.T) {
-		prefs := []core.MatchPreference{
-			{Name: "A", Preferences: []string{"B", "C", "D"}},
-			{Name: "B", Preferences: []string{"A", "C", "D"}},
-			{Name: "C", Preferences: []string{"A", "B", "D"}},
+	t.Run("super stability", func(t *testing.T) {
+		prefsA := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"K", "L"}},
+			{Name: "B", Preferences: []string{"L", "K"}},
 		}
 
-		_, err := SolveSRP(&prefs)
+		prefsB := []core.MatchPreference{
+			{Name: "K", Preferences: []string{"A", "B"}},
+			{Name: "L", RankedPreferences: [][]string{{"A", "B"}}},
+		}
 
-		assert.Equal(t, "Table must have an even number of members", err.Error())
-	})
-}
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "K",
+				"B": "L",
+				"K": "A",
+				"L": "B",
+			},
+			OptimalSide: core.SideA,
+			Costs:       map[core.Side]int{core.SideA: 2, core.SideB: 2},
+		}
 
-// ExampleSolveSRP solves the "Stable Roommates Problem" for some sample input
-func ExampleSolveSRP() {
-	prefTable := []MatchPreference{
-		{Name: "A", Preferences: []string{"B", "D", "F", "C", "E"}},
-		{Name: "B", Preferences: []string{"D", "E", "F", "A", "C"}},
-		{Name: "C", Preferences: []string{"D", "E", "F", "A", "B"}},
-		{Name: "D", Preferences: []string{"F", "C", "A", "E", "B"}},
-		{Name: "E", Preferences: []string{"F", "C", "D", "B", "A"}},
-		{Name: "F", Preferences: []string{"A", "B", "D", "C", "E"}},
-	}
+		result, err := SolveSMP(&prefsA, &prefsB, WithStability(StabilitySuper))
 
-	// Call `libmatch`
-	result, err := SolveSRP(&prefTable)
-	if err != nil {
-		fmt.Println(err)
-		os.Exit(1)
-	}
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
 
-	// Iterate through the result mapping
-	for x, y := range result.Mapping {
-		fmt.Printf("%v => %v\n", x, y)
-	}
+	t.Run("no super-stable solution", func(t *testing.T) {
+		prefsA := []core.MatchPreference{
+			{Name: "A", RankedPreferences: [][]string{{"K", "L"}}},
+			{Name: "B", RankedPreferences: [][]string{{"K", "L"}}},
+		}
 
-	// Unordered output:
-	// A => F
-	// B => E
-	// C => D
-	// D => C
-	// E => B
-	// F => A
-}
+		prefsB := []core.MatchPreference{
+			{Name: "K", RankedPreferences: [][]string{{"A", "B"}}},
+			{Name: "L", RankedPreferences: [][]string{{"A", "B"}}},
+		}
 
-func ExampleSolveSRP__no_stable_solution() {
-	prefTable := []MatchPreference{
-		{Name: "A", Preferences: []string{"B", "E", "C", "F", "D"}},
-		{Name: "B", Preferences: []string{"C", "F", "E", "A", "D"}},
-		{Name: "C", Preferences: []string{"E", "A", "F", "D", "B"}},
-		{Name: "D", Preferences: []string{"B", "A", "C", "F", "E"}},
-		{Name: "E", Preferences: []string{"A", "C", "D", "B", "F"}},
-		{Name: "F", Preferences: []string{"C", "A", "E", "B", "D"}},
-	}
+		_, err := SolveSMP(&prefsA, &prefsB, WithStability(StabilitySuper))
 
-	// Call `libmatch`
-	_, err := SolveSRP(&prefTable)
+		assert.True(t, errors.Is(err, ErrNoSuperStableSolution))
+	})
 
-	// The above input has no stable matching solution, and we expect it to return an error
-	fmt.Println(err)
+	t.Run("egalitarian objective", func(t *testing.T) {
+		prefsA := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"G", "E", "F", "H"}},
+			{Name: "B", Preferences: []string{"F", "G", "H", "E"}},
+			{Name: "C", Preferences: []string{"H", "F", "G", "E"}},
+			{Name: "D", Preferences: []string{"G", "H", "E", "F"}},
+		}
 
-	// Output:
+		prefsB := []core.MatchPreference{
+			{Name: "E", Preferences: []string{"C", "B", "A", "D"}},
+			{Name: "F", Preferences: []string{"C", "D", "B", "A"}},
+			{Name: "G", Preferences: []string{"D", "B", "C", "A"}},
+			{Name: "H", Preferences: []string{"A", "B", "D", "C"}},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "E", "B": "H", "C": "F", "D": "G",
+				"E": "A", "H": "B", "F": "C", "G": "D",
+			},
+			Costs: map[core.Side]int{core.SideA: 8, core.SideB: 7},
+		}
+
+		result, err := SolveSMP(&prefsA, &prefsB, WithObjective(ObjectiveEgalitarian))
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("minimum regret objective", func(t *testing.T) {
+		prefsA := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"G", "F", "H", "E"}},
+			{Name: "B", Preferences: []string{"G", "H", "E", "F"}},
+			{Name: "C", Preferences: []string{"E", "F", "H", "G"}},
+			{Name: "D", Preferences: []string{"G", "H", "E", "F"}},
+		}
+
+		prefsB := []core.MatchPreference{
+			{Name: "E", Preferences: []string{"B", "A", "D", "C"}},
+			{Name: "F", Preferences: []string{"A", "B", "D", "C"}},
+			{Name: "G", Preferences: []string{"C", "B", "D", "A"}},
+			{Name: "H", Preferences: []string{"B", "A", "C", "D"}},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "F", "B": "G", "C": "H", "D": "E",
+				"F": "A", "G": "B", "H": "C", "E": "D",
+			},
+			Costs: map[core.Side]int{core.SideA: 9, core.SideB: 9},
+		}
+
+		result, err := SolveSMP(&prefsA, &prefsB, WithObjective(ObjectiveMinimumRegret))
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("sex-equal objective", func(t *testing.T) {
+		prefsA := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"G", "E", "F", "H"}},
+			{Name: "B", Preferences: []string{"F", "G", "H", "E"}},
+			{Name: "C", Preferences: []string{"H", "F", "G", "E"}},
+			{Name: "D", Preferences: []string{"G", "H", "E", "F"}},
+		}
+
+		prefsB := []core.MatchPreference{
+			{Name: "E", Preferences: []string{"C", "B", "A", "D"}},
+			{Name: "F", Preferences: []string{"C", "D", "B", "A"}},
+			{Name: "G", Preferences: []string{"D", "B", "C", "A"}},
+			{Name: "H", Preferences: []string{"A", "B", "D", "C"}},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "E", "B": "H", "C": "F", "D": "G",
+				"E": "A", "H": "B", "F": "C", "G": "D",
+			},
+			Costs: map[core.Side]int{core.SideA: 8, core.SideB: 7},
+		}
+
+		result, err := SolveSMP(&prefsA, &prefsB, WithObjective(ObjectiveSexEqual))
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("median objective", func(t *testing.T) {
+		prefsA := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"D", "E", "F"}},
+			{Name: "B", Preferences: []string{"E", "F", "D"}},
+			{Name: "C", Preferences: []string{"F", "D", "E"}},
+		}
+
+		prefsB := []core.MatchPreference{
+			{Name: "D", Preferences: []string{"B", "C", "A"}},
+			{Name: "E", Preferences: []string{"C", "A", "B"}},
+			{Name: "F", Preferences: []string{"A", "B", "C"}},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "E", "B": "F", "C": "D",
+				"E": "A", "F": "B", "D": "C",
+			},
+			Costs: map[core.Side]int{core.SideA: 6, core.SideB: 6},
+		}
+
+		result, err := SolveSMP(&prefsA, &prefsB, WithObjective(ObjectiveMedian))
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("pair cost", func(t *testing.T) {
+		prefsA := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"C", "D"}},
+			{Name: "B", Preferences: []string{"D", "C"}},
+		}
+
+		prefsB := []core.MatchPreference{
+			{Name: "C", Preferences: []string{"B", "A"}},
+			{Name: "D", Preferences: []string{"A", "B"}},
+		}
+
+		// Matching "A" with "C" is expensive
+		cost := func(a, b string) int {
+			if a == "A" && b == "C" {
+				return 10
+			}
+			return 1
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{"A": "D", "B": "C", "D": "A", "C": "B"},
+			Costs:   map[core.Side]int{core.SideA: 4, core.SideB: 2},
+		}
+
+		result, err := SolveSMP(&prefsA, &prefsB, WithPairCost(cost))
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("maximum cardinality objective", func(t *testing.T) {
+		prefsA := []core.MatchPreference{
+			{Name: "A", RankedPreferences: [][]string{{"C", "D"}}},
+			{Name: "B", Preferences: []string{"C"}},
+		}
+
+		prefsB := []core.MatchPreference{
+			{Name: "C", Preferences: []string{"A", "B"}},
+			{Name: "D", Preferences: []string{"A"}},
+		}
+
+		// Breaking the tie in listed order leaves "B" and "D" unmatched
+		result, err := SolveSMP(&prefsA, &prefsB, WithIncompleteLists())
+
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"B", "D"}, result.Unmatched)
+
+		wanted := core.MatchResult{
+			Mapping:        map[string]string{"A": "D", "B": "C", "D": "A", "C": "B"},
+			Costs:          map[core.Side]int{core.SideA: 2, core.SideB: 3},
+			Size:           2,
+			SizeUpperBound: 2,
+		}
+
+		result, err = SolveSMP(&prefsA, &prefsB,
+			WithIncompleteLists(), WithObjective(ObjectiveMaximumCardinality))
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+}
+
+func ExampleSolveSMP() {
+
+	prefTableA := []MatchPreference{
+		{Name: "A", Preferences: []string{"F", "J", "H", "G", "I"}},
+		{Name: "B", Preferences: []string{"F", "J", "H", "G", "I"}},
+		{Name: "C", Preferences: []string{"F", "G", "H", "J", "I"}},
+		{Name: "D", Preferences: []string{"H", "J", "F", "I", "G"}},
+		{Name: "E", Preferences: []string{"H", "F", "G", "I", "J"}},
+	}
+
+	prefTableB := []MatchPreference{
+		{Name: "F", Preferences: []string{"A", "E", "C", "B", "D"}},
+		{Name: "G", Preferences: []string{"D", "E", "C", "B", "A"}},
+		{Name: "H", Preferences: []string{"A", "B", "C", "D", "E"}},
+		{Name: "I", Preferences: []string{"B", "E", "C", "D", "A"}},
+		{Name: "J", Preferences: []string{"E", "A", "D", "B", "C"}},
+	}
+
+	// Call `libmatch`
+	result, err := SolveSMP(&prefTableA, &prefTableB)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	// Iterate through the result mapping
+	for x, y := range result.Mapping {
+		fmt.Printf("%v => %v\n", x, y)
+	}
+
+	// Unordered output:
+	// A => F
+	// B => H
+	// C => I
+	// D => J
+	// E => G
+	// F => A
+	// G => E
+	// H => B
+	// I => C
+	// J => D
+}
+
+func TestEnumerateSMP(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		prefsA := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"D", "E", "F"}},
+			{Name: "B", Preferences: []string{"E", "F", "D"}},
+			{Name: "C", Preferences: []string{"F", "D", "E"}},
+		}
+
+		prefsB := []core.MatchPreference{
+			{Name: "D", Preferences: []string{"B", "C", "A"}},
+			{Name: "E", Preferences: []string{"C", "A", "B"}},
+			{Name: "F", Preferences: []string{"A", "B", "C"}},
+		}
+
+		var mappings []map[string]string
+		err := EnumerateSMP(&prefsA, &prefsB, func(result MatchResult) bool {
+			mappings = append(mappings, result.Mapping)
+			return true
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []map[string]string{
+			{"A": "D", "B": "E", "C": "F", "D": "A", "E": "B", "F": "C"},
+			{"A": "E", "B": "F", "C": "D", "E": "A", "F": "B", "D": "C"},
+			{"A": "F", "B": "D", "C": "E", "F": "A", "D": "B", "E": "C"},
+		}, mappings)
+	})
+
+	t.Run("second table proposes", func(t *testing.T) {
+		prefsA := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"D", "E", "F"}},
+			{Name: "B", Preferences: []string{"E", "F", "D"}},
+			{Name: "C", Preferences: []string{"F", "D", "E"}},
+		}
+
+		prefsB := []core.MatchPreference{
+			{Name: "D", Preferences: []string{"B", "C", "A"}},
+			{Name: "E", Preferences: []string{"C", "A", "B"}},
+			{Name: "F", Preferences: []string{"A", "B", "C"}},
+		}
+
+		var mappings []map[string]string
+		err := EnumerateSMP(&prefsA, &prefsB, func(result MatchResult) bool {
+			mappings = append(mappings, result.Mapping)
+			return true
+		}, WithProposer(SideB))
+
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(mappings))
+		assert.Equal(t, "B", mappings[0]["D"])
+		assert.Equal(t, "A", mappings[2]["D"])
+	})
+
+	t.Run("validates preference table", func(t *testing.T) {
+		prefsA := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"D", "E", "F"}},
+			{Name: "B", Preferences: []string{"E", "F", "D"}},
+			{Name: "C", Preferences: []string{"F", "D", "E"}},
+			{Name: "A", Preferences: []string{"D", "E", "F"}},
+		}
+
+		prefsB := []core.MatchPreference{
+			{Name: "D", Preferences: []string{"B", "C", "A"}},
+			{Name: "E", Preferences: []string{"C", "A", "B"}},
+			{Name: "F", Preferences: []string{"A", "B", "C"}},
+		}
+
+		err := EnumerateSMP(&prefsA, &prefsB, func(result MatchResult) bool {
+			return true
+		})
+
+		assert.Equal(t, "Member names must be unique. Found duplicate entry 'A'", err.Error())
+	})
+}
+
+func TestSolveSRP(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		prefs := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "C", "D"}},
+			{Name: "B", Preferences: []string{"A", "C", "D"}},
+			{Name: "C", Preferences: []string{"A", "B", "D"}},
+			{Name: "D", Preferences: []string{"A", "B", "C"}},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "B",
+				"B": "A",
+				"C": "D",
+				"D": "C",
+			},
+		}
+
+		result, err := SolveSRP(&prefs)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("no stable solution", func(t *testing.T) {
+		prefs := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "E", "C", "F", "D"}},
+			{Name: "B", Preferences: []string{"C", "F", "E", "A", "D"}},
+			{Name: "C", Preferences: []string{"E", "A", "F", "D", "B"}},
+			{Name: "D", Preferences: []string{"B", "A", "C", "F", "E"}},
+			{Name: "E", Preferences: []string{"A", "C", "D", "B", "F"}},
+			{Name: "F", Preferences: []string{"C", "A", "E", "B", "D"}},
+		}
+
+		_, err := SolveSRP(&prefs)
+
+		assert.Equal(t, "No stable solution exists", err.Error())
+	})
+
+	t.Run("maximum stable matching", func(t *testing.T) {
+		prefs := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "E", "C", "F", "D"}},
+			{Name: "B", Preferences: []string{"C", "F", "E", "A", "D"}},
+			{Name: "C", Preferences: []string{"E", "A", "F", "D", "B"}},
+			{Name: "D", Preferences: []string{"B", "A", "C", "F", "E"}},
+			{Name: "E", Preferences: []string{"A", "C", "D", "B", "F"}},
+			{Name: "F", Preferences: []string{"C", "A", "E", "B", "D"}},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"B": "F",
+				"C": "E",
+				"E": "C",
+				"F": "B",
+			},
+			Unmatched: []string{"A", "D"},
+		}
+
+		result, err := SolveSRP(&prefs, WithMaximumStableMatching())
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("capacities", func(t *testing.T) {
+		prefs := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "C", "D", "E", "F"}, Capacity: 2},
+			{Name: "B", Preferences: []string{"C", "A", "E", "F", "D"}, Capacity: 2},
+			{Name: "C", Preferences: []string{"A", "B", "F", "D", "E"}, Capacity: 2},
+			{Name: "D", Preferences: []string{"E", "F", "A", "B", "C"}, Capacity: 3},
+			{Name: "E", Preferences: []string{"F", "D", "B", "C", "A"}, Capacity: 2},
+			{Name: "F", Preferences: []string{"D", "E", "C", "A", "B"}},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{},
+			Assignments: map[string][]string{
+				"A": {"B", "C"},
+				"B": {"C", "A"},
+				"C": {"A", "B"},
+				"D": {"E", "F"},
+				"E": {"D"},
+				"F": {"D"},
+			},
+		}
+
+		result, err := SolveSRP(&prefs)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("capacities with an odd number of members", func(t *testing.T) {
+		prefs := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "C"}, Capacity: 2},
+			{Name: "B", Preferences: []string{"C", "A"}, Capacity: 2},
+			{Name: "C", Preferences: []string{"A", "B"}, Capacity: 2},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{},
+			Assignments: map[string][]string{
+				"A": {"B", "C"},
+				"B": {"C", "A"},
+				"C": {"A", "B"},
+			},
+		}
+
+		result, err := SolveSRP(&prefs)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("is not dependent on order", func(t *testing.T) {
+		prefs := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "C", "D"}},
+			{Name: "D", Preferences: []string{"A", "B", "C"}},
+			{Name: "C", Preferences: []string{"A", "B", "D"}},
+			{Name: "B", Preferences: []string{"A", "C", "D"}},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "B",
+				"B": "A",
+				"C": "D",
+				"D": "C",
+			},
+		}
+
+		result, err := SolveSRP(&prefs)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("validates match prefs", func(t *testing.T) {
+		prefs := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "C", "D"}},
+			{Name: "B", Preferences: []string{"A", "C", "D"}},
+			{Name: "C", Preferences: []string{"A", "B", "D"}},
+			{Name: "A", Preferences: []string{"C", "B", "D"}},
+		}
+
+		_, err := SolveSRP(&prefs)
+
+		assert.Equal(t, "Member names must be unique. Found duplicate entry 'A'", err.Error())
+	})
+
+	t.Run("validates preference table", func(t *testing.T) {
+		prefs := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "C", "D"}},
+			{Name: "B", Preferences: []string{"A", "C", "D"}},
+			{Name: "C", Preferences: []string{"A", "B", "D"}},
+		}
+
+		_, err := SolveSRP(&prefs)
+
+		assert.Equal(t, "Table must have an even number of members", err.Error())
+	})
+
+	t.Run("incomplete lists", func(t *testing.T) {
+		prefs := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "C", "D"}},
+			{Name: "B", Preferences: []string{"A", "C"}},
+			{Name: "C", Preferences: []string{"A", "B"}},
+			{Name: "D", Preferences: []string{"A"}},
+			{Name: "E", Preferences: []string{}},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "B",
+				"B": "A",
+			},
+			Unmatched: []string{"C", "D", "E"},
+		}
+
+		result, err := SolveSRP(&prefs, WithIncompleteLists())
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("incomplete lists with no stable solution", func(t *testing.T) {
+		prefs := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "C"}},
+			{Name: "B", Preferences: []string{"C", "A"}},
+			{Name: "C", Preferences: []string{"A", "B"}},
+		}
+
+		_, err := SolveSRP(&prefs, WithIncompleteLists())
+
+		assert.Equal(t, "No stable solution exists", err.Error())
+	})
+
+	t.Run("incomplete lists are validated", func(t *testing.T) {
+		prefs := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "X"}},
+			{Name: "B", Preferences: []string{"A"}},
+		}
+
+		_, err := SolveSRP(&prefs, WithIncompleteLists())
+
+		assert.Equal(t, "Preference list for 'A' contains at least one unknown member", err.Error())
+	})
+}
+
+// ExampleSolveSRP solves the "Stable Roommates Problem" for some sample input
+func ExampleSolveSRP() {
+	prefTable := []MatchPreference{
+		{Name: "A", Preferences: []string{"B", "D", "F", "C", "E"}},
+		{Name: "B", Preferences: []string{"D", "E", "F", "A", "C"}},
+		{Name: "C", Preferences: []string{"D", "E", "F", "A", "B"}},
+		{Name: "D", Preferences: []string{"F", "C", "A", "E", "B"}},
+		{Name: "E", Preferences: []string{"F", "C", "D", "B", "A"}},
+		{Name: "F", Preferences: []string{"A", "B", "D", "C", "E"}},
+	}
+
+	// Call `libmatch`
+	result, err := SolveSRP(&prefTable)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	// Iterate through the result mapping
+	for x, y := range result.Mapping {
+		fmt.Printf("%v => %v\n", x, y)
+	}
+
+	// Unordered output:
+	// A => F
+	// B => E
+	// C => D
+	// D => C
+	// E => B
+	// F => A
+}
+
+func ExampleSolveSRP__no_stable_solution() {
+	prefTable := []MatchPreference{
+		{Name: "A", Preferences: []string{"B", "E", "C", "F", "D"}},
+		{Name: "B", Preferences: []string{"C", "F", "E", "A", "D"}},
+		{Name: "C", Preferences: []string{"E", "A", "F", "D", "B"}},
+		{Name: "D", Preferences: []string{"B", "A", "C", "F", "E"}},
+		{Name: "E", Preferences: []string{"A", "C", "D", "B", "F"}},
+		{Name: "F", Preferences: []string{"C", "A", "E", "B", "D"}},
+	}
+
+	// Call `libmatch`
+	_, err := SolveSRP(&prefTable)
+
+	// The above input has no stable matching solution, and we expect it to return an error
+	fmt.Println(err)
+
+	// Output:
 	// No stable solution exists
 }
+
+func TestSolveHR(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		residents := []core.MatchPreference{
+			{Name: "R1", Preferences: []string{"H1", "H2"}},
+			{Name: "R2", Preferences: []string{"H1", "H2"}},
+			{Name: "R3", Preferences: []string{"H2", "H1"}},
+		}
+
+		hospitals := []core.MatchPreference{
+			{Name: "H1", Preferences: []string{"R3", "R2", "R1"}, Capacity: 2},
+			{Name: "H2", Preferences: []string{"R1", "R2", "R3"}},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"R1": "H1",
+				"R2": "H1",
+				"R3": "H2",
+			},
+			Assignments: map[string][]string{
+				"H1": {"R2", "R1"},
+				"H2": {"R3"},
+			},
+			OptimalSide: core.SideA,
+		}
+
+		result, err := SolveHR(&residents, &hospitals)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("hospitals propose", func(t *testing.T) {
+		residents := []core.MatchPreference{
+			{Name: "R1", Preferences: []string{"H1", "H2"}},
+			{Name: "R2", Preferences: []string{"H1", "H2"}},
+			{Name: "R3", Preferences: []string{"H2", "H1"}},
+		}
+
+		hospitals := []core.MatchPreference{
+			{Name: "H1", Preferences: []string{"R3", "R2", "R1"}, Capacity: 2},
+			{Name: "H2", Preferences: []string{"R1", "R2", "R3"}},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"R1": "H2",
+				"R2": "H1",
+				"R3": "H1",
+			},
+			Assignments: map[string][]string{
+				"H1": {"R3", "R2"},
+				"H2": {"R1"},
+			},
+			OptimalSide: core.SideB,
+		}
+
+		result, err := SolveHR(&residents, &hospitals, WithProposer(SideB))
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("more residents than places", func(t *testing.T) {
+		residents := []core.MatchPreference{
+			{Name: "R1", Preferences: []string{"H1", "H2"}},
+			{Name: "R2", Preferences: []string{"H1", "H2"}},
+			{Name: "R3", Preferences: []string{"H2", "H1"}},
+		}
+
+		hospitals := []core.MatchPreference{
+			{Name: "H1", Preferences: []string{"R3", "R2", "R1"}},
+			{Name: "H2", Preferences: []string{"R1", "R2", "R3"}},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"R1": "H2",
+				"R3": "H1",
+			},
+			Assignments: map[string][]string{
+				"H1": {"R3"},
+				"H2": {"R1"},
+			},
+			Unmatched:   []string{"R2"},
+			OptimalSide: core.SideA,
+		}
+
+		result, err := SolveHR(&residents, &hospitals)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("with incomplete lists", func(t *testing.T) {
+		residents := []core.MatchPreference{
+			{Name: "R1", Preferences: []string{"H1"}},
+			{Name: "R2", Preferences: []string{"H1", "H2"}},
+			{Name: "R3", Preferences: []string{"H2"}},
+		}
+
+		hospitals := []core.MatchPreference{
+			{Name: "H1", Preferences: []string{"R2", "R1"}},
+			{Name: "H2", Preferences: []string{"R2", "R3"}},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"R2": "H1",
+				"R3": "H2",
+			},
+			Assignments: map[string][]string{
+				"H1": {"R2"},
+				"H2": {"R3"},
+			},
+			Unmatched:   []string{"R1"},
+			OptimalSide: core.SideA,
+		}
+
+		result, err := SolveHR(&residents, &hospitals, WithIncompleteLists())
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("validates preference table", func(t *testing.T) {
+		residents := []core.MatchPreference{
+			{Name: "R1", Preferences: []string{"H1"}},
+			{Name: "R2", Preferences: []string{"H1", "H2"}},
+		}
+
+		hospitals := []core.MatchPreference{
+			{Name: "H1", Preferences: []string{"R2", "R1"}},
+			{Name: "H2", Preferences: []string{"R1", "R2"}},
+		}
+
+		_, err := SolveHR(&residents, &hospitals)
+
+		assert.Equal(t,
+			"Preference list for 'R1' does not contain all the required members", err.Error())
+	})
+}
+
+func ExampleSolveHR() {
+	residents := []MatchPreference{
+		{Name: "R1", Preferences: []string{"H1", "H2", "H3"}},
+		{Name: "R2", Preferences: []string{"H1", "H3", "H2"}},
+		{Name: "R3", Preferences: []string{"H2", "H1", "H3"}},
+		{Name: "R4", Preferences: []string{"H1", "H2", "H3"}},
+		{Name: "R5", Preferences: []string{"H3", "H1", "H2"}},
+	}
+
+	hospitals := []MatchPreference{
+		{Name: "H1", Preferences: []string{"R3", "R5", "R1", "R4", "R2"}, Capacity: 2},
+		{Name: "H2", Preferences: []string{"R1", "R2", "R3", "R4", "R5"}, Capacity: 2},
+		{Name: "H3", Preferences: []string{"R2", "R4", "R1", "R5", "R3"}, Capacity: 1},
+	}
+
+	// Call `libmatch`
+	result, err := SolveHR(&residents, &hospitals)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	// Iterate through the result assignments
+	for hospital, assigned := range result.Assignments {
+		fmt.Printf("%v => %v\n", hospital, assigned)
+	}
+
+	// Unordered output:
+	// H1 => [R5 R1]
+	// H2 => [R3 R4]
+	// H3 => [R2]
+}
+
+func TestSolveHRC(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		residents := []core.MatchPreference{
+			{Name: "R1", Preferences: []string{"H2", "H3"}},
+			{Name: "R2", Preferences: []string{"H1"}},
+			{Name: "R3"},
+			{Name: "R4"},
+		}
+
+		hospitals := []core.MatchPreference{
+			{Name: "H1", Preferences: []string{"R2", "R3"}},
+			{Name: "H2", Preferences: []string{"R4", "R1"}},
+			{Name: "H3", Preferences: []string{"R3", "R4", "R1"}, Capacity: 2},
+		}
+
+		couples := []core.CouplePreference{
+			{Name: "C1", Members: []string{"R3", "R4"}, Preferences: [][]string{{"H1", "H2"}, {"H3", "H3"}}},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"R1": "H2",
+				"R2": "H1",
+				"R3": "H3",
+				"R4": "H3",
+			},
+			Assignments: map[string][]string{
+				"H1": {"R2"},
+				"H2": {"R1"},
+				"H3": {"R3", "R4"},
+			},
+		}
+
+		result, err := SolveHRC(&residents, &hospitals, &couples)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("without couples", func(t *testing.T) {
+		residents := []core.MatchPreference{
+			{Name: "R1", Preferences: []string{"H1", "H2"}},
+			{Name: "R2", Preferences: []string{"H1"}},
+		}
+
+		hospitals := []core.MatchPreference{
+			{Name: "H1", Preferences: []string{"R2", "R1"}},
+			{Name: "H2", Preferences: []string{"R1"}},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"R1": "H2",
+				"R2": "H1",
+			},
+			Assignments: map[string][]string{
+				"H1": {"R2"},
+				"H2": {"R1"},
+			},
+		}
+
+		result, err := SolveHRC(&residents, &hospitals, nil)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("no stable matching found", func(t *testing.T) {
+		residents := []core.MatchPreference{
+			{Name: "R1"},
+			{Name: "R2"},
+			{Name: "R3", Preferences: []string{"H1", "H2"}},
+		}
+
+		hospitals := []core.MatchPreference{
+			{Name: "H1", Preferences: []string{"R1", "R3"}},
+			{Name: "H2", Preferences: []string{"R3", "R2"}},
+		}
+
+		couples := []core.CouplePreference{
+			{Name: "C1", Members: []string{"R1", "R2"}, Preferences: [][]string{{"H1", "H2"}}},
+		}
+
+		result, err := SolveHRC(&residents, &hospitals, &couples, WithSearchLimit(20))
+
+		assert.True(t, errors.Is(err, ErrNoStableMatchingFound))
+		assert.Equal(t, map[string][]string{"R3": {"H2"}}, result.BlockingPairs)
+	})
+
+	t.Run("validates preference tables", func(t *testing.T) {
+		residents := []core.MatchPreference{
+			{Name: "R1", Preferences: []string{"H1"}},
+			{Name: "R1", Preferences: []string{"H1"}},
+		}
+
+		hospitals := []core.MatchPreference{
+			{Name: "H1", Preferences: []string{"R1"}},
+		}
+
+		_, err := SolveHRC(&residents, &hospitals, nil)
+
+		assert.Equal(t, "Member names must be unique. Found duplicate entry 'R1'", err.Error())
+	})
+
+	t.Run("validates couples", func(t *testing.T) {
+		residents := []core.MatchPreference{
+			{Name: "R1", Preferences: []string{"H1"}},
+			{Name: "R2"},
+		}
+
+		hospitals := []core.MatchPreference{
+			{Name: "H1", Preferences: []string{"R1", "R2"}, Capacity: 2},
+		}
+
+		couples := []core.CouplePreference{
+			{Name: "C1", Members: []string{"R1", "R2"}, Preferences: [][]string{{"H1", "H1"}}},
+		}
+
+		_, err := SolveHRC(&residents, &hospitals, &couples)
+
+		assert.Equal(t,
+			"Preference list for 'R1' must be empty, since they apply as part of couple 'C1'", err.Error())
+	})
+}
+
+func ExampleSolveHRC() {
+	residents := []MatchPreference{
+		{Name: "R1", Preferences: []string{"H2", "H3"}},
+		{Name: "R2", Preferences: []string{"H1"}},
+		{Name: "R3"},
+		{Name: "R4"},
+	}
+
+	hospitals := []MatchPreference{
+		{Name: "H1", Preferences: []string{"R2", "R3"}},
+		{Name: "H2", Preferences: []string{"R4", "R1"}},
+		{Name: "H3", Preferences: []string{"R3", "R4", "R1"}, Capacity: 2},
+	}
+
+	// "R3" and "R4" rank pairs of hospitals together
+	couples := []CouplePreference{
+		{Name: "C1", Members: []string{"R3", "R4"}, Preferences: [][]string{{"H1", "H2"}, {"H3", "H3"}}},
+	}
+
+	// Call `libmatch`
+	result, err := SolveHRC(&residents, &hospitals, &couples)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	// Iterate through the result assignments
+	for hospital, assigned := range result.Assignments {
+		fmt.Printf("%v => %v\n", hospital, assigned)
+	}
+
+	// Unordered output:
+	// H1 => [R2]
+	// H2 => [R1]
+	// H3 => [R3 R4]
+}
+
+func TestSolveHRLQ(t *testing.T) {
+	residents := []core.MatchPreference{
+		{Name: "R1", Preferences: []string{"H1"}},
+		{Name: "R2", Preferences: []string{"H2", "H3"}},
+		{Name: "R3", Preferences: []string{"H3"}},
+	}
+
+	hospitals := []core.MatchPreference{
+		{Name: "H1", Preferences: []string{"R1"}},
+		{Name: "H2", Preferences: []string{"R2"}, Capacity: 3, LowerQuota: 3},
+		{Name: "H3", Preferences: []string{"R3", "R2"}, Capacity: 2, LowerQuota: 2},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		residents := []core.MatchPreference{
+			{Name: "R1", Preferences: []string{"H1", "H2"}},
+			{Name: "R2", Preferences: []string{"H1", "H2"}},
+			{Name: "R3", Preferences: []string{"H2"}},
+		}
+
+		hospitals := []core.MatchPreference{
+			{Name: "H1", Preferences: []string{"R2", "R1"}},
+			{Name: "H2", Preferences: []string{"R1", "R3"}, Capacity: 2, LowerQuota: 2},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"R1": "H2",
+				"R2": "H1",
+				"R3": "H2",
+			},
+			Assignments: map[string][]string{
+				"H1": {"R2"},
+				"H2": {"R1", "R3"},
+			},
+		}
+
+		result, err := SolveHRLQ(&residents, &hospitals)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("lower quotas not met", func(t *testing.T) {
+		result, err := SolveHRLQ(&residents, &hospitals)
+
+		assert.True(t, errors.Is(err, ErrLowerQuotasNotMet))
+		assert.Equal(t, map[string]string{"R1": "H1", "R2": "H2", "R3": "H3"}, result.Mapping)
+		assert.Nil(t, result.Closed)
+	})
+
+	t.Run("closing underfilled hospitals", func(t *testing.T) {
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"R1": "H1",
+				"R2": "H3",
+				"R3": "H3",
+			},
+			Assignments: map[string][]string{
+				"H1": {"R1"},
+				"H2": {},
+				"H3": {"R3", "R2"},
+			},
+			Closed: []string{"H2"},
+		}
+
+		result, err := SolveHRLQ(&residents, &hospitals, WithCloseUnderfilled())
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("validates preference tables", func(t *testing.T) {
+		hospitals := []core.MatchPreference{
+			{Name: "H1", Preferences: []string{"R1"}},
+			{Name: "H2", Preferences: []string{"R2"}, Capacity: 1, LowerQuota: 2},
+			{Name: "H3", Preferences: []string{"R3", "R2"}},
+		}
+
+		_, err := SolveHRLQ(&residents, &hospitals)
+
+		assert.Equal(t, "Lower quota for 'H2' can not be greater than its capacity", err.Error())
+	})
+}
+
+func ExampleSolveHRLQ() {
+	residents := []MatchPreference{
+		{Name: "R1", Preferences: []string{"H1"}},
+		{Name: "R2", Preferences: []string{"H2", "H3"}},
+		{Name: "R3", Preferences: []string{"H3"}},
+	}
+
+	hospitals := []MatchPreference{
+		{Name: "H1", Preferences: []string{"R1"}},
+		{Name: "H2", Preferences: []string{"R2"}, Capacity: 3, LowerQuota: 3},
+		{Name: "H3", Preferences: []string{"R3", "R2"}, Capacity: 2, LowerQuota: 2},
+	}
+
+	// Call `libmatch`, closing hospitals that can not meet their lower quota
+	result, err := SolveHRLQ(&residents, &hospitals, WithCloseUnderfilled())
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	// Iterate through the result assignments
+	for hospital, assigned := range result.Assignments {
+		fmt.Printf("%v => %v\n", hospital, assigned)
+	}
+
+	fmt.Printf("Closed: %v\n", result.Closed)
+
+	// Unordered output:
+	// H1 => [R1]
+	// H2 => []
+	// H3 => [R3 R2]
+	// Closed: [H2]
+}
+
+func TestSolveSPA(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		students := []core.MatchPreference{
+			{Name: "S1", Preferences: []string{"P1", "P2"}},
+			{Name: "S2", Preferences: []string{"P1"}},
+			{Name: "S3", Preferences: []string{"P2", "P3"}},
+		}
+
+		projects := []core.MatchPreference{
+			{Name: "P1", Owner: "L1"},
+			{Name: "P2", Owner: "L1"},
+			{Name: "P3", Owner: "L2"},
+		}
+
+		lecturers := []core.MatchPreference{
+			{Name: "L1", Preferences: []string{"S2", "S1", "S3"}, Capacity: 2},
+			{Name: "L2", Preferences: []string{"S3"}},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"S1": "P2",
+				"S2": "P1",
+				"S3": "P3",
+			},
+			Assignments: map[string][]string{
+				"P1": {"S2"},
+				"P2": {"S1"},
+				"P3": {"S3"},
+			},
+			Allocations: map[string]map[string][]string{
+				"L1": {"P1": {"S2"}, "P2": {"S1"}},
+				"L2": {"P3": {"S3"}},
+			},
+			OptimalSide: core.SideA,
+		}
+
+		result, err := SolveSPA(&students, &projects, &lecturers)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("unmatched students", func(t *testing.T) {
+		students := []core.MatchPreference{
+			{Name: "S1", Preferences: []string{"P1"}},
+			{Name: "S2", Preferences: []string{"P2"}},
+		}
+
+		projects := []core.MatchPreference{
+			{Name: "P1", Owner: "L1"},
+			{Name: "P2", Owner: "L1"},
+		}
+
+		lecturers := []core.MatchPreference{
+			{Name: "L1", Preferences: []string{"S2", "S1"}},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"S2": "P2",
+			},
+			Assignments: map[string][]string{
+				"P1": {},
+				"P2": {"S2"},
+			},
+			Allocations: map[string]map[string][]string{
+				"L1": {"P1": {}, "P2": {"S2"}},
+			},
+			Unmatched:   []string{"S1"},
+			OptimalSide: core.SideA,
+		}
+
+		result, err := SolveSPA(&students, &projects, &lecturers)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("validates preference tables", func(t *testing.T) {
+		students := []core.MatchPreference{
+			{Name: "S1", Preferences: []string{"P1"}},
+		}
+
+		projects := []core.MatchPreference{
+			{Name: "P1", Owner: "L2"},
+		}
+
+		lecturers := []core.MatchPreference{
+			{Name: "L1", Preferences: []string{"S1"}},
+		}
+
+		_, err := SolveSPA(&students, &projects, &lecturers)
+
+		assert.Equal(t, "Owner of 'P1' must be a member of the third table", err.Error())
+	})
+}
+
+func ExampleSolveSPA() {
+	students := []MatchPreference{
+		{Name: "S1", Preferences: []string{"P1", "P3"}},
+		{Name: "S2", Preferences: []string{"P1", "P2"}},
+		{Name: "S3", Preferences: []string{"P2", "P3"}},
+		{Name: "S4", Preferences: []string{"P3"}},
+		{Name: "S5", Preferences: []string{"P2", "P1"}},
+	}
+
+	projects := []MatchPreference{
+		{Name: "P1", Owner: "L1"},
+		{Name: "P2", Owner: "L1"},
+		{Name: "P3", Owner: "L2", Capacity: 2},
+	}
+
+	lecturers := []MatchPreference{
+		{Name: "L1", Preferences: []string{"S3", "S1", "S5", "S2"}, Capacity: 2},
+		{Name: "L2", Preferences: []string{"S4", "S3", "S1"}, Capacity: 1},
+	}
+
+	// Call `libmatch`
+	result, err := SolveSPA(&students, &projects, &lecturers)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	// Iterate through the result allocations
+	for lecturer, assigned := range result.Allocations {
+		fmt.Printf("%v => %v\n", lecturer, assigned)
+	}
+	fmt.Printf("Unmatched => %v\n", result.Unmatched)
+
+	// Unordered output:
+	// L1 => map[P1:[S1] P2:[S3]]
+	// L2 => map[P3:[S4]]
+	// Unmatched => [S2 S5]
+}
+
+func TestSolveTTC(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		students := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"H3", "H2", "H1"}},
+			{Name: "B", Preferences: []string{"H1", "H4", "H2"}},
+			{Name: "C", Preferences: []string{"H1", "H3"}},
+			{Name: "D", Preferences: []string{"H3", "H2", "H4"}},
+		}
+
+		houses := []core.MatchPreference{
+			{Name: "H1", Owner: "A"},
+			{Name: "H2", Owner: "B"},
+			{Name: "H3", Owner: "C"},
+			{Name: "H4", Owner: "D"},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "H3",
+				"B": "H4",
+				"C": "H1",
+				"D": "H2",
+			},
+			Assignments: map[string][]string{
+				"H1": {"C"},
+				"H2": {"D"},
+				"H3": {"A"},
+				"H4": {"B"},
+			},
+		}
+
+		result, err := SolveTTC(&students, &houses)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("unmatched students", func(t *testing.T) {
+		students := []core.MatchPreference{
+			{Name: "S1", Preferences: []string{"X"}},
+			{Name: "S2", Preferences: []string{"X"}},
+		}
+
+		schools := []core.MatchPreference{
+			{Name: "X", Preferences: []string{"S2", "S1"}},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"S2": "X",
+			},
+			Assignments: map[string][]string{
+				"X": {"S2"},
+			},
+			Unmatched: []string{"S1"},
+		}
+
+		result, err := SolveTTC(&students, &schools)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("validates preference tables", func(t *testing.T) {
+		students := []core.MatchPreference{
+			{Name: "S1", Preferences: []string{"X"}},
+		}
+
+		schools := []core.MatchPreference{
+			{Name: "X", Owner: "S2"},
+		}
+
+		_, err := SolveTTC(&students, &schools)
+
+		assert.Equal(t, "Owner of 'X' must be a member of the first table", err.Error())
+	})
+}
+
+func ExampleSolveTTC() {
+	students := []MatchPreference{
+		{Name: "S1", Preferences: []string{"X", "Y", "Z"}},
+		{Name: "S2", Preferences: []string{"X", "Z", "Y"}},
+		{Name: "S3", Preferences: []string{"Y", "X", "Z"}},
+		{Name: "S4", Preferences: []string{"X", "Y"}},
+		{Name: "S5", Preferences: []string{"Z", "X"}},
+	}
+
+	schools := []MatchPreference{
+		{Name: "X", Preferences: []string{"S3", "S5", "S1", "S2", "S4"}, Capacity: 2},
+		{Name: "Y", Preferences: []string{"S1", "S2", "S3", "S4", "S5"}, Capacity: 1},
+		{Name: "Z", Preferences: []string{"S4", "S2", "S1", "S3", "S5"}, Capacity: 2},
+	}
+
+	// Call `libmatch`
+	result, err := SolveTTC(&students, &schools)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	// Iterate through the result assignments
+	for school, assigned := range result.Assignments {
+		fmt.Printf("%v => %v\n", school, assigned)
+	}
+
+	// Unordered output:
+	// X => [S1 S4]
+	// Y => [S3]
+	// Z => [S2 S5]
+}
+
+func TestSolveSD(t *testing.T) {
+	members := []core.MatchPreference{
+		{Name: "A", Preferences: []string{"Y", "X"}},
+		{Name: "B", Preferences: []string{"Y", "Z"}},
+		{Name: "C", Preferences: []string{"X"}},
+		{Name: "D", Preferences: []string{"Y", "X", "Z"}},
+		{Name: "E", Preferences: []string{"Z"}},
+	}
+
+	items := []core.MatchPreference{
+		{Name: "X", Capacity: 2},
+		{Name: "Y"},
+		{Name: "Z"},
+	}
+
+	t.Run("with priority order", func(t *testing.T) {
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "X",
+				"B": "Z",
+				"C": "X",
+				"D": "Y",
+			},
+			Assignments: map[string][]string{
+				"X": {"A", "C"},
+				"Y": {"D"},
+				"Z": {"B"},
+			},
+			Unmatched: []string{"E"},
+		}
+
+		result, err := SolveSD(&members, &items, WithPriorityOrder([]string{"D", "A", "B", "C", "E"}))
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("picks in the order listed by default", func(t *testing.T) {
+		reversed := []core.MatchPreference{
+			members[4], members[3], members[2], members[1], members[0],
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "X",
+				"C": "X",
+				"D": "Y",
+				"E": "Z",
+			},
+			Assignments: map[string][]string{
+				"X": {"C", "A"},
+				"Y": {"D"},
+				"Z": {"E"},
+			},
+			Unmatched: []string{"B"},
+		}
+
+		result, err := SolveSD(&reversed, &items)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("validates priority order", func(t *testing.T) {
+		_, err := SolveSD(&members, &items, WithPriorityOrder([]string{"D", "A"}))
+
+		assert.Equal(t, "Priority order must contain every member of the first table", err.Error())
+	})
+}
+
+func TestSolveRSD(t *testing.T) {
+	members := []core.MatchPreference{
+		{Name: "A", Preferences: []string{"X", "Y"}},
+		{Name: "B", Preferences: []string{"X", "Y"}},
+	}
+
+	items := []core.MatchPreference{
+		{Name: "X"},
+		{Name: "Y"},
+	}
+
+	t.Run("single draw", func(t *testing.T) {
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "X",
+				"B": "Y",
+			},
+			Assignments: map[string][]string{
+				"X": {"A"},
+				"Y": {"B"},
+			},
+		}
+
+		result, err := SolveRSD(&members, &items, WithSeed(1))
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("many draws", func(t *testing.T) {
+		wanted := core.MatchResult{
+			Mapping: map[string]string{},
+			Probabilities: map[string]map[string]float64{
+				"A": {"X": 0.515, "Y": 0.485},
+				"B": {"X": 0.485, "Y": 0.515},
+			},
+		}
+
+		result, err := SolveRSD(&members, &items, WithDraws(1000))
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("validates preference tables", func(t *testing.T) {
+		members := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"X", "Z"}},
+		}
+
+		_, err := SolveRSD(&members, &items)
+
+		assert.Equal(t, "Preference list for 'A' contains at least one unknown member", err.Error())
+	})
+}
+
+func ExampleSolveSD() {
+	members := []MatchPreference{
+		{Name: "A", Preferences: []string{"Y", "X"}},
+		{Name: "B", Preferences: []string{"Y", "Z"}},
+		{Name: "C", Preferences: []string{"X"}},
+		{Name: "D", Preferences: []string{"Y", "X", "Z"}},
+		{Name: "E", Preferences: []string{"Z"}},
+	}
+
+	items := []MatchPreference{
+		{Name: "X", Capacity: 2},
+		{Name: "Y"},
+		{Name: "Z"},
+	}
+
+	// Call `libmatch`
+	result, err := SolveSD(&members, &items, WithPriorityOrder([]string{"D", "A", "B", "C", "E"}))
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	// Iterate through the result assignments
+	for item, assigned := range result.Assignments {
+		fmt.Printf("%v => %v\n", item, assigned)
+	}
+	fmt.Printf("Unmatched => %v\n", result.Unmatched)
+
+	// Unordered output:
+	// X => [A C]
+	// Y => [D]
+	// Z => [B]
+	// Unmatched => [E]
+}
+
+func TestSolvePS(t *testing.T) {
+	members := []core.MatchPreference{
+		{Name: "A", Preferences: []string{"X", "Y", "Z"}},
+		{Name: "B", Preferences: []string{"X", "Z", "Y"}},
+		{Name: "C", Preferences: []string{"Y", "X", "Z"}},
+	}
+
+	items := []core.MatchPreference{
+		{Name: "X"},
+		{Name: "Y"},
+		{Name: "Z"},
+	}
+
+	probabilities := map[string]map[string]float64{
+		"A": {"X": 0.5, "Y": 0.25, "Z": 0.25},
+		"B": {"X": 0.5, "Z": 0.5},
+		"C": {"Y": 0.75, "Z": 0.25},
+	}
+
+	decomposition := []core.WeightedMatching{
+		{Probability: 0.5, Mapping: map[string]string{"A": "X", "B": "Z", "C": "Y"}},
+		{Probability: 0.25, Mapping: map[string]string{"A": "Y", "B": "X", "C": "Z"}},
+		{Probability: 0.25, Mapping: map[string]string{"A": "Z", "B": "X", "C": "Y"}},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		wanted := core.MatchResult{
+			Mapping:       map[string]string{},
+			Probabilities: probabilities,
+			Decomposition: decomposition,
+		}
+
+		result, err := SolvePS(&members, &items)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("with sample", func(t *testing.T) {
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "X",
+				"B": "Z",
+				"C": "Y",
+			},
+			Assignments: map[string][]string{
+				"X": {"A"},
+				"Y": {"C"},
+				"Z": {"B"},
+			},
+			Probabilities: probabilities,
+			Decomposition: decomposition,
+		}
+
+		result, err := SolvePS(&members, &items, WithSample(), WithSeed(42))
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("validates preference tables", func(t *testing.T) {
+		members := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"X", "W"}},
+		}
+
+		_, err := SolvePS(&members, &items)
+
+		assert.Equal(t, "Preference list for 'A' contains at least one unknown member", err.Error())
+	})
+}
+
+func ExampleSolvePS() {
+	members := []MatchPreference{
+		{Name: "A", Preferences: []string{"X", "Y", "Z"}},
+		{Name: "B", Preferences: []string{"X", "Z", "Y"}},
+		{Name: "C", Preferences: []string{"Y", "X", "Z"}},
+	}
+
+	items := []MatchPreference{
+		{Name: "X"},
+		{Name: "Y"},
+		{Name: "Z"},
+	}
+
+	// Call `libmatch`
+	result, err := SolvePS(&members, &items)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	// Iterate through the result probabilities
+	for member, probabilities := range result.Probabilities {
+		fmt.Printf("%v => %v\n", member, probabilities)
+	}
+
+	// Unordered output:
+	// A => map[X:0.5 Y:0.25 Z:0.25]
+	// B => map[X:0.5 Z:0.5]
+	// C => map[Y:0.75 Z:0.25]
+}
+
+func TestSolvePOP(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		applicants := []core.MatchPreference{
+			{Name: "A1", Preferences: []string{"H1", "H2", "H3"}},
+			{Name: "A2", Preferences: []string{"H1", "H3"}},
+			{Name: "A3", Preferences: []string{"H2", "H1"}},
+			{Name: "A4", Preferences: []string{"H2", "H4"}},
+		}
+
+		houses := []core.MatchPreference{
+			{Name: "H1"},
+			{Name: "H2"},
+			{Name: "H3"},
+			{Name: "H4"},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A1": "H3",
+				"A2": "H1",
+				"A4": "H2",
+			},
+			Unmatched: []string{"A3"},
+		}
+
+		result, err := SolvePOP(&applicants, &houses)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("with ties", func(t *testing.T) {
+		applicants := []core.MatchPreference{
+			{
+				Name:              "A1",
+				Preferences:       []string{"H1", "H2", "H3"},
+				RankedPreferences: [][]string{{"H1", "H2"}, {"H3"}},
+			},
+			{Name: "A2", Preferences: []string{"H1", "H3"}},
+			{Name: "A3", Preferences: []string{"H1", "H2"}},
+		}
+
+		houses := []core.MatchPreference{
+			{Name: "H1"},
+			{Name: "H2"},
+			{Name: "H3"},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A1": "H2",
+				"A2": "H3",
+				"A3": "H1",
+			},
+		}
+
+		result, err := SolvePOP(&applicants, &houses)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("validates preference tables", func(t *testing.T) {
+		applicants := []core.MatchPreference{
+			{Name: "A1", Preferences: []string{"H1"}},
+		}
+
+		houses := []core.MatchPreference{
+			{Name: "H1", Preferences: []string{"A1"}},
+		}
+
+		_, err := SolvePOP(&applicants, &houses)
+
+		assert.Equal(t, "Preference list for 'H1' must be empty", err.Error())
+	})
+}
+
+func ExampleSolvePOP() {
+	applicants := []MatchPreference{
+		{Name: "A1", Preferences: []string{"H1", "H2", "H3"}},
+		{Name: "A2", Preferences: []string{"H1", "H3"}},
+		{Name: "A3", Preferences: []string{"H2", "H1"}},
+		{Name: "A4", Preferences: []string{"H2", "H4"}},
+	}
+
+	houses := []MatchPreference{
+		{Name: "H1"},
+		{Name: "H2"},
+		{Name: "H3"},
+		{Name: "H4"},
+	}
+
+	// Call `libmatch`
+	result, err := SolvePOP(&applicants, &houses)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	// Iterate through the result mapping
+	for applicant, house := range result.Mapping {
+		fmt.Printf("%v => %v\n", applicant, house)
+	}
+	fmt.Printf("Unmatched => %v\n", result.Unmatched)
+
+	// Unordered output:
+	// A1 => H3
+	// A2 => H1
+	// A4 => H2
+	// Unmatched => [A3]
+}
+
+func ExampleSolvePOP_noPopularMatching() {
+	applicants := []MatchPreference{
+		{Name: "A1", Preferences: []string{"H1", "H2", "H3"}},
+		{Name: "A2", Preferences: []string{"H1", "H2", "H3"}},
+		{Name: "A3", Preferences: []string{"H1", "H2", "H3"}},
+	}
+
+	houses := []MatchPreference{
+		{Name: "H1"},
+		{Name: "H2"},
+		{Name: "H3"},
+	}
+
+	// Call `libmatch`
+	_, err := SolvePOP(&applicants, &houses)
+
+	// The above input has no popular matching, and we expect it to return an error
+	fmt.Println(err)
+
+	// Output:
+	// No popular matching exists
+}
+
+func TestSolveRMM(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		applicants := []core.MatchPreference{
+			{Name: "A1", Preferences: []string{"H1", "H2"}},
+			{Name: "A2", Preferences: []string{"H3", "H1"}},
+			{Name: "A3", Preferences: []string{"H3"}},
+		}
+
+		houses := []core.MatchPreference{
+			{Name: "H1"},
+			{Name: "H2"},
+			{Name: "H3"},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A1": "H1",
+				"A2": "H3",
+			},
+			Assignments: map[string][]string{
+				"H1": {"A1"},
+				"H2": {},
+				"H3": {"A2"},
+			},
+			Unmatched: []string{"A3"},
+			Signature: []int{2, 0},
+		}
+
+		result, err := SolveRMM(&applicants, &houses)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("with capacities", func(t *testing.T) {
+		applicants := []core.MatchPreference{
+			{Name: "A1", Preferences: []string{"H1", "H2"}},
+			{Name: "A2", Preferences: []string{"H1", "H2"}},
+			{Name: "A3", Preferences: []string{"H1", "H2"}},
+		}
+
+		houses := []core.MatchPreference{
+			{Name: "H1", Capacity: 2},
+			{Name: "H2"},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A1": "H2",
+				"A2": "H1",
+				"A3": "H1",
+			},
+			Assignments: map[string][]string{
+				"H1": {"A2", "A3"},
+				"H2": {"A1"},
+			},
+			Signature: []int{2, 1},
+		}
+
+		result, err := SolveRMM(&applicants, &houses)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("validates preference tables", func(t *testing.T) {
+		applicants := []core.MatchPreference{
+			{Name: "A1", Preferences: []string{"H1", "H2"}},
+		}
+
+		houses := []core.MatchPreference{
+			{Name: "H1"},
+		}
+
+		_, err := SolveRMM(&applicants, &houses)
+
+		assert.Equal(t, "Preference list for 'A1' contains at least one unknown member", err.Error())
+	})
+}
+
+func ExampleSolveRMM() {
+	applicants := []MatchPreference{
+		{Name: "A1", Preferences: []string{"H1", "H2"}},
+		{Name: "A2", Preferences: []string{"H3", "H1"}},
+		{Name: "A3", Preferences: []string{"H3"}},
+	}
+
+	houses := []MatchPreference{
+		{Name: "H1"},
+		{Name: "H2"},
+		{Name: "H3"},
+	}
+
+	// Call `libmatch`
+	result, err := SolveRMM(&applicants, &houses)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	// Iterate through the result mapping
+	for applicant, house := range result.Mapping {
+		fmt.Printf("%v => %v\n", applicant, house)
+	}
+	fmt.Printf("Unmatched => %v\n", result.Unmatched)
+	fmt.Printf("Signature => %v\n", result.Signature)
+
+	// Unordered output:
+	// A1 => H1
+	// A2 => H3
+	// Unmatched => [A3]
+	// Signature => [2 0]
+}
+
+func TestSolveAP(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		workers := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"X", "Y", "Z"}, Scores: []float64{9, 8, 1}},
+			{Name: "B", Preferences: []string{"X", "Y", "Z"}, Scores: []float64{8, 3, 2}},
+			{Name: "C", Preferences: []string{"X", "Y", "Z"}, Scores: []float64{7, 6, 5}},
+		}
+
+		tasks := []core.MatchPreference{
+			{Name: "X", Preferences: []string{"A", "B", "C"}},
+			{Name: "Y", Preferences: []string{"A", "B", "C"}},
+			{Name: "Z", Preferences: []string{"A", "B", "C"}},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "Y",
+				"B": "X",
+				"C": "Z",
+				"X": "B",
+				"Y": "A",
+				"Z": "C",
+			},
+		}
+
+		result, err := SolveAP(&workers, &tasks)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("scores as costs", func(t *testing.T) {
+		workers := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"X", "Y"}, Scores: []float64{1.5, 4}},
+			{Name: "B", Preferences: []string{"X", "Y"}, Scores: []float64{2, 3}},
+		}
+
+		tasks := []core.MatchPreference{
+			{Name: "X", Preferences: []string{"A", "B"}},
+			{Name: "Y", Preferences: []string{"A", "B"}},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "X",
+				"B": "Y",
+				"X": "A",
+				"Y": "B",
+			},
+		}
+
+		result, err := SolveAP(&workers, &tasks, WithScoresAsCosts())
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("with incomplete lists", func(t *testing.T) {
+		workers := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"X"}, Scores: []float64{5}},
+			{Name: "B", Preferences: []string{"X"}, Scores: []float64{2}},
+		}
+
+		tasks := []core.MatchPreference{
+			{Name: "X", Preferences: []string{"A", "B"}},
+			{Name: "Y", Preferences: []string{}},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "X",
+				"X": "A",
+			},
+			Unmatched: []string{"B", "Y"},
+		}
+
+		result, err := SolveAP(&workers, &tasks, WithIncompleteLists())
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("maximises welfare with incomplete lists", func(t *testing.T) {
+		workers := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"X", "Y"}, Scores: []float64{10, 1}},
+			{Name: "B", Preferences: []string{"X"}, Scores: []float64{1}},
+		}
+
+		tasks := []core.MatchPreference{
+			{Name: "X", Preferences: []string{"A", "B"}},
+			{Name: "Y", Preferences: []string{"A"}},
+		}
+
+		// Matching "A" with "X" alone scores 10, while matching everyone
+		// would only score 2
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "X",
+				"X": "A",
+			},
+			Unmatched: []string{"B", "Y"},
+		}
+
+		result, err := SolveAP(&workers, &tasks, WithIncompleteLists())
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("with maximum cardinality", func(t *testing.T) {
+		workers := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"X", "Y"}, Scores: []float64{10, 1}},
+			{Name: "B", Preferences: []string{"X"}, Scores: []float64{1}},
+		}
+
+		tasks := []core.MatchPreference{
+			{Name: "X", Preferences: []string{"A", "B"}},
+			{Name: "Y", Preferences: []string{"A"}},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "Y",
+				"B": "X",
+				"X": "B",
+				"Y": "A",
+			},
+		}
+
+		result, err := SolveAP(
+			&workers,
+			&tasks,
+			WithIncompleteLists(),
+			WithObjective(ObjectiveMaximumCardinality),
+		)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("validates preference tables", func(t *testing.T) {
+		workers := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"X", "Y"}, Scores: []float64{5}},
+			{Name: "B", Preferences: []string{"X", "Y"}},
+		}
+
+		tasks := []core.MatchPreference{
+			{Name: "X", Preferences: []string{"A", "B"}},
+			{Name: "Y", Preferences: []string{"A", "B"}},
+		}
+
+		_, err := SolveAP(&workers, &tasks)
+
+		assert.Equal(t, "Scores for 'A' must contain one score for each preference", err.Error())
+	})
+}
+
+func ExampleSolveAP() {
+	workers := []MatchPreference{
+		{Name: "A", Preferences: []string{"X", "Y", "Z"}, Scores: []float64{9, 8, 1}},
+		{Name: "B", Preferences: []string{"X", "Y", "Z"}, Scores: []float64{8, 3, 2}},
+		{Name: "C", Preferences: []string{"X", "Y", "Z"}, Scores: []float64{7, 6, 5}},
+	}
+
+	tasks := []MatchPreference{
+		{Name: "X", Preferences: []string{"A", "B", "C"}},
+		{Name: "Y", Preferences: []string{"A", "B", "C"}},
+		{Name: "Z", Preferences: []string{"A", "B", "C"}},
+	}
+
+	// Call `libmatch`
+	result, err := SolveAP(&workers, &tasks)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	// Iterate through the result mapping
+	for worker, task := range result.Mapping {
+		fmt.Printf("%v => %v\n", worker, task)
+	}
+
+	// Unordered output:
+	// A => Y
+	// B => X
+	// C => Z
+	// X => B
+	// Y => A
+	// Z => C
+}
+
+func TestSolveDA(t *testing.T) {
+	students := []core.MatchPreference{
+		{Name: "S1", Preferences: []string{"X", "Y"}},
+		{Name: "S2", Preferences: []string{"X", "Y"}},
+		{Name: "S3", Preferences: []string{"X", "Y"}},
+		{Name: "S4", Preferences: []string{"Y", "X"}},
+	}
+
+	schools := []core.MatchPreference{
+		{Name: "X", RankedPreferences: [][]string{{"S4"}, {"S1", "S2", "S3"}}},
+		{Name: "Y", RankedPreferences: [][]string{{"S1", "S2", "S3", "S4"}}, Capacity: 2},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"S1": "X",
+				"S2": "Y",
+				"S4": "Y",
+			},
+			Assignments: map[string][]string{
+				"X": {"S1"},
+				"Y": {"S4", "S2"},
+			},
+			Unmatched: []string{"S3"},
+			Lottery: map[string]map[string]int{
+				"X": {"S1": 2, "S2": 3, "S3": 4, "S4": 1},
+				"Y": {"S1": 2, "S2": 3, "S3": 4, "S4": 1},
+			},
+		}
+
+		result, err := SolveDA(&students, &schools)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("with multiple lotteries", func(t *testing.T) {
+		result, err := SolveDA(&students, &schools, WithLottery(LotteryMultiple))
+
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{"S1": "X", "S3": "Y", "S4": "Y"}, result.Mapping)
+		assert.Equal(t, map[string]int{"S1": 2, "S2": 4, "S3": 1, "S4": 3}, result.Lottery["Y"])
+	})
+
+	t.Run("with seed", func(t *testing.T) {
+		result, err := SolveDA(&students, &schools, WithSeed(1))
+
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{"S1": "Y", "S2": "Y", "S4": "X"}, result.Mapping)
+
+		again, err := SolveDA(&students, &schools, WithSeed(1))
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(result, again))
+	})
+
+	t.Run("validates preference tables", func(t *testing.T) {
+		tiedStudents := []core.MatchPreference{
+			{Name: "S1", RankedPreferences: [][]string{{"X", "Y"}}},
+		}
+
+		_, err := SolveDA(&tiedStudents, &schools)
+
+		assert.Equal(t, "Preference list for 'S1' can not contain ties", err.Error())
+	})
+}
+
+func ExampleSolveDA() {
+	students := []MatchPreference{
+		{Name: "S1", Preferences: []string{"X", "Y"}},
+		{Name: "S2", Preferences: []string{"X", "Y"}},
+		{Name: "S3", Preferences: []string{"X", "Y"}},
+		{Name: "S4", Preferences: []string{"Y", "X"}},
+	}
+
+	schools := []MatchPreference{
+		{Name: "X", RankedPreferences: [][]string{{"S4"}, {"S1", "S2", "S3"}}},
+		{Name: "Y", RankedPreferences: [][]string{{"S1", "S2", "S3", "S4"}}, Capacity: 2},
+	}
+
+	// Call `libmatch`
+	result, err := SolveDA(&students, &schools, WithSeed(0))
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	// Iterate through the result mapping
+	for student, school := range result.Mapping {
+		fmt.Printf("%v => %v (lottery number %v)\n", student, school, result.Lottery[school][student])
+	}
+	fmt.Printf("Unmatched => %v\n", result.Unmatched)
+
+	// Unordered output:
+	// S1 => X (lottery number 2)
+	// S2 => Y (lottery number 3)
+	// S4 => Y (lottery number 1)
+	// Unmatched => [S3]
+}
+
+func TestSolveIA(t *testing.T) {
+	students := []core.MatchPreference{
+		{Name: "S1", Preferences: []string{"X", "Y"}},
+		{Name: "S2", Preferences: []string{"Y", "X"}},
+		{Name: "S3", Preferences: []string{"Y", "X"}},
+	}
+
+	schools := []core.MatchPreference{
+		{Name: "X", RankedPreferences: [][]string{{"S2"}, {"S1", "S3"}}},
+		{Name: "Y", RankedPreferences: [][]string{{"S1", "S2", "S3"}}},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"S1": "X",
+				"S3": "Y",
+			},
+			Assignments: map[string][]string{
+				"X": {"S1"},
+				"Y": {"S3"},
+			},
+			Unmatched: []string{"S2"},
+			Lottery: map[string]map[string]int{
+				"X": {"S1": 2, "S2": 3, "S3": 1},
+				"Y": {"S1": 2, "S2": 3, "S3": 1},
+			},
+		}
+
+		result, err := SolveIA(&students, &schools)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("draws the same lottery as deferred acceptance", func(t *testing.T) {
+		opts := []Option{WithSeed(3), WithLottery(LotteryMultiple)}
+
+		iaResult, err := SolveIA(&students, &schools, opts...)
+		assert.Nil(t, err)
+
+		daResult, err := SolveDA(&students, &schools, opts...)
+		assert.Nil(t, err)
+
+		assert.Equal(t, daResult.Lottery, iaResult.Lottery)
+	})
+
+	t.Run("validates preference tables", func(t *testing.T) {
+		unknownSchool := []core.MatchPreference{
+			{Name: "S1", Preferences: []string{"Z"}},
+		}
+
+		_, err := SolveIA(&unknownSchool, &schools)
+
+		assert.Equal(t, "Preference list for 'S1' contains at least one unknown member", err.Error())
+	})
+}
+
+func ExampleSolveIA() {
+	students := []MatchPreference{
+		{Name: "S1", Preferences: []string{"X", "Y"}},
+		{Name: "S2", Preferences: []string{"Y", "X"}},
+		{Name: "S3", Preferences: []string{"Y", "X"}},
+	}
+
+	schools := []MatchPreference{
+		{Name: "X", RankedPreferences: [][]string{{"S2"}, {"S1", "S3"}}},
+		{Name: "Y", RankedPreferences: [][]string{{"S1", "S2", "S3"}}},
+	}
+
+	// Call `libmatch` with both mechanisms and the same lottery
+	iaResult, err := SolveIA(&students, &schools, WithSeed(0))
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	daResult, err := SolveDA(&students, &schools, WithSeed(0))
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	// Compare the school of each student under both mechanisms
+	for _, student := range students {
+		fmt.Printf("%v => %q (IA), %q (DA)\n",
+			student.Name, iaResult.Mapping[student.Name], daResult.Mapping[student.Name])
+	}
+
+	// Output:
+	// S1 => "X" (IA), "" (DA)
+	// S2 => "" (IA), "X" (DA)
+	// S3 => "Y" (IA), "Y" (DA)
+}
@@ -0,0 +1,210 @@
+package libmatch
+
+import (
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// Option configures an optional setting of a matching algorithm. Options that
+// do not apply to a given algorithm are ignored.
+type Option func(*core.AlgorithmContext)
+
+type Side = core.Side
+
+const (
+	SideA = core.SideA
+	SideB = core.SideB
+)
+
+type Stability = core.Stability
+
+const (
+	StabilityWeak   = core.StabilityWeak
+	StabilityStrong = core.StabilityStrong
+	StabilitySuper  = core.StabilitySuper
+)
+
+type Objective = core.Objective
+
+const (
+	ObjectiveProposerOptimal = core.ObjectiveProposerOptimal
+	ObjectiveEgalitarian     = core.ObjectiveEgalitarian
+	ObjectiveMinimumRegret   = core.ObjectiveMinimumRegret
+	ObjectiveSexEqual        = core.ObjectiveSexEqual
+	ObjectiveBalanced        = core.ObjectiveBalanced
+	ObjectiveMedian          = core.ObjectiveMedian
+	ObjectiveMinimumCost     = core.ObjectiveMinimumCost
+
+	ObjectiveMaximumCardinality = core.ObjectiveMaximumCardinality
+)
+
+type Lottery = core.Lottery
+
+const (
+	LotterySingle   = core.LotterySingle
+	LotteryMultiple = core.LotteryMultiple
+)
+
+type PairCost = core.PairCost
+
+type TieBreaker = core.TieBreaker
+
+var (
+	TieBreakByOrder = core.TieBreakByOrder
+	TieBreakByName  = core.TieBreakByName
+	TieBreakRandom  = core.TieBreakRandom
+)
+
+// WithProposer sets which side makes proposals in a two-sided matching
+// problem. `SideA` refers to the first preference table and `SideB` to the
+// second. The proposing side receives its optimal stable matching.
+func WithProposer(side Side) Option {
+	return func(algoCtx *core.AlgorithmContext) {
+		algoCtx.Proposer = side
+	}
+}
+
+// WithIncompleteLists allows preference lists to omit members that are
+// unacceptable, in which case some members may remain unmatched. For two-sided
+// problems, the two preference tables may also be of different sizes.
+func WithIncompleteLists() Option {
+	return func(algoCtx *core.AlgorithmContext) {
+		algoCtx.IncompleteLists = true
+	}
+}
+
+// WithTieBreaker sets the policy used to order members that are tied in rank,
+// for algorithms that require strictly ordered preference lists. Built in
+// policies are `TieBreakByOrder` (default), `TieBreakByName` and
+// `TieBreakRandom(seed)`.
+func WithTieBreaker(tieBreaker TieBreaker) Option {
+	return func(algoCtx *core.AlgorithmContext) {
+		algoCtx.TieBreaker = tieBreaker
+	}
+}
+
+// WithStability sets the notion of stability that a matching must satisfy when
+// preference lists contain ties. Strongly stable (`StabilityStrong`) and
+// super-stable (`StabilitySuper`) matchings are not guaranteed to exist, in
+// which case an error is returned.
+func WithStability(stability Stability) Option {
+	return func(algoCtx *core.AlgorithmContext) {
+		algoCtx.Stability = stability
+	}
+}
+
+// WithObjective sets the criterion used to choose among the stable matchings
+// of a two-sided matching problem. By default the matching that is optimal for
+// the proposing side is returned (`ObjectiveProposerOptimal`).
+// `ObjectiveEgalitarian` returns the matching that minimises the sum of the
+// ranks that all members give their partners, and `ObjectiveMinimumRegret`
+// the matching that minimises the worst rank that any member gives their
+// partner. `ObjectiveMaximumCardinality` approximates the largest weakly
+// stable matching when preference lists contain ties and are incomplete. In
+// the assignment problem, `ObjectiveMaximumCardinality` matches as many
+// members as possible before maximising the total score.
+func WithObjective(objective Objective) Option {
+	return func(algoCtx *core.AlgorithmContext) {
+		algoCtx.Objective = objective
+	}
+}
+
+// WithPairCost returns the stable matching that minimises the total cost of
+// all matched pairs, where `cost(a, b)` is the cost of matching member `a` of
+// the first preference table with member `b` of the second.
+func WithPairCost(cost PairCost) Option {
+	return func(algoCtx *core.AlgorithmContext) {
+		algoCtx.Objective = core.ObjectiveMinimumCost
+		algoCtx.PairCost = cost
+	}
+}
+
+// WithMaximumStableMatching returns a maximum stable matching for a roommates
+// problem that has no stable matching, instead of an error. One member of each
+// odd party of Tan's (1991) stable partition is left unmatched, and the other
+// members are matched so that no two of them would both prefer to be matched
+// with each other.
+func WithMaximumStableMatching() Option {
+	return func(algoCtx *core.AlgorithmContext) {
+		algoCtx.MaximumStableMatching = true
+	}
+}
+
+// WithPriorityOrder sets the order in which members of the first preference
+// table pick in serial dictatorship, listing each of them exactly once. By
+// default members pick in the order in which they are listed.
+func WithPriorityOrder(order []string) Option {
+	return func(algoCtx *core.AlgorithmContext) {
+		algoCtx.PriorityOrder = order
+	}
+}
+
+// WithSeed sets the seed of the random priority order or lottery drawn by
+// randomized algorithms, so that the same seed always produces the same result.
+func WithSeed(seed int64) Option {
+	return func(algoCtx *core.AlgorithmContext) {
+		algoCtx.Seed = seed
+	}
+}
+
+// WithDraws sets the number of random priority orders drawn by randomized
+// algorithms. When greater than 1, the result holds the probability of each
+// member being matched with each other member over all draws, instead of a
+// single matching.
+func WithDraws(draws int) Option {
+	return func(algoCtx *core.AlgorithmContext) {
+		algoCtx.Draws = draws
+	}
+}
+
+// WithSample draws a single matching from the lottery computed by the
+// Probabilistic Serial mechanism, using the seed set by `WithSeed(seed)`, in
+// addition to the probabilities of each member receiving each item.
+func WithSample() Option {
+	return func(algoCtx *core.AlgorithmContext) {
+		algoCtx.Sample = true
+	}
+}
+
+// WithLottery sets how ties between students of the same priority class are
+// broken in school choice. `LotterySingle` (default) draws one lottery number
+// per student that every school uses, and `LotteryMultiple` draws a separate
+// lottery at each school.
+func WithLottery(lottery Lottery) Option {
+	return func(algoCtx *core.AlgorithmContext) {
+		algoCtx.Lottery = lottery
+	}
+}
+
+// WithScoresAsCosts treats the scores that members give their preferences as
+// costs to be minimised, rather than utilities to be maximised, when solving
+// the assignment problem.
+func WithScoresAsCosts() Option {
+	return func(algoCtx *core.AlgorithmContext) {
+		algoCtx.ScoresAsCosts = true
+	}
+}
+
+// WithSearchLimit sets the number of proposals that heuristic algorithms make
+// before giving up on finding a stable matching, such as the Hospitals/Residents
+// Problem with Couples.
+func WithSearchLimit(limit int) Option {
+	return func(algoCtx *core.AlgorithmContext) {
+		algoCtx.SearchLimit = limit
+	}
+}
+
+// WithCloseUnderfilled closes hospitals that can not meet their lower quota,
+// rather than failing, when solving the Hospitals/Residents Problem with Lower
+// Quotas.
+func WithCloseUnderfilled() Option {
+	return func(algoCtx *core.AlgorithmContext) {
+		algoCtx.CloseUnderfilled = true
+	}
+}
+
+// applyOptions applies a list of options to an algorithm context
+func applyOptions(algoCtx *core.AlgorithmContext, opts []Option) {
+	for i := range opts {
+		opts[i](algoCtx)
+	}
+}
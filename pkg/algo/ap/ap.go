@@ -0,0 +1,70 @@
+package ap
+
+import (
+	"sort"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// Run executes the Hungarian algorithm to solve the "Assignment Problem" (AP)
+// for a set of given preference inputs.
+//
+// Each member may score the members of their preference list, and the score of
+// a pair is the sum of the scores both members give each other. The matching
+// maximises the total score of all matched pairs or, when the algorithm
+// context specifies that scores are costs, minimises it.
+//
+// When the algorithm context specifies incomplete preference lists, only pairs
+// of members that both list each other may be matched, and the rest are
+// reported as unmatched. Members are left unmatched whenever that gives a
+// higher total score, unless the algorithm context's `Objective` is
+// `core.ObjectiveMaximumCardinality`, in which case as many members as possible
+// are matched first. Costs are always minimised over matchings of the largest
+// size, since leaving every member unmatched would otherwise cost the least.
+//
+// See ap package documentation for more detail
+func Run(algoCtx core.AlgorithmContext) (core.MatchResult, error) {
+	rows := algoCtx.TableA.SortedMembers()
+	cols := algoCtx.TableB.SortedMembers()
+
+	maximumCardinality := algoCtx.ScoresAsCosts ||
+		algoCtx.Objective == core.ObjectiveMaximumCardinality
+
+	cost := costMatrix(rows, cols, algoCtx.ScoresAsCosts, maximumCardinality)
+	assignment := hungarian(cost)
+
+	mapping := make(map[string]string)
+
+	for i := range rows {
+		j := assignment[i]
+
+		if j >= len(cols) || !isAcceptable(rows[i], cols[j]) {
+			continue
+		}
+
+		mapping[rows[i].Name()] = cols[j].Name()
+		mapping[cols[j].Name()] = rows[i].Name()
+	}
+
+	return buildResult(algoCtx.TableA, algoCtx.TableB, mapping), nil
+}
+
+// buildResult constructs a Match Result from the mapping between matched
+// members, in both directions.
+func buildResult(ptA, ptB *core.PreferenceTable, mapping map[string]string) core.MatchResult {
+	res := core.MatchResult{}
+
+	res.Mapping = mapping
+
+	for _, pt := range []*core.PreferenceTable{ptA, ptB} {
+		for name := range *pt {
+			if _, ok := mapping[name]; !ok {
+				res.Unmatched = append(res.Unmatched, name)
+			}
+		}
+	}
+
+	sort.Strings(res.Unmatched)
+
+	return res
+}
@@ -0,0 +1,188 @@
+package ap
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	t.Run("maximises utility", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(
+			&[]core.MatchPreference{
+				{Name: "A", Preferences: []string{"X", "Y", "Z"}, Scores: []float64{9, 8, 1}},
+				{Name: "B", Preferences: []string{"X", "Y", "Z"}, Scores: []float64{8, 3, 2}},
+				{Name: "C", Preferences: []string{"X", "Y", "Z"}, Scores: []float64{7, 6, 5}},
+			},
+			&[]core.MatchPreference{
+				{Name: "X", Preferences: []string{"A", "B", "C"}},
+				{Name: "Y", Preferences: []string{"A", "B", "C"}},
+				{Name: "Z", Preferences: []string{"A", "B", "C"}},
+			},
+		)
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "Y",
+				"B": "X",
+				"C": "Z",
+				"X": "B",
+				"Y": "A",
+				"Z": "C",
+			},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("minimises cost", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(
+			&[]core.MatchPreference{
+				{Name: "A", Preferences: []string{"X", "Y", "Z"}, Scores: []float64{9, 8, 1}},
+				{Name: "B", Preferences: []string{"X", "Y", "Z"}, Scores: []float64{8, 3, 2}},
+				{Name: "C", Preferences: []string{"X", "Y", "Z"}, Scores: []float64{7, 6, 5}},
+			},
+			&[]core.MatchPreference{
+				{Name: "X", Preferences: []string{"A", "B", "C"}},
+				{Name: "Y", Preferences: []string{"A", "B", "C"}},
+				{Name: "Z", Preferences: []string{"A", "B", "C"}},
+			},
+		)
+
+		algoCtx := core.AlgorithmContext{
+			TableA:        &tables[0],
+			TableB:        &tables[1],
+			ScoresAsCosts: true,
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "Z",
+				"B": "Y",
+				"C": "X",
+				"X": "C",
+				"Y": "B",
+				"Z": "A",
+			},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("adds the scores of both members", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(
+			&[]core.MatchPreference{
+				{Name: "A", Preferences: []string{"X", "Y"}, Scores: []float64{3, 1}},
+				{Name: "B", Preferences: []string{"X", "Y"}, Scores: []float64{3, 1}},
+			},
+			&[]core.MatchPreference{
+				{Name: "X", Preferences: []string{"A", "B"}, Scores: []float64{5, 0}},
+				{Name: "Y", Preferences: []string{"A", "B"}},
+			},
+		)
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "X",
+				"B": "Y",
+				"X": "A",
+				"Y": "B",
+			},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("incomplete lists", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(
+			&[]core.MatchPreference{
+				{Name: "A", Preferences: []string{"X", "Y"}, Scores: []float64{10, 1}},
+				{Name: "B", Preferences: []string{"X"}, Scores: []float64{1}},
+			},
+			&[]core.MatchPreference{
+				{Name: "X", Preferences: []string{"A", "B"}},
+				{Name: "Y", Preferences: []string{"A"}},
+			},
+		)
+
+		algoCtx := core.AlgorithmContext{
+			TableA:          &tables[0],
+			TableB:          &tables[1],
+			IncompleteLists: true,
+		}
+
+		// "A" matched with "X" scores 10, more than "A" with "Y" and "B" with
+		// "X" together, so "B" is left unmatched
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "X",
+				"X": "A",
+			},
+			Unmatched: []string{"B", "Y"},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("incomplete lists with maximum cardinality", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(
+			&[]core.MatchPreference{
+				{Name: "A", Preferences: []string{"X", "Y"}, Scores: []float64{10, 1}},
+				{Name: "B", Preferences: []string{"X"}, Scores: []float64{2}},
+				{Name: "C", Preferences: []string{"Y"}, Scores: []float64{7}},
+			},
+			&[]core.MatchPreference{
+				{Name: "X", Preferences: []string{"A", "B"}},
+				{Name: "Y", Preferences: []string{"A"}},
+			},
+		)
+
+		algoCtx := core.AlgorithmContext{
+			TableA:          &tables[0],
+			TableB:          &tables[1],
+			IncompleteLists: true,
+			Objective:       core.ObjectiveMaximumCardinality,
+		}
+
+		// "Y" does not list "C", so "A" takes "Y" to match as many members as
+		// possible, even though "A" matched with "X" would score more
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "Y",
+				"B": "X",
+				"X": "B",
+				"Y": "A",
+			},
+			Unmatched: []string{"C"},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+}
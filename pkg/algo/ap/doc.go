@@ -0,0 +1,92 @@
+/*
+Package ap implements a solution to the "Assignment Problem" (AP) between two
+sets of members, using the Hungarian algorithm.
+
+Members may give a numeric score to each member of their preference list (e.g.
+a satisfaction rating), and the score of a pair is the sum of the scores both
+members give each other. A member who gives no scores scores every member 0, so
+scores may come from either or both sides.
+
+Unlike the stable matching algorithms, the order of each preference list does
+not matter. The matching instead maximises the total score of all matched
+pairs (its "welfare"), or minimises it when scores are costs. It may not be
+stable: a pair of members may both prefer each other to their partners when
+that would lower the total.
+
+It implements the algorithm of Kuhn (1955) and Munkres (1957).
+
+ALGORITHM
+
+See: https://en.wikipedia.org/wiki/Hungarian_algorithm
+
+Members of each table are listed by name, and the cost of matching every pair
+is written into a square matrix. Scores are negated unless they are costs, so
+that the algorithm always minimises.
+
+The algorithm keeps a "potential" for every row and column, such that no cost
+is less than the sum of its row and column potentials. An entry whose cost
+equals that sum is "tight". Rows are added to the assignment one at a time. A
+tree of tight entries is grown from each new row until it reaches an
+unassigned column, raising and lowering potentials by the smallest slack
+whenever no tight entry is left to follow. The assignment is then augmented
+along the path from the new row to that column.
+
+When every row is assigned, every assigned entry is tight, so the assignment
+has the minimum total cost.
+
+INCOMPLETE LISTS
+
+When preference lists are incomplete, a pair of members may only be matched if
+both list each other, and the tables may differ in size. The matrix is padded
+to a square with dummy rows or columns. Members assigned a dummy member, or a
+member they are not acceptable to, are left unmatched.
+
+Such pairs score 0, so the matching is a maximum-weight matching: members are
+left unmatched whenever matching them would lower the total score. With the
+maximum cardinality objective, such pairs instead cost more than any matching
+of acceptable pairs could save. As many members as possible are then matched,
+and among matchings of that size the one with the best total score is chosen.
+
+When scores are costs, as many members as possible are always matched, since
+leaving every member unmatched would otherwise cost the least.
+
+DETERMINISM
+
+Members are considered in alphabetical order, so the result is always
+deterministic, though it may be one of many matchings with the same total
+score.
+
+ALGORITHM EXAMPLE
+
+Take the following preference tables, where workers score the tasks and tasks
+give no scores
+
+	// workers (scores)
+	A => [X (9), Y (8), Z (1)]
+	B => [X (8), Y (3), Z (2)]
+	C => [X (7), Y (6), Z (5)]
+
+	// tasks
+	X => [A, B, C]
+	Y => [A, B, C]
+	Z => [A, B, C]
+
+The scores are negated into the cost matrix
+
+	      X    Y    Z
+	A    -9   -8   -1
+	B    -8   -3   -2
+	C    -7   -6   -5
+
+Every worker likes "X" the most, and giving it to "A" scores the highest of any
+pair. But the assignment with the minimum cost gives "X" to "B" instead, since
+"A" loses little by taking "Y"
+
+	A => Y
+	B => X
+	C => Z
+
+Its total score is 21, compared with 17 for any assignment that gives "A" its
+first choice.
+*/
+package ap
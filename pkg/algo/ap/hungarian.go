@@ -0,0 +1,151 @@
+package ap
+
+import (
+	"math"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// costMatrix builds a square matrix of the cost of matching each member of the
+// first table (row) with each member of the second table (column), with
+// members listed by name. The matrix is padded with dummy rows or columns when
+// the tables differ in size.
+//
+// The cost of a pair is the sum of the scores both members give each other,
+// negated unless scores are costs. Pairs that are not acceptable to both
+// members, and pairs with a dummy member, leave both members unmatched and cost
+// nothing, so that the matching has the maximum total score.
+//
+// When `maximumCardinality` is set, those pairs instead cost more than any
+// matching of acceptable pairs could save, so that as many members as possible
+// are matched first.
+func costMatrix(rows, cols []*core.Member, scoresAsCosts, maximumCardinality bool) [][]float64 {
+	n := len(rows)
+	if len(cols) > n {
+		n = len(cols)
+	}
+
+	total := 0.0
+	cost := make([][]float64, n)
+
+	for i := range cost {
+		cost[i] = make([]float64, n)
+
+		for j := range cost[i] {
+			cost[i][j] = math.NaN()
+
+			if i >= len(rows) || j >= len(cols) || !isAcceptable(rows[i], cols[j]) {
+				continue
+			}
+
+			score := rows[i].Score(*cols[j]) + cols[j].Score(*rows[i])
+
+			if !scoresAsCosts {
+				score = -score
+			}
+
+			cost[i][j] = score
+			total += math.Abs(score)
+		}
+	}
+
+	unmatched := 0.0
+	if maximumCardinality {
+		unmatched = 2*total + 1
+	}
+
+	for i := range cost {
+		for j := range cost[i] {
+			if math.IsNaN(cost[i][j]) {
+				cost[i][j] = unmatched
+			}
+		}
+	}
+
+	return cost
+}
+
+// isAcceptable indicates whether two members both list each other.
+func isAcceptable(a, b *core.Member) bool {
+	return a.PreferenceList().IndexOf(*b) != -1 && b.PreferenceList().IndexOf(*a) != -1
+}
+
+// hungarian implements the Hungarian algorithm of Kuhn (1955) and Munkres
+// (1957), in the O(n^3) form that maintains a potential for every row and
+// column.
+//
+// It returns the column assigned to each row of a square cost matrix, such
+// that the total cost of the assignment is minimised.
+func hungarian(cost [][]float64) []int {
+	n := len(cost)
+
+	// Rows and columns are indexed from 1, and column 0 holds the row that is
+	// being added to the assignment
+	u := make([]float64, n+1)
+	v := make([]float64, n+1)
+	rowOf := make([]int, n+1)
+	way := make([]int, n+1)
+
+	for i := 1; i <= n; i++ {
+		rowOf[0] = i
+		j0 := 0
+
+		minSlack := make([]float64, n+1)
+		used := make([]bool, n+1)
+		for j := range minSlack {
+			minSlack[j] = math.Inf(1)
+		}
+
+		// Grow a tree of tight edges from the new row until it reaches a free
+		// column, adjusting the potentials whenever no tight edge is left
+		for rowOf[j0] != 0 {
+			used[j0] = true
+			i0 := rowOf[j0]
+			delta := math.Inf(1)
+			j1 := 0
+
+			for j := 1; j <= n; j++ {
+				if used[j] {
+					continue
+				}
+
+				slack := cost[i0-1][j-1] - u[i0] - v[j]
+
+				if slack < minSlack[j] {
+					minSlack[j] = slack
+					way[j] = j0
+				}
+
+				if minSlack[j] < delta {
+					delta = minSlack[j]
+					j1 = j
+				}
+			}
+
+			for j := 0; j <= n; j++ {
+				if used[j] {
+					u[rowOf[j]] += delta
+					v[j] -= delta
+				} else {
+					minSlack[j] -= delta
+				}
+			}
+
+			j0 = j1
+		}
+
+		// Augment along the path back to the new row
+		for j0 != 0 {
+			j1 := way[j0]
+			rowOf[j0] = rowOf[j1]
+			j0 = j1
+		}
+	}
+
+	assignment := make([]int, n)
+	for j := 1; j <= n; j++ {
+		assignment[rowOf[j]-1] = j - 1
+	}
+
+	return assignment
+}
@@ -0,0 +1,101 @@
+package ap
+
+import (
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCostMatrix(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "B", Preferences: []string{"X"}, Scores: []float64{2}},
+			{Name: "A", Preferences: []string{"Y", "X"}, Scores: []float64{4, -1}},
+			{Name: "C", Preferences: []string{"X"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "X", Preferences: []string{"A", "B", "C"}, Scores: []float64{3, 0, 1}},
+			{Name: "Y", Preferences: []string{"B"}, Scores: []float64{5}},
+		},
+	)
+
+	ptA, ptB := tables[0], tables[1]
+
+	rows := ptA.SortedMembers()
+	cols := ptB.SortedMembers()
+
+	t.Run("utilities", func(t *testing.T) {
+		cost := costMatrix(rows, cols, false, false)
+
+		// The acceptable pairs are A-X (2), B-X (2) and C-X (1)
+		assert.Equal(t, [][]float64{
+			{-2, 0, 0},
+			{-2, 0, 0},
+			{-1, 0, 0},
+		}, cost)
+	})
+
+	t.Run("utilities with maximum cardinality", func(t *testing.T) {
+		cost := costMatrix(rows, cols, false, true)
+
+		assert.Equal(t, [][]float64{
+			{-2, 11, 11},
+			{-2, 11, 11},
+			{-1, 11, 11},
+		}, cost)
+	})
+
+	t.Run("costs", func(t *testing.T) {
+		cost := costMatrix(rows, cols, true, true)
+
+		assert.Equal(t, [][]float64{
+			{2, 11, 11},
+			{2, 11, 11},
+			{1, 11, 11},
+		}, cost)
+	})
+}
+
+func TestIsAcceptable(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "A", Preferences: []string{"X", "Y"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "X", Preferences: []string{"A"}},
+			{Name: "Y", Preferences: []string{}},
+		},
+	)
+
+	ptA, ptB := tables[0], tables[1]
+
+	assert.True(t, isAcceptable(ptA["A"], ptB["X"]))
+	assert.False(t, isAcceptable(ptA["A"], ptB["Y"]))
+}
+
+func TestHungarian(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		cost := [][]float64{
+			{-9, -8, -1},
+			{-8, -3, -2},
+			{-7, -6, -5},
+		}
+
+		assert.Equal(t, []int{1, 0, 2}, hungarian(cost))
+	})
+
+	t.Run("fractional costs", func(t *testing.T) {
+		cost := [][]float64{
+			{0.5, 0.25, 4},
+			{1.5, 3, 0.75},
+			{2, 0.5, 1},
+		}
+
+		assert.Equal(t, []int{0, 2, 1}, hungarian(cost))
+	})
+
+	t.Run("empty matrix", func(t *testing.T) {
+		assert.Equal(t, []int{}, hungarian([][]float64{}))
+	})
+}
@@ -0,0 +1,74 @@
+package da
+
+import (
+	"github.com/abhchand/libmatch/pkg/algo/internal/schoolchoice"
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// deferredAcceptance implements the student-proposing deferred acceptance
+// algorithm of Gale and Shapley (1962), as applied to school choice by
+// Abdulkadiroğlu and Sönmez (2003).
+//
+// Each unassigned student "applies" to their next school, and each school
+// holds on to the applicants it gives the highest priority, up to its
+// capacity. Ties in priority are broken by the school's lottery.
+//
+// The preference tables are not modified.
+//
+// See da package documentation for more detail
+func deferredAcceptance(ptS, ptC *core.PreferenceTable, lot schoolchoice.LotteryNumbers) schoolchoice.Assignments {
+	held := make(schoolchoice.Assignments, len(*ptC))
+
+	// Track the index of the next school each student applies to
+	next := make(map[string]int, len(*ptS))
+
+	queue := make([]*core.Member, 0, len(*ptS))
+	for _, name := range ptS.SortedNames() {
+		queue = append(queue, (*ptS)[name])
+	}
+
+	for len(queue) > 0 {
+		student := queue[0]
+		queue = queue[1:]
+
+		schools := student.PreferenceList().Members()
+
+		if next[student.Name()] >= len(schools) {
+			continue
+		}
+
+		school := schools[next[student.Name()]]
+		next[student.Name()]++
+
+		if rejected := apply(school, student, held, lot); rejected != nil {
+			queue = append(queue, rejected)
+		}
+	}
+
+	return held
+}
+
+// apply lets a student apply to a school, which holds the student if it has
+// a seat left or gives them a higher priority than one of the students it
+// holds. It returns the student rejected by the school, if any.
+func apply(school, student *core.Member, held schoolchoice.Assignments, lot schoolchoice.LotteryNumbers) *core.Member {
+	students := append(held[school.Name()], student)
+
+	if len(students) <= school.Capacity() {
+		held[school.Name()] = students
+		return nil
+	}
+
+	// Reject the student with the lowest priority
+	lowest := 0
+	for i := range students {
+		if schoolchoice.Precedes(school, students[lowest], students[i], lot) {
+			lowest = i
+		}
+	}
+
+	rejected := students[lowest]
+	held[school.Name()] = append(students[:lowest], students[lowest+1:]...)
+
+	return rejected
+}
@@ -0,0 +1,90 @@
+package da
+
+import (
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/algo/internal/schoolchoice"
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeferredAcceptance(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "S1", Preferences: []string{"X", "Y"}},
+			{Name: "S2", Preferences: []string{"X", "Y"}},
+			{Name: "S3", Preferences: []string{"X", "Y"}},
+			{Name: "S4", Preferences: []string{"Y", "X"}},
+		},
+		&[]core.MatchPreference{
+			{
+				Name:              "X",
+				Preferences:       []string{"S4", "S1", "S2", "S3"},
+				RankedPreferences: [][]string{{"S4"}, {"S1", "S2", "S3"}},
+			},
+			{
+				Name:              "Y",
+				Preferences:       []string{"S1", "S2", "S3", "S4"},
+				RankedPreferences: [][]string{{"S1", "S2", "S3", "S4"}},
+				Capacity:          2,
+			},
+		},
+	)
+
+	ptS, ptC := tables[0], tables[1]
+
+	numbers := map[string]int{"S1": 2, "S2": 3, "S3": 4, "S4": 1}
+	lot := schoolchoice.LotteryNumbers{"X": numbers, "Y": numbers}
+
+	held := deferredAcceptance(&ptS, &ptC, lot)
+
+	assert.Equal(t, []*core.Member{ptS["S1"]}, held["X"])
+	assert.ElementsMatch(t, []*core.Member{ptS["S2"], ptS["S4"]}, held["Y"])
+
+	// The preference tables are not modified
+	assert.Equal(t, 2, len(ptS["S3"].PreferenceList().Members()))
+}
+
+func TestApply(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "S1", Preferences: []string{"X"}},
+			{Name: "S2", Preferences: []string{"X"}},
+			{Name: "S3", Preferences: []string{"X"}},
+		},
+		&[]core.MatchPreference{
+			{
+				Name:              "X",
+				Preferences:       []string{"S1", "S2", "S3"},
+				RankedPreferences: [][]string{{"S1", "S2", "S3"}},
+				Capacity:          2,
+			},
+		},
+	)
+
+	ptS, ptC := tables[0], tables[1]
+
+	lot := schoolchoice.LotteryNumbers{"X": {"S1": 3, "S2": 1, "S3": 2}}
+
+	t.Run("holds students while seats are left", func(t *testing.T) {
+		held := schoolchoice.Assignments{}
+
+		assert.Nil(t, apply(ptC["X"], ptS["S1"], held, lot))
+		assert.Nil(t, apply(ptC["X"], ptS["S2"], held, lot))
+		assert.Equal(t, []*core.Member{ptS["S1"], ptS["S2"]}, held["X"])
+	})
+
+	t.Run("rejects the student with the lowest priority", func(t *testing.T) {
+		held := schoolchoice.Assignments{"X": {ptS["S1"], ptS["S2"]}}
+
+		assert.Equal(t, ptS["S1"], apply(ptC["X"], ptS["S3"], held, lot))
+		assert.Equal(t, []*core.Member{ptS["S2"], ptS["S3"]}, held["X"])
+	})
+
+	t.Run("rejects the applicant", func(t *testing.T) {
+		held := schoolchoice.Assignments{"X": {ptS["S2"], ptS["S3"]}}
+
+		assert.Equal(t, ptS["S1"], apply(ptC["X"], ptS["S1"], held, lot))
+		assert.Equal(t, []*core.Member{ptS["S2"], ptS["S3"]}, held["X"])
+	})
+}
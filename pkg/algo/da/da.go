@@ -0,0 +1,28 @@
+package da
+
+import (
+	"github.com/abhchand/libmatch/pkg/algo/internal/schoolchoice"
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// Run executes the student-proposing deferred acceptance algorithm (DA) for
+// school choice, for a set of given preference inputs. `TableA` holds the
+// students and `TableB` holds the schools.
+//
+// Schools rank students in coarse priority classes, which may contain ties.
+// Ties are broken by a lottery drawn from the algorithm context's `Seed`,
+// either once for every school (`core.LotterySingle`, the default) or
+// separately for each school (`core.LotteryMultiple`). The lottery numbers are
+// recorded in the result, and the same seed always produces the same result.
+//
+// See da package documentation for more detail
+func Run(algoCtx core.AlgorithmContext) (core.MatchResult, error) {
+	ptS := algoCtx.TableA
+	ptC := algoCtx.TableB
+
+	lot := schoolchoice.DrawLottery(ptS, ptC, algoCtx.Lottery, algoCtx.Seed)
+
+	held := deferredAcceptance(ptS, ptC, lot)
+
+	return schoolchoice.BuildResult(ptS, ptC, held, lot), nil
+}
@@ -0,0 +1,128 @@
+package da
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	prefsSet := []*[]core.MatchPreference{
+		{
+			{Name: "S1", Preferences: []string{"X", "Y"}},
+			{Name: "S2", Preferences: []string{"X", "Y"}},
+			{Name: "S3", Preferences: []string{"X", "Y"}},
+			{Name: "S4", Preferences: []string{"Y", "X"}},
+		},
+		{
+			{
+				Name:              "X",
+				Preferences:       []string{"S4", "S1", "S2", "S3"},
+				RankedPreferences: [][]string{{"S4"}, {"S1", "S2", "S3"}},
+			},
+			{
+				Name:              "Y",
+				Preferences:       []string{"S1", "S2", "S3", "S4"},
+				RankedPreferences: [][]string{{"S1", "S2", "S3", "S4"}},
+				Capacity:          2,
+			},
+		},
+	}
+
+	t.Run("single lottery", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"S1": "X",
+				"S2": "Y",
+				"S4": "Y",
+			},
+			Assignments: map[string][]string{
+				"X": {"S1"},
+				"Y": {"S4", "S2"},
+			},
+			Unmatched: []string{"S3"},
+			Lottery: map[string]map[string]int{
+				"X": {"S1": 2, "S2": 3, "S3": 4, "S4": 1},
+				"Y": {"S1": 2, "S2": 3, "S3": 4, "S4": 1},
+			},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("multiple lotteries", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA:  &tables[0],
+			TableB:  &tables[1],
+			Lottery: core.LotteryMultiple,
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"S1": "X",
+				"S3": "Y",
+				"S4": "Y",
+			},
+			Assignments: map[string][]string{
+				"X": {"S1"},
+				"Y": {"S3", "S4"},
+			},
+			Unmatched: []string{"S2"},
+			Lottery: map[string]map[string]int{
+				"X": {"S1": 2, "S2": 3, "S3": 4, "S4": 1},
+				"Y": {"S1": 2, "S2": 4, "S3": 1, "S4": 3},
+			},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("seed changes the lottery", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+			Seed:   1,
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"S1": "Y",
+				"S2": "Y",
+				"S4": "X",
+			},
+			Assignments: map[string][]string{
+				"X": {"S4"},
+				"Y": {"S1", "S2"},
+			},
+			Unmatched: []string{"S3"},
+			Lottery: map[string]map[string]int{
+				"X": {"S1": 1, "S2": 2, "S3": 3, "S4": 4},
+				"Y": {"S1": 1, "S2": 2, "S3": 3, "S4": 4},
+			},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+}
@@ -0,0 +1,83 @@
+/*
+Package da implements the student-proposing "Deferred Acceptance" algorithm
+(DA) for school choice, with ties in priority broken by lottery.
+
+Students rank the schools they would like to attend. Schools have a capacity
+and rank students in coarse priority classes (e.g. siblings, then students in
+the walk zone, then everyone else), so many students share the same priority.
+Students a school does not rank form its lowest priority class.
+
+It implements the algorithm of Gale and Shapley (1962) as applied to school
+choice by Abdulkadiroğlu and Sönmez (2003), with the single and multiple
+tie-breaking rules studied by Abdulkadiroğlu, Pathak and Roth (2009).
+
+ALGORITHM
+
+See: https://en.wikipedia.org/wiki/School_choice
+
+Ties between students of the same priority class are first broken by lottery.
+Each school gives every student a lottery number, and a lower number breaks a
+tie in the student's favour -
+
+1. Single tie-breaking (STB) draws one number per student, which every school
+uses
+
+2. Multiple tie-breaking (MTB) draws a separate number per student at every
+school
+
+Students then apply to schools in rounds. Each student who is not held by a
+school applies to their next school, and each school holds on to the
+applicants it gives the highest priority, up to its capacity, rejecting the
+rest. Students who are rejected by every school they ranked are left
+unmatched.
+
+The result is stable with respect to the priorities after tie-breaking, and is
+the best such matching for every student. Applying to schools in their true
+order of preference is always in a student's best interest.
+
+DETERMINISM
+
+The lottery is drawn from a seeded random source, with students and schools
+listed by name. The same seed always draws the same lottery numbers, which are
+recorded in the result so that it can be audited. The order in which students
+apply does not change the result, so the same seed always produces the same
+result.
+
+ALGORITHM EXAMPLE
+
+Take the following preference tables, where tied students are grouped in
+brackets
+
+	// students
+	S1 => [X, Y]
+	S2 => [X, Y]
+	S3 => [X, Y]
+	S4 => [Y, X]
+
+	// schools (capacity)
+	X (1) => [S4, [S1, S2, S3]]
+	Y (2) => [[S1, S2, S3, S4]]
+
+A single lottery draws the following numbers
+
+	S1 => 2
+	S2 => 3
+	S3 => 4
+	S4 => 1
+
+In the first round "S1", "S2" and "S3" apply to "X", and "S4" applies to "Y".
+"X" has a single seat, and the three students share its second priority
+class. It holds "S1", who has the best lottery number, and rejects the rest.
+
+In the second round "S2" and "S3" apply to "Y", which now has three applicants
+for two seats in a single priority class. It holds "S4" and "S2" and rejects
+"S3", who has the worst lottery number.
+
+"S3" has no schools left, which gives us the following assignments
+
+	S1 => X
+	S2 => Y
+	S3 => (unmatched)
+	S4 => Y
+*/
+package da
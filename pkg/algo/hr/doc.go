@@ -0,0 +1,92 @@
+/*
+Package hr implements the solution to the "Hospitals/Residents Problem".
+
+It implements the deferred acceptance algorithm of Gale-Shapley (1962),
+generalized to a many-to-one setting. Each resident is matched with at most one
+hospital, while each hospital can be matched with as many residents as its
+capacity allows. Some residents may remain unmatched when there are more
+residents than places, or when residents and hospitals only rank the members
+they find acceptable.
+
+ALGORITHM
+
+See: https://en.wikipedia.org/wiki/National_Resident_Matching_Program
+
+The algorithm can be run with either side proposing.
+
+Resident-proposing
+
+Each unassigned resident "proposes" to the top remaining hospital on their
+list. Each hospital receiving a proposal can take one of 3 actions -
+
+1. The hospital has spare capacity and immediately holds the proposal
+
+2. The hospital is full, but prefers this new proposal over the least preferred
+resident it holds. The hospital "rejects" that resident and holds this new one
+
+3. The hospital is full and prefers every resident it holds. The hospital
+"rejects" the new proposal
+
+Hospital-proposing
+
+Each hospital with spare capacity "proposes" to the top remaining resident on
+its list. Each resident receiving a proposal behaves exactly as in the
+"Stable Marriage Problem" - they hold on to the single most preferred proposal
+and reject the other.
+
+NOTE: Rejections are mutual. If `i` removes `j` from their preference list,
+then `j` must also remove `i` from its list
+
+This cycle continues until no further proposals can be made.
+
+STABILITY AND DETERMINISM
+
+A stable solution is always guaranteed. The resident-proposing variant returns
+the stable matching that is best for every resident, and the hospital-proposing
+variant returns the stable matching that is best for every hospital.
+
+Since each of these matchings is unique, the result is deterministic even
+though members are processed in no particular order.
+
+ALGORITHM EXAMPLE
+
+Take the following preference tables
+
+	// residents
+	R1 => [H1, H2, H3]
+	R2 => [H1, H3, H2]
+	R3 => [H2, H1, H3]
+	R4 => [H1, H2, H3]
+	R5 => [H3, H1, H2]
+
+	// hospitals (capacity)
+	H1 (2) => [R3, R5, R1, R4, R2]
+	H2 (2) => [R1, R2, R3, R4, R5]
+	H3 (1) => [R2, R4, R1, R5, R3]
+
+With the residents proposing, one possible sequence of events is -
+
+	'R1' proposes to 'H1'
+	'H1' holds 'R1'
+	'R2' proposes to 'H1'
+	'H1' holds 'R2'
+	'R3' proposes to 'H2'
+	'H2' holds 'R3'
+	'R4' proposes to 'H1'
+	'H1' holds 'R4', rejects 'R2'
+	'R5' proposes to 'H3'
+	'H3' holds 'R5'
+	'R2' proposes to 'H3'
+	'H3' holds 'R2', rejects 'R5'
+	'R5' proposes to 'H1'
+	'H1' holds 'R5', rejects 'R4'
+	'R4' proposes to 'H2'
+	'H2' holds 'R4'
+
+The matching result is:
+
+	H1 => [R5, R1]
+	H2 => [R3, R4]
+	H3 => [R2]
+*/
+package hr
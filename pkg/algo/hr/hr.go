@@ -0,0 +1,81 @@
+package hr
+
+import (
+	"sort"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// Run executes the algorithm to solve the "Hospitals/Residents Problem" (HR)
+// for a set of given preference inputs. `TableA` holds the residents and
+// `TableB` holds the hospitals.
+//
+// Residents propose by default. Setting the algorithm context's `Proposer` to
+// `core.SideB` lets the hospitals propose instead.
+//
+// When `IncompleteLists` is set, residents and hospitals are only matched when
+// both list each other. Residents who are not matched with any hospital are
+// listed as unmatched.
+//
+// See hr package documentation for more detail
+func Run(algoCtx core.AlgorithmContext) (core.MatchResult, error) {
+	ptR := algoCtx.TableA
+	ptH := algoCtx.TableB
+
+	if algoCtx.IncompleteLists {
+		ptR.RemoveUnacceptable()
+		ptH.RemoveUnacceptable()
+	}
+
+	held := make(assignments, len(*ptH))
+
+	optimalSide := core.SideA
+
+	if algoCtx.Proposer == core.SideB {
+		hospitalProposal(ptR, ptH, held)
+		optimalSide = core.SideB
+	} else {
+		residentProposal(ptR, ptH, held)
+	}
+
+	res := buildResult(ptR, ptH, held)
+	res.OptimalSide = optimalSide
+
+	return res, nil
+}
+
+// buildResult constructs a Match Result from the residents held by each
+// hospital at the end of the algorithm run. Residents held by no hospital are
+// listed as unmatched, in alphabetical order.
+func buildResult(ptR, ptH *core.PreferenceTable, held assignments) core.MatchResult {
+	res := core.MatchResult{}
+
+	res.Mapping = make(map[string]string)
+	res.Assignments = make(map[string][]string, len(*ptH))
+
+	for name, hospital := range *ptH {
+		residents := held[name]
+
+		// List residents in the hospital's order of preference
+		sort.Slice(residents, func(i, j int) bool {
+			pl := hospital.PreferenceList()
+			return pl.IndexOf(*residents[i]) < pl.IndexOf(*residents[j])
+		})
+
+		res.Assignments[name] = make([]string, len(residents))
+		for i := range residents {
+			res.Assignments[name][i] = residents[i].Name()
+			res.Mapping[residents[i].Name()] = name
+		}
+	}
+
+	for name := range *ptR {
+		if _, ok := res.Mapping[name]; !ok {
+			res.Unmatched = append(res.Unmatched, name)
+		}
+	}
+
+	sort.Strings(res.Unmatched)
+
+	return res
+}
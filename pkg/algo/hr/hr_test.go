@@ -0,0 +1,256 @@
+package hr
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "R1", Preferences: []string{"H1", "H2", "H3"}},
+				{Name: "R2", Preferences: []string{"H1", "H3", "H2"}},
+				{Name: "R3", Preferences: []string{"H2", "H1", "H3"}},
+				{Name: "R4", Preferences: []string{"H1", "H2", "H3"}},
+				{Name: "R5", Preferences: []string{"H3", "H1", "H2"}},
+			},
+			{
+				{Name: "H1", Preferences: []string{"R3", "R5", "R1", "R4", "R2"}, Capacity: 2},
+				{Name: "H2", Preferences: []string{"R1", "R2", "R3", "R4", "R5"}, Capacity: 2},
+				{Name: "H3", Preferences: []string{"R2", "R4", "R1", "R5", "R3"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"R1": "H1",
+				"R2": "H3",
+				"R3": "H2",
+				"R4": "H2",
+				"R5": "H1",
+			},
+			Assignments: map[string][]string{
+				"H1": {"R5", "R1"},
+				"H2": {"R3", "R4"},
+				"H3": {"R2"},
+			},
+			OptimalSide: core.SideA,
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("residents propose", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "R1", Preferences: []string{"H1", "H2"}},
+				{Name: "R2", Preferences: []string{"H1", "H2"}},
+				{Name: "R3", Preferences: []string{"H2", "H1"}},
+			},
+			{
+				{Name: "H1", Preferences: []string{"R3", "R2", "R1"}, Capacity: 2},
+				{Name: "H2", Preferences: []string{"R1", "R2", "R3"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA:   &tables[0],
+			TableB:   &tables[1],
+			Proposer: core.SideA,
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"R1": "H1",
+				"R2": "H1",
+				"R3": "H2",
+			},
+			Assignments: map[string][]string{
+				"H1": {"R2", "R1"},
+				"H2": {"R3"},
+			},
+			OptimalSide: core.SideA,
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("hospitals propose", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "R1", Preferences: []string{"H1", "H2"}},
+				{Name: "R2", Preferences: []string{"H1", "H2"}},
+				{Name: "R3", Preferences: []string{"H2", "H1"}},
+			},
+			{
+				{Name: "H1", Preferences: []string{"R3", "R2", "R1"}, Capacity: 2},
+				{Name: "H2", Preferences: []string{"R1", "R2", "R3"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA:   &tables[0],
+			TableB:   &tables[1],
+			Proposer: core.SideB,
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"R1": "H2",
+				"R2": "H1",
+				"R3": "H1",
+			},
+			Assignments: map[string][]string{
+				"H1": {"R3", "R2"},
+				"H2": {"R1"},
+			},
+			OptimalSide: core.SideB,
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("hospital with no residents", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "R1", Preferences: []string{"H1", "H2"}},
+				{Name: "R2", Preferences: []string{"H1", "H2"}},
+			},
+			{
+				{Name: "H1", Preferences: []string{"R1", "R2"}, Capacity: 2},
+				{Name: "H2", Preferences: []string{"R1", "R2"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"R1": "H1",
+				"R2": "H1",
+			},
+			Assignments: map[string][]string{
+				"H1": {"R1", "R2"},
+				"H2": {},
+			},
+			OptimalSide: core.SideA,
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("more residents than places", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "R1", Preferences: []string{"H1", "H2"}},
+				{Name: "R2", Preferences: []string{"H1", "H2"}},
+				{Name: "R3", Preferences: []string{"H1", "H2"}},
+			},
+			{
+				{Name: "H1", Preferences: []string{"R3", "R2", "R1"}},
+				{Name: "H2", Preferences: []string{"R1", "R2", "R3"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"R1": "H2",
+				"R3": "H1",
+			},
+			Assignments: map[string][]string{
+				"H1": {"R3"},
+				"H2": {"R1"},
+			},
+			Unmatched:   []string{"R2"},
+			OptimalSide: core.SideA,
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("incomplete lists", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "R1", Preferences: []string{"H1"}},
+				{Name: "R2", Preferences: []string{"H1", "H2"}},
+				{Name: "R3", Preferences: []string{"H2"}},
+			},
+			{
+				{Name: "H1", Preferences: []string{"R2"}},
+				{Name: "H2", Preferences: []string{"R3", "R2"}},
+			},
+		}
+
+		for _, proposer := range []core.Side{core.SideA, core.SideB} {
+			tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+			algoCtx := core.AlgorithmContext{
+				TableA:          &tables[0],
+				TableB:          &tables[1],
+				Proposer:        proposer,
+				IncompleteLists: true,
+			}
+
+			// "H1" does not find "R1" acceptable
+			wanted := core.MatchResult{
+				Mapping: map[string]string{
+					"R2": "H1",
+					"R3": "H2",
+				},
+				Assignments: map[string][]string{
+					"H1": {"R2"},
+					"H2": {"R3"},
+				},
+				Unmatched:   []string{"R1"},
+				OptimalSide: proposer,
+			}
+
+			result, err := Run(algoCtx)
+
+			assert.Nil(t, err)
+			assert.True(t, reflect.DeepEqual(wanted, result))
+		}
+	})
+}
@@ -0,0 +1,147 @@
+package hr
+
+import (
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// assignments tracks the residents currently held by each hospital, keyed by
+// hospital name.
+type assignments map[string][]*core.Member
+
+// residentProposal implements the resident-proposing variant of the deferred
+// acceptance algorithm.
+//
+// Each unassigned resident "proposes" to their top remaining hospital and each
+// hospital holds on to its most preferred proposals, up to its capacity.
+//
+// See hr package documentation for more detail
+func residentProposal(ptR, ptH *core.PreferenceTable, held assignments) {
+	for true {
+		unassigned := unassignedResidents(ptR)
+
+		if len(unassigned) == 0 {
+			break
+		}
+
+		for i := range unassigned {
+			resident := unassigned[i]
+			topChoice := resident.FirstPreference()
+			simulateResidentProposal(resident, topChoice, held)
+		}
+	}
+}
+
+// hospitalProposal implements the hospital-proposing variant of the deferred
+// acceptance algorithm.
+//
+// Each hospital with remaining capacity "proposes" to its top remaining
+// resident and each resident holds on to the single most preferred proposal.
+//
+// See hr package documentation for more detail
+func hospitalProposal(ptR, ptH *core.PreferenceTable, held assignments) {
+	for true {
+		proposed := false
+
+		for _, hospital := range *ptH {
+			if len(held[hospital.Name()]) >= hospital.Capacity() {
+				continue
+			}
+
+			resident := nextResident(hospital, held)
+			if resident == nil {
+				continue
+			}
+
+			simulateHospitalProposal(hospital, resident, held)
+			proposed = true
+		}
+
+		if !proposed {
+			break
+		}
+	}
+}
+
+// unassignedResidents returns all residents that are not currently held by a
+// hospital and that still have hospitals left to propose to.
+func unassignedResidents(ptR *core.PreferenceTable) []*core.Member {
+	var unassigned []*core.Member
+
+	for _, resident := range *ptR {
+		if resident.HasAcceptedProposal() {
+			continue
+		}
+
+		if len(resident.PreferenceList().Members()) == 0 {
+			continue
+		}
+
+		unassigned = append(unassigned, resident)
+	}
+
+	return unassigned
+}
+
+// nextResident returns the most preferred resident on a hospital's preference
+// list that the hospital does not already hold.
+func nextResident(hospital *core.Member, held assignments) *core.Member {
+	prefs := hospital.PreferenceList().Members()
+
+	for i := range prefs {
+		if core.IndexOfMember(held[hospital.Name()], prefs[i]) == -1 {
+			return prefs[i]
+		}
+	}
+
+	return nil
+}
+
+// simulateResidentProposal simulates a proposal from a resident to a hospital
+func simulateResidentProposal(resident, hospital *core.Member, held assignments) {
+	name := hospital.Name()
+
+	if len(held[name]) < hospital.Capacity() {
+		// Hospital has spare capacity. Blindly hold this proposal.
+		held[name] = append(held[name], resident)
+		resident.Accept(hospital)
+		return
+	}
+
+	worst := hospital.PreferenceList().LeastPreferred(held[name])
+
+	if hospital.PreferenceList().IndexOf(*resident) < hospital.PreferenceList().IndexOf(*worst) {
+		// Hospital is full, but prefers the new proposal to the least preferred
+		// resident it holds. Reject that resident and hold this new proposal.
+		held[name] = core.RemoveMember(held[name], worst)
+		worst.Reject(hospital)
+
+		held[name] = append(held[name], resident)
+		resident.Accept(hospital)
+	} else {
+		// Hospital is full and prefers all the residents it holds. Reject this
+		// new proposal.
+		resident.Reject(hospital)
+	}
+}
+
+// simulateHospitalProposal simulates a proposal from a hospital to a resident
+func simulateHospitalProposal(hospital, resident *core.Member, held assignments) {
+	if !resident.HasAcceptedProposal() {
+		// Resident does not hold a proposal. Blindly accept this one.
+		held[hospital.Name()] = append(held[hospital.Name()], resident)
+		resident.Accept(hospital)
+	} else if resident.WouldPreferProposalFrom(*hospital) {
+		// Resident holds a proposal, but the new proposal is better. Reject the
+		// existing proposal and accept this new one.
+		current := resident.CurrentProposer()
+		held[current.Name()] = core.RemoveMember(held[current.Name()], resident)
+		resident.Reject(current)
+
+		held[hospital.Name()] = append(held[hospital.Name()], resident)
+		resident.Accept(hospital)
+	} else {
+		// Resident holds a proposal, but prefers to hold on to it. Reject this
+		// new proposal.
+		resident.Reject(hospital)
+	}
+}
@@ -0,0 +1,203 @@
+package hr
+
+import (
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResidentProposal(t *testing.T) {
+	prefsSet := []*[]core.MatchPreference{
+		{
+			{Name: "R1", Preferences: []string{"H1", "H2"}},
+			{Name: "R2", Preferences: []string{"H1", "H2"}},
+			{Name: "R3", Preferences: []string{"H1", "H2"}},
+		},
+		{
+			{Name: "H1", Preferences: []string{"R3", "R2", "R1"}, Capacity: 2},
+			{Name: "H2", Preferences: []string{"R1", "R2", "R3"}},
+		},
+	}
+
+	tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+	held := make(assignments)
+
+	residentProposal(&tables[0], &tables[1], held)
+
+	assert.ElementsMatch(t, []*core.Member{tables[0]["R2"], tables[0]["R3"]}, held["H1"])
+	assert.Equal(t, []*core.Member{tables[0]["R1"]}, held["H2"])
+
+	assert.Equal(t, tables[1]["H2"], tables[0]["R1"].CurrentProposer())
+	assert.Equal(t, tables[1]["H1"], tables[0]["R2"].CurrentProposer())
+	assert.Equal(t, tables[1]["H1"], tables[0]["R3"].CurrentProposer())
+
+	// 'H1' rejected 'R1', so they are removed from each others' lists
+	assert.Equal(t, []*core.Member{tables[1]["H2"]}, tables[0]["R1"].PreferenceList().Members())
+	assert.Equal(t,
+		[]*core.Member{tables[0]["R3"], tables[0]["R2"]}, tables[1]["H1"].PreferenceList().Members())
+}
+
+func TestHospitalProposal(t *testing.T) {
+	prefsSet := []*[]core.MatchPreference{
+		{
+			{Name: "R1", Preferences: []string{"H1", "H2"}},
+			{Name: "R2", Preferences: []string{"H2", "H1"}},
+			{Name: "R3", Preferences: []string{"H2", "H1"}},
+		},
+		{
+			{Name: "H1", Preferences: []string{"R3", "R2", "R1"}, Capacity: 2},
+			{Name: "H2", Preferences: []string{"R3", "R1", "R2"}},
+		},
+	}
+
+	tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+	held := make(assignments)
+
+	hospitalProposal(&tables[0], &tables[1], held)
+
+	assert.ElementsMatch(t, []*core.Member{tables[0]["R1"], tables[0]["R2"]}, held["H1"])
+	assert.Equal(t, []*core.Member{tables[0]["R3"]}, held["H2"])
+
+	assert.Equal(t, tables[1]["H1"], tables[0]["R1"].CurrentProposer())
+	assert.Equal(t, tables[1]["H1"], tables[0]["R2"].CurrentProposer())
+	assert.Equal(t, tables[1]["H2"], tables[0]["R3"].CurrentProposer())
+}
+
+func TestSimulateResidentProposal(t *testing.T) {
+	t.Run("hospital has spare capacity", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "R1", Preferences: []string{"H1"}},
+				{Name: "R2", Preferences: []string{"H1"}},
+			},
+			{
+				{Name: "H1", Preferences: []string{"R1", "R2"}, Capacity: 2},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+		held := make(assignments)
+
+		simulateResidentProposal(tables[0]["R2"], tables[1]["H1"], held)
+		simulateResidentProposal(tables[0]["R1"], tables[1]["H1"], held)
+
+		assert.Equal(t, []*core.Member{tables[0]["R2"], tables[0]["R1"]}, held["H1"])
+		assert.Equal(t, tables[1]["H1"], tables[0]["R1"].CurrentProposer())
+		assert.Equal(t, tables[1]["H1"], tables[0]["R2"].CurrentProposer())
+	})
+
+	t.Run("hospital prefers new proposal to a held one", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "R1", Preferences: []string{"H1"}},
+				{Name: "R2", Preferences: []string{"H1"}},
+			},
+			{
+				{Name: "H1", Preferences: []string{"R1", "R2"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+		held := make(assignments)
+
+		simulateResidentProposal(tables[0]["R2"], tables[1]["H1"], held)
+		simulateResidentProposal(tables[0]["R1"], tables[1]["H1"], held)
+
+		assert.Equal(t, []*core.Member{tables[0]["R1"]}, held["H1"])
+		assert.Equal(t, tables[1]["H1"], tables[0]["R1"].CurrentProposer())
+		assert.Nil(t, tables[0]["R2"].CurrentProposer())
+		assert.Equal(t, []*core.Member{}, tables[0]["R2"].PreferenceList().Members())
+	})
+
+	t.Run("hospital prefers held proposals", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "R1", Preferences: []string{"H1"}},
+				{Name: "R2", Preferences: []string{"H1"}},
+			},
+			{
+				{Name: "H1", Preferences: []string{"R1", "R2"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+		held := make(assignments)
+
+		simulateResidentProposal(tables[0]["R1"], tables[1]["H1"], held)
+		simulateResidentProposal(tables[0]["R2"], tables[1]["H1"], held)
+
+		assert.Equal(t, []*core.Member{tables[0]["R1"]}, held["H1"])
+		assert.Equal(t, tables[1]["H1"], tables[0]["R1"].CurrentProposer())
+		assert.Nil(t, tables[0]["R2"].CurrentProposer())
+		assert.Equal(t, []*core.Member{}, tables[0]["R2"].PreferenceList().Members())
+	})
+}
+
+func TestSimulateHospitalProposal(t *testing.T) {
+	t.Run("resident holds no proposal", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "R1", Preferences: []string{"H1", "H2"}},
+			},
+			{
+				{Name: "H1", Preferences: []string{"R1"}},
+				{Name: "H2", Preferences: []string{"R1"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+		held := make(assignments)
+
+		simulateHospitalProposal(tables[1]["H2"], tables[0]["R1"], held)
+
+		assert.Equal(t, []*core.Member{tables[0]["R1"]}, held["H2"])
+		assert.Equal(t, tables[1]["H2"], tables[0]["R1"].CurrentProposer())
+	})
+
+	t.Run("resident prefers new proposal", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "R1", Preferences: []string{"H1", "H2"}},
+			},
+			{
+				{Name: "H1", Preferences: []string{"R1"}},
+				{Name: "H2", Preferences: []string{"R1"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+		held := make(assignments)
+
+		simulateHospitalProposal(tables[1]["H2"], tables[0]["R1"], held)
+		simulateHospitalProposal(tables[1]["H1"], tables[0]["R1"], held)
+
+		assert.Equal(t, []*core.Member{tables[0]["R1"]}, held["H1"])
+		assert.Equal(t, []*core.Member{}, held["H2"])
+		assert.Equal(t, tables[1]["H1"], tables[0]["R1"].CurrentProposer())
+		assert.Equal(t, []*core.Member{}, tables[1]["H2"].PreferenceList().Members())
+	})
+
+	t.Run("resident prefers held proposal", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "R1", Preferences: []string{"H1", "H2"}},
+			},
+			{
+				{Name: "H1", Preferences: []string{"R1"}},
+				{Name: "H2", Preferences: []string{"R1"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+		held := make(assignments)
+
+		simulateHospitalProposal(tables[1]["H1"], tables[0]["R1"], held)
+		simulateHospitalProposal(tables[1]["H2"], tables[0]["R1"], held)
+
+		assert.Equal(t, []*core.Member{tables[0]["R1"]}, held["H1"])
+		assert.Nil(t, held["H2"])
+		assert.Equal(t, tables[1]["H1"], tables[0]["R1"].CurrentProposer())
+		assert.Equal(t, []*core.Member{}, tables[1]["H2"].PreferenceList().Members())
+	})
+}
@@ -0,0 +1,76 @@
+package hrc
+
+import (
+	"sort"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// applicant is a single resident, or a couple of residents who apply to
+// hospitals together.
+//
+// Each of the applicant's choices holds one hospital for each of its
+// residents, in order of preference. A single resident's choices are the
+// hospitals on their preference list.
+type applicant struct {
+	name      string
+	residents []*core.Member
+	choices   [][]*core.Member
+}
+
+// includes returns whether a resident is one of the applicant's residents.
+func (a *applicant) includes(resident *core.Member) bool {
+	for _, r := range a.residents {
+		if r == resident {
+			return true
+		}
+	}
+
+	return false
+}
+
+// newApplicants builds the applicants of a problem from its residents and its
+// couples, in alphabetical order of name.
+func newApplicants(ptR, ptH *core.PreferenceTable, couples *[]core.CouplePreference) []*applicant {
+	var applicants []*applicant
+
+	inCouple := make(map[string]bool)
+
+	if couples != nil {
+		for i := range *couples {
+			couple := (*couples)[i]
+			a := &applicant{name: couple.Name}
+
+			for _, name := range couple.Members {
+				a.residents = append(a.residents, (*ptR)[name])
+				inCouple[name] = true
+			}
+
+			for _, pair := range couple.Preferences {
+				a.choices = append(a.choices, []*core.Member{(*ptH)[pair[0]], (*ptH)[pair[1]]})
+			}
+
+			applicants = append(applicants, a)
+		}
+	}
+
+	for name, resident := range *ptR {
+		if inCouple[name] {
+			continue
+		}
+
+		a := &applicant{name: name, residents: []*core.Member{resident}}
+
+		for _, hospital := range resident.PreferenceList().Members() {
+			a.choices = append(a.choices, []*core.Member{hospital})
+		}
+
+		applicants = append(applicants, a)
+	}
+
+	sort.Slice(applicants, func(i, j int) bool {
+		return applicants[i].name < applicants[j].name
+	})
+
+	return applicants
+}
@@ -0,0 +1,61 @@
+package hrc
+
+import (
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewApplicants(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "R3", Preferences: []string{"H2", "H1"}},
+			{Name: "R1"},
+			{Name: "R2"},
+		},
+		&[]core.MatchPreference{
+			{Name: "H1", Preferences: []string{"R1", "R2", "R3"}},
+			{Name: "H2", Preferences: []string{"R3", "R2"}},
+		},
+	)
+
+	ptR, ptH := tables[0], tables[1]
+
+	couples := []core.CouplePreference{
+		{Name: "C1", Members: []string{"R1", "R2"}, Preferences: [][]string{{"H1", "H2"}, {"H1", "H1"}}},
+	}
+
+	wanted := []*applicant{
+		{
+			name:      "C1",
+			residents: []*core.Member{ptR["R1"], ptR["R2"]},
+			choices:   [][]*core.Member{{ptH["H1"], ptH["H2"]}, {ptH["H1"], ptH["H1"]}},
+		},
+		{
+			name:      "R3",
+			residents: []*core.Member{ptR["R3"]},
+			choices:   [][]*core.Member{{ptH["H2"]}, {ptH["H1"]}},
+		},
+	}
+
+	assert.Equal(t, wanted, newApplicants(&ptR, &ptH, &couples))
+
+	t.Run("without couples", func(t *testing.T) {
+		applicants := newApplicants(&ptR, &ptH, nil)
+
+		assert.Equal(t, 3, len(applicants))
+		assert.Equal(t, "R1", applicants[0].name)
+		assert.Nil(t, applicants[0].choices)
+	})
+}
+
+func TestIncludes(t *testing.T) {
+	r1, r2, r3 := core.NewMember("R1"), core.NewMember("R2"), core.NewMember("R3")
+
+	a := &applicant{name: "C1", residents: []*core.Member{&r1, &r2}}
+
+	assert.True(t, a.includes(&r1))
+	assert.True(t, a.includes(&r2))
+	assert.False(t, a.includes(&r3))
+}
@@ -0,0 +1,99 @@
+/*
+Package hrc implements a solution to the "Hospitals/Residents Problem with
+Couples" (HRC).
+
+It extends the "Hospitals/Residents Problem" with couples of residents who
+apply together, such as two doctors who want to work in the same city. A
+couple ranks pairs of hospitals, one for each of its residents, rather than
+each resident ranking hospitals on their own. Both hospitals of a pair may be
+the same hospital.
+
+Unlike the Hospitals/Residents Problem, a stable matching may not exist, and
+deciding whether one does is NP-complete (Ronn, 1990). This package instead
+implements a heuristic in the style of the Roth and Peranson (1999) algorithm,
+used by the National Resident Matching Program, which runs for a bounded number
+of proposals.
+
+ALGORITHM
+
+See: https://en.wikipedia.org/wiki/National_Resident_Matching_Program
+
+Each single resident and each couple is an "applicant". Applicants propose to
+their choices in turn, where a single resident's choices are the hospitals on
+their preference list and a couple's choices are its pairs of hospitals.
+
+A proposal is held when every hospital of the choice has spare capacity, or
+prefers each resident it is given to the residents it would have to reject to
+make room. Otherwise the applicant proposes to its next choice.
+
+When a resident is rejected to make room -
+
+1. A single resident proposes to their next hospital
+
+2. A resident in a couple withdraws along with their partner, releasing the
+partner's seat, and the couple proposes to its next pair of hospitals
+
+Once every applicant has proposed, the matching may still not be stable, since
+an applicant may have been rejected by a hospital before a partner released a
+seat there. The first applicant that blocks the matching proposes again from
+the choice it blocks with, and the cycle continues.
+
+STABILITY
+
+An applicant "blocks" the matching with a choice it prefers to the one it
+holds, when every hospital of the choice would hold the proposal. The matching
+is stable when no applicant blocks it.
+
+The algorithm stops as soon as the matching is stable. Otherwise it gives up
+after a limited number of proposals and reports the applicants that block the
+last matching, either because no stable matching exists or because the
+heuristic did not reach one.
+
+DETERMINISM
+
+Applicants propose in alphabetical order of name, so the result is always
+deterministic. When a stable matching is found, it may be one of many.
+
+ALGORITHM EXAMPLE
+
+Take the following preference tables, where residents "R3" and "R4" apply as
+the couple "C1"
+
+	// residents
+	R1 => [H2, H3]
+	R2 => [H1]
+	R3 => []
+	R4 => []
+
+	// couples
+	C1 (R3, R4) => [(H1, H2), (H3, H3)]
+
+	// hospitals (capacity)
+	H1 (1) => [R2, R3]
+	H2 (1) => [R4, R1]
+	H3 (2) => [R3, R4, R1]
+
+"C1" proposes to its first pair, and "H1" holds "R3" while "H2" holds "R4".
+
+"R1" proposes to "H2", which rejects them since it prefers "R4". "R1" proposes
+to "H3" instead, which holds them.
+
+"R2" proposes to "H1", which prefers them to "R3" and rejects "R3". The couple
+withdraws, so "H2" releases "R4", and "C1" proposes to "H3" for both of its
+residents. "H3" prefers them both to "R1", and rejects "R1".
+
+	R1 => -
+	R2 => H1
+	R3 => H3
+	R4 => H3
+
+Every applicant has proposed, but "R1" blocks the matching, since "H2" now has
+a free seat. "R1" proposes to "H2" again, which holds them. This gives us the
+following stable matching
+
+	R1 => H2
+	R2 => H1
+	R3 => H3
+	R4 => H3
+*/
+package hrc
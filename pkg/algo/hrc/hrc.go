@@ -0,0 +1,103 @@
+package hrc
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// ErrNoStableMatchingFound is returned when the search does not find a stable
+// matching within its limit, either because none exists or because the
+// heuristic did not reach one.
+var ErrNoStableMatchingFound = errors.New("No stable matching found within the search limit")
+
+// defaultSearchLimit is the number of proposals made before giving up, when
+// the algorithm context does not specify a limit.
+const defaultSearchLimit = 10000
+
+// Run executes the algorithm to solve the "Hospitals/Residents Problem with
+// Couples" (HRC) for a set of given preference inputs. `TableA` holds the
+// residents, `TableB` holds the hospitals and `Couples` lists the residents
+// who apply together.
+//
+// When no stable matching is found within the algorithm context's
+// `SearchLimit`, the last matching is returned along with its blocking pairs
+// and `ErrNoStableMatchingFound`.
+//
+// See hrc package documentation for more detail
+func Run(algoCtx core.AlgorithmContext) (core.MatchResult, error) {
+	ptR := algoCtx.TableA
+	ptH := algoCtx.TableB
+
+	limit := algoCtx.SearchLimit
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+
+	applicants := newApplicants(ptR, ptH, algoCtx.Couples)
+	m := search(applicants, limit)
+
+	res := buildResult(ptR, ptH, m)
+
+	if blockingPairs := blockingPairsOf(m, applicants); len(blockingPairs) > 0 {
+		res.BlockingPairs = blockingPairs
+		return res, ErrNoStableMatchingFound
+	}
+
+	return res, nil
+}
+
+// buildResult constructs a Match Result from the residents held by each
+// hospital at the end of the algorithm run.
+func buildResult(ptR, ptH *core.PreferenceTable, m *matching) core.MatchResult {
+	res := core.MatchResult{}
+
+	res.Mapping = make(map[string]string)
+	res.Assignments = make(map[string][]string, len(*ptH))
+
+	for name, hospital := range *ptH {
+		residents := m.held[name]
+
+		// List residents in the hospital's order of preference
+		sort.Slice(residents, func(i, j int) bool {
+			pl := hospital.PreferenceList()
+			return pl.IndexOf(*residents[i]) < pl.IndexOf(*residents[j])
+		})
+
+		res.Assignments[name] = make([]string, len(residents))
+		for i := range residents {
+			res.Assignments[name][i] = residents[i].Name()
+			res.Mapping[residents[i].Name()] = name
+		}
+	}
+
+	for name := range *ptR {
+		if _, ok := res.Mapping[name]; !ok {
+			res.Unmatched = append(res.Unmatched, name)
+		}
+	}
+
+	sort.Strings(res.Unmatched)
+
+	return res
+}
+
+// blockingPairsOf maps each applicant that blocks the matching to the
+// hospitals of the first choice it blocks with.
+func blockingPairsOf(m *matching, applicants []*applicant) map[string][]string {
+	blockingPairs := make(map[string][]string)
+
+	for _, a := range applicants {
+		c := m.blockingChoice(a)
+		if c == -1 {
+			continue
+		}
+
+		for _, hospital := range a.choices[c] {
+			blockingPairs[a.name] = append(blockingPairs[a.name], hospital.Name())
+		}
+	}
+
+	return blockingPairs
+}
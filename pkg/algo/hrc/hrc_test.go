@@ -0,0 +1,137 @@
+package hrc
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	t.Run("stable matching", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "R1", Preferences: []string{"H2", "H3"}},
+				{Name: "R2", Preferences: []string{"H1"}},
+				{Name: "R3"},
+				{Name: "R4"},
+				{Name: "R5", Preferences: []string{"H1"}},
+			},
+			{
+				{Name: "H1", Preferences: []string{"R2", "R3", "R5"}},
+				{Name: "H2", Preferences: []string{"R4", "R1"}},
+				{Name: "H3", Preferences: []string{"R3", "R4", "R1"}, Capacity: 2},
+			},
+		}
+
+		couples := []core.CouplePreference{
+			{Name: "C1", Members: []string{"R3", "R4"}, Preferences: [][]string{{"H1", "H2"}, {"H3", "H3"}}},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA:  &tables[0],
+			TableB:  &tables[1],
+			Couples: &couples,
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"R1": "H2",
+				"R2": "H1",
+				"R3": "H3",
+				"R4": "H3",
+			},
+			Assignments: map[string][]string{
+				"H1": {"R2"},
+				"H2": {"R1"},
+				"H3": {"R3", "R4"},
+			},
+			Unmatched: []string{"R5"},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("no stable matching", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "R1"},
+				{Name: "R2"},
+				{Name: "R3", Preferences: []string{"H1", "H2"}},
+			},
+			{
+				{Name: "H1", Preferences: []string{"R1", "R3"}},
+				{Name: "H2", Preferences: []string{"R3", "R2"}},
+			},
+		}
+
+		couples := []core.CouplePreference{
+			{Name: "C1", Members: []string{"R1", "R2"}, Preferences: [][]string{{"H1", "H2"}}},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA:      &tables[0],
+			TableB:      &tables[1],
+			Couples:     &couples,
+			SearchLimit: 20,
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"R1": "H1",
+				"R2": "H2",
+			},
+			Assignments: map[string][]string{
+				"H1": {"R1"},
+				"H2": {"R2"},
+			},
+			Unmatched: []string{"R3"},
+			BlockingPairs: map[string][]string{
+				"R3": {"H2"},
+			},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Equal(t, ErrNoStableMatchingFound, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("default search limit", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "R1"},
+				{Name: "R2"},
+				{Name: "R3", Preferences: []string{"H1", "H2"}},
+			},
+			{
+				{Name: "H1", Preferences: []string{"R1", "R3"}},
+				{Name: "H2", Preferences: []string{"R3", "R2"}},
+			},
+		}
+
+		couples := []core.CouplePreference{
+			{Name: "C1", Members: []string{"R1", "R2"}, Preferences: [][]string{{"H1", "H2"}}},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA:  &tables[0],
+			TableB:  &tables[1],
+			Couples: &couples,
+		}
+
+		_, err := Run(algoCtx)
+
+		assert.Equal(t, ErrNoStableMatchingFound, err)
+	})
+}
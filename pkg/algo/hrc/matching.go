@@ -0,0 +1,163 @@
+package hrc
+
+import (
+	"sort"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// matching tracks the residents held by each hospital, keyed by hospital
+// name, and the choice held by each applicant, keyed by applicant name. A
+// choice is an index into the applicant's choices, or -1 when the applicant is
+// unmatched.
+type matching struct {
+	held   map[string][]*core.Member
+	choice map[string]int
+}
+
+// newMatching returns an empty matching, where every applicant is unmatched.
+func newMatching(applicants []*applicant) *matching {
+	m := &matching{
+		held:   make(map[string][]*core.Member),
+		choice: make(map[string]int, len(applicants)),
+	}
+
+	for _, a := range applicants {
+		m.choice[a.name] = -1
+	}
+
+	return m
+}
+
+// acceptable returns whether every hospital of a choice would take the
+// applicant's residents it is given, either into a free seat or in place of
+// residents the hospital likes less than all of them. Residents already held
+// for the applicant itself are not counted.
+func (m *matching) acceptable(a *applicant, choice []*core.Member) bool {
+	for i, hospital := range choice {
+		// A hospital given both residents of a couple is only checked once
+		if i > 0 && choice[0] == hospital {
+			continue
+		}
+
+		pl := hospital.PreferenceList()
+
+		worstIncoming := -1
+		incoming := 0
+
+		for j := range choice {
+			if choice[j] != hospital {
+				continue
+			}
+
+			idx := pl.IndexOf(*a.residents[j])
+			if idx == -1 {
+				return false
+			}
+
+			if idx > worstIncoming {
+				worstIncoming = idx
+			}
+
+			incoming++
+		}
+
+		// Ranks of the residents held for other applicants, worst first
+		var ranks []int
+		for _, resident := range m.held[hospital.Name()] {
+			if !a.includes(resident) {
+				ranks = append(ranks, pl.IndexOf(*resident))
+			}
+		}
+		sort.Sort(sort.Reverse(sort.IntSlice(ranks)))
+
+		excess := len(ranks) + incoming - hospital.Capacity()
+
+		if excess <= 0 {
+			continue
+		}
+
+		if excess > len(ranks) || ranks[excess-1] < worstIncoming {
+			return false
+		}
+	}
+
+	return true
+}
+
+// admit matches an applicant with one of its choices, and returns the
+// residents that the hospitals reject to stay within their capacity.
+func (m *matching) admit(a *applicant, c int) []*core.Member {
+	var rejected []*core.Member
+
+	choice := a.choices[c]
+
+	for i, hospital := range choice {
+		m.held[hospital.Name()] = append(m.held[hospital.Name()], a.residents[i])
+	}
+
+	for i, hospital := range choice {
+		if i > 0 && choice[0] == hospital {
+			continue
+		}
+
+		residents := m.held[hospital.Name()]
+		pl := hospital.PreferenceList()
+
+		sort.SliceStable(residents, func(x, y int) bool {
+			return pl.IndexOf(*residents[x]) < pl.IndexOf(*residents[y])
+		})
+
+		for len(residents) > hospital.Capacity() {
+			rejected = append(rejected, residents[len(residents)-1])
+			residents = residents[:len(residents)-1]
+		}
+
+		m.held[hospital.Name()] = residents
+	}
+
+	m.choice[a.name] = c
+
+	return rejected
+}
+
+// withdraw unmatches an applicant, releasing any seats held for its
+// residents.
+func (m *matching) withdraw(a *applicant) {
+	c := m.choice[a.name]
+	if c == -1 {
+		return
+	}
+
+	for _, hospital := range a.choices[c] {
+		var residents []*core.Member
+
+		for _, resident := range m.held[hospital.Name()] {
+			if !a.includes(resident) {
+				residents = append(residents, resident)
+			}
+		}
+
+		m.held[hospital.Name()] = residents
+	}
+
+	m.choice[a.name] = -1
+}
+
+// blockingChoice returns the first choice that an applicant prefers to the
+// one it holds and whose hospitals would accept it, or -1 when no choice
+// blocks the matching.
+func (m *matching) blockingChoice(a *applicant) int {
+	limit := m.choice[a.name]
+	if limit == -1 {
+		limit = len(a.choices)
+	}
+
+	for c := 0; c < limit; c++ {
+		if m.acceptable(a, a.choices[c]) {
+			return c
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,149 @@
+package hrc
+
+import (
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func matchingTables() []core.PreferenceTable {
+	return core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "R1", Preferences: []string{"H1", "H2"}},
+			{Name: "R2"},
+			{Name: "R3"},
+			{Name: "R4", Preferences: []string{"H1"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "H1", Preferences: []string{"R2", "R1", "R3"}, Capacity: 2},
+			{Name: "H2", Preferences: []string{"R3", "R1"}},
+		},
+	)
+}
+
+func TestAcceptable(t *testing.T) {
+	tables := matchingTables()
+	ptR, ptH := tables[0], tables[1]
+
+	couples := []core.CouplePreference{
+		{Name: "C1", Members: []string{"R2", "R3"}, Preferences: [][]string{{"H1", "H1"}, {"H1", "H2"}}},
+	}
+
+	applicants := newApplicants(&ptR, &ptH, &couples)
+	c1, r1, r4 := applicants[0], applicants[1], applicants[2]
+
+	t.Run("free seats", func(t *testing.T) {
+		m := newMatching(applicants)
+
+		assert.True(t, m.acceptable(c1, c1.choices[0]))
+		assert.True(t, m.acceptable(r1, r1.choices[0]))
+	})
+
+	t.Run("unranked resident", func(t *testing.T) {
+		m := newMatching(applicants)
+
+		assert.False(t, m.acceptable(r4, r4.choices[0]))
+	})
+
+	t.Run("in place of residents the hospital likes less", func(t *testing.T) {
+		m := newMatching(applicants)
+		m.admit(r1, 0)
+
+		// "H1" would reject "R1" for "R2", but not for "R3"
+		assert.True(t, m.acceptable(c1, c1.choices[1]))
+		assert.False(t, m.acceptable(c1, c1.choices[0]))
+	})
+
+	t.Run("ignores residents held for the applicant", func(t *testing.T) {
+		m := newMatching(applicants)
+		m.admit(c1, 0)
+
+		// "H1" is full, but only with the couple's own residents
+		assert.True(t, m.acceptable(c1, c1.choices[0]))
+		assert.True(t, m.acceptable(c1, c1.choices[1]))
+	})
+}
+
+func TestAdmit(t *testing.T) {
+	tables := matchingTables()
+	ptR, ptH := tables[0], tables[1]
+
+	couples := []core.CouplePreference{
+		{Name: "C1", Members: []string{"R2", "R3"}, Preferences: [][]string{{"H1", "H2"}}},
+	}
+
+	applicants := newApplicants(&ptR, &ptH, &couples)
+	c1, r1 := applicants[0], applicants[1]
+
+	m := newMatching(applicants)
+
+	assert.Empty(t, m.admit(r1, 1))
+	assert.Equal(t, 1, m.choice["R1"])
+
+	// "H2" rejects "R1" to make room for "R3"
+	assert.Equal(t, []*core.Member{ptR["R1"]}, m.admit(c1, 0))
+	assert.Equal(t, 0, m.choice["C1"])
+
+	assert.Equal(t, []*core.Member{ptR["R2"]}, m.held["H1"])
+	assert.Equal(t, []*core.Member{ptR["R3"]}, m.held["H2"])
+}
+
+func TestWithdraw(t *testing.T) {
+	tables := matchingTables()
+	ptR, ptH := tables[0], tables[1]
+
+	couples := []core.CouplePreference{
+		{Name: "C1", Members: []string{"R2", "R3"}, Preferences: [][]string{{"H1", "H1"}}},
+	}
+
+	applicants := newApplicants(&ptR, &ptH, &couples)
+	c1, r1 := applicants[0], applicants[1]
+
+	m := newMatching(applicants)
+	m.admit(r1, 1)
+	m.admit(c1, 0)
+
+	m.withdraw(c1)
+
+	assert.Equal(t, -1, m.choice["C1"])
+	assert.Empty(t, m.held["H1"])
+	assert.Equal(t, []*core.Member{ptR["R1"]}, m.held["H2"])
+
+	// Withdrawing an unmatched applicant does nothing
+	m.withdraw(r1)
+
+	assert.Equal(t, -1, m.choice["R1"])
+}
+
+func TestBlockingChoice(t *testing.T) {
+	tables := matchingTables()
+	ptR, ptH := tables[0], tables[1]
+
+	couples := []core.CouplePreference{
+		{Name: "C1", Members: []string{"R2", "R3"}, Preferences: [][]string{{"H1", "H2"}, {"H1", "H1"}}},
+	}
+
+	applicants := newApplicants(&ptR, &ptH, &couples)
+	c1, r1, r4 := applicants[0], applicants[1], applicants[2]
+
+	m := newMatching(applicants)
+	m.admit(r1, 1)
+	m.admit(c1, 1)
+
+	// "H2" would reject "R1" for "R3"
+	assert.Equal(t, 0, m.blockingChoice(c1))
+
+	// "H1" would reject "R3" for "R1"
+	assert.Equal(t, 0, m.blockingChoice(r1))
+
+	// "H1" does not rank "R4"
+	assert.Equal(t, -1, m.blockingChoice(r4))
+
+	m.withdraw(c1)
+	m.withdraw(r1)
+	m.admit(r1, 0)
+
+	// Applicants never block with the choice they hold
+	assert.Equal(t, -1, m.blockingChoice(r1))
+}
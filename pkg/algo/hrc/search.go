@@ -0,0 +1,91 @@
+package hrc
+
+// search implements a heuristic in the style of Roth and Peranson (1999) to
+// find a stable matching of residents and couples to hospitals.
+//
+// Applicants propose down their list of choices, one at a time, and hospitals
+// hold on to their most preferred residents. When a member of a couple is
+// rejected, the couple withdraws both of its residents and proposes again from
+// its next choice. The seat left by the partner may then be wanted by an
+// applicant that was rejected before, so once every applicant has proposed,
+// the first applicant that blocks the matching proposes again from the choice
+// it blocks with.
+//
+// The search stops when no applicant blocks the matching, or after `limit`
+// proposals, since a stable matching may not exist.
+//
+// See hrc package documentation for more detail
+func search(applicants []*applicant, limit int) *matching {
+	m := newMatching(applicants)
+
+	applicantOf := make(map[string]*applicant)
+	next := make(map[string]int, len(applicants))
+
+	for _, a := range applicants {
+		for _, resident := range a.residents {
+			applicantOf[resident.Name()] = a
+		}
+	}
+
+	queue := append([]*applicant{}, applicants...)
+	proposals := 0
+
+	for true {
+		for len(queue) > 0 {
+			a := queue[0]
+			queue = queue[1:]
+
+			for next[a.name] < len(a.choices) {
+				if proposals == limit {
+					return m
+				}
+
+				proposals++
+
+				c := next[a.name]
+				next[a.name]++
+
+				if !m.acceptable(a, a.choices[c]) {
+					continue
+				}
+
+				for _, resident := range m.admit(a, c) {
+					rejected := applicantOf[resident.Name()]
+
+					// Both residents of a couple may be rejected at once
+					if m.choice[rejected.name] == -1 {
+						continue
+					}
+
+					m.withdraw(rejected)
+					queue = append(queue, rejected)
+				}
+
+				break
+			}
+		}
+
+		a, c := firstBlocking(m, applicants)
+		if a == nil {
+			break
+		}
+
+		m.withdraw(a)
+		next[a.name] = c
+		queue = append(queue, a)
+	}
+
+	return m
+}
+
+// firstBlocking returns the first applicant that blocks the matching, along
+// with the choice it blocks with.
+func firstBlocking(m *matching, applicants []*applicant) (*applicant, int) {
+	for _, a := range applicants {
+		if c := m.blockingChoice(a); c != -1 {
+			return a, c
+		}
+	}
+
+	return nil, -1
+}
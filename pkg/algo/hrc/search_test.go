@@ -0,0 +1,86 @@
+package hrc
+
+import (
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearch(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "R1", Preferences: []string{"H2", "H3"}},
+			{Name: "R2", Preferences: []string{"H1"}},
+			{Name: "R3"},
+			{Name: "R4"},
+		},
+		&[]core.MatchPreference{
+			{Name: "H1", Preferences: []string{"R2", "R3"}},
+			{Name: "H2", Preferences: []string{"R4", "R1"}},
+			{Name: "H3", Preferences: []string{"R3", "R4", "R1"}, Capacity: 2},
+		},
+	)
+
+	ptR, ptH := tables[0], tables[1]
+
+	couples := []core.CouplePreference{
+		{Name: "C1", Members: []string{"R3", "R4"}, Preferences: [][]string{{"H1", "H2"}, {"H3", "H3"}}},
+	}
+
+	applicants := newApplicants(&ptR, &ptH, &couples)
+
+	t.Run("finds a stable matching", func(t *testing.T) {
+		m := search(applicants, defaultSearchLimit)
+
+		assert.Equal(t, map[string]int{"C1": 1, "R1": 0, "R2": 0}, m.choice)
+		assert.Equal(t, []*core.Member{ptR["R2"]}, m.held["H1"])
+		assert.Equal(t, []*core.Member{ptR["R1"]}, m.held["H2"])
+		assert.ElementsMatch(t, []*core.Member{ptR["R3"], ptR["R4"]}, m.held["H3"])
+
+		a, _ := firstBlocking(m, applicants)
+		assert.Nil(t, a)
+	})
+
+	t.Run("stops at the limit", func(t *testing.T) {
+		// The couple and "R1" propose to their first choices, and "R2" has yet
+		// to propose
+		m := search(applicants, 2)
+
+		assert.Equal(t, map[string]int{"C1": 0, "R1": -1, "R2": -1}, m.choice)
+	})
+}
+
+func TestFirstBlocking(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "R1", Preferences: []string{"H1"}},
+			{Name: "R2", Preferences: []string{"H2", "H1"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "H1", Preferences: []string{"R1", "R2"}},
+			{Name: "H2", Preferences: []string{"R2"}},
+		},
+	)
+
+	ptR, ptH := tables[0], tables[1]
+
+	applicants := newApplicants(&ptR, &ptH, nil)
+
+	m := newMatching(applicants)
+	m.admit(applicants[1], 1)
+
+	a, c := firstBlocking(m, applicants)
+
+	assert.Equal(t, applicants[0], a)
+	assert.Equal(t, 0, c)
+
+	m.withdraw(applicants[1])
+	m.admit(applicants[0], 0)
+	m.admit(applicants[1], 0)
+
+	a, c = firstBlocking(m, applicants)
+
+	assert.Nil(t, a)
+	assert.Equal(t, -1, c)
+}
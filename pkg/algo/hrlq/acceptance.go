@@ -0,0 +1,74 @@
+package hrlq
+
+import (
+	"github.com/abhchand/libmatch/pkg/algo/hr"
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// residentOptimal finds the resident-optimal stable matching among the
+// hospitals that are open, using the resident-proposing deferred acceptance
+// algorithm of the hr package. Closed hospitals are assigned no residents.
+//
+// The algorithm runs on a copy of the preference tables without the closed
+// hospitals, so the preference tables are not modified.
+//
+// See hrlq package documentation for more detail
+func residentOptimal(ptR, ptH *core.PreferenceTable, closed map[string]bool) (core.MatchResult, error) {
+	tables := openTables(ptR, ptH, closed)
+
+	res, err := hr.Run(core.AlgorithmContext{
+		TableA:          &tables[0],
+		TableB:          &tables[1],
+		IncompleteLists: true,
+	})
+
+	if err != nil {
+		return res, err
+	}
+
+	for name := range closed {
+		res.Assignments[name] = []string{}
+	}
+
+	// The optimal side is not recorded, since the matching is no longer
+	// optimal for the residents once hospitals are closed
+	res.OptimalSide = ""
+
+	return res, nil
+}
+
+// openTables builds a copy of a table of residents and a table of hospitals,
+// leaving out the closed hospitals.
+func openTables(ptR, ptH *core.PreferenceTable, closed map[string]bool) []core.PreferenceTable {
+	prefsR := make([]core.MatchPreference, 0, len(*ptR))
+	for _, resident := range ptR.SortedMembers() {
+		prefsR = append(prefsR, openPreference(resident, closed))
+	}
+
+	prefsH := make([]core.MatchPreference, 0, len(*ptH))
+	for _, hospital := range ptH.SortedMembers() {
+		if !closed[hospital.Name()] {
+			prefsH = append(prefsH, openPreference(hospital, closed))
+		}
+	}
+
+	return core.NewPreferenceTablePair(&prefsR, &prefsH)
+}
+
+// openPreference returns the match preference of a member, leaving out the
+// closed hospitals from their preference list.
+func openPreference(member *core.Member, closed map[string]bool) core.MatchPreference {
+	pref := core.MatchPreference{
+		Name:       member.Name(),
+		Capacity:   member.Capacity(),
+		LowerQuota: member.LowerQuota(),
+	}
+
+	for _, m := range member.PreferenceList().Members() {
+		if !closed[m.Name()] {
+			pref.Preferences = append(pref.Preferences, m.Name())
+		}
+	}
+
+	return pref
+}
@@ -0,0 +1,90 @@
+package hrlq
+
+import (
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResidentOptimal(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "R1", Preferences: []string{"H1", "H2"}},
+			{Name: "R2", Preferences: []string{"H1", "H2"}},
+			{Name: "R3", Preferences: []string{"H1", "H3"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "H1", Preferences: []string{"R3", "R2", "R1"}, Capacity: 2},
+			{Name: "H2", Preferences: []string{"R2", "R1"}},
+			{Name: "H3", Preferences: []string{"R3"}},
+		},
+	)
+
+	ptR, ptH := tables[0], tables[1]
+
+	t.Run("all hospitals open", func(t *testing.T) {
+		res, err := residentOptimal(&ptR, &ptH, map[string]bool{})
+
+		wanted := map[string][]string{
+			"H1": {"R3", "R2"},
+			"H2": {"R1"},
+			"H3": {},
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, wanted, res.Assignments)
+		assert.Nil(t, res.Unmatched)
+	})
+
+	t.Run("closed hospitals", func(t *testing.T) {
+		res, err := residentOptimal(&ptR, &ptH, map[string]bool{"H1": true})
+
+		wanted := map[string][]string{
+			"H1": {},
+			"H2": {"R2"},
+			"H3": {"R3"},
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, wanted, res.Assignments)
+		assert.Equal(t, []string{"R1"}, res.Unmatched)
+	})
+
+	t.Run("does not modify the preference tables", func(t *testing.T) {
+		residentOptimal(&ptR, &ptH, map[string]bool{})
+
+		assert.Equal(t, 2, len(ptR["R1"].PreferenceList().Members()))
+		assert.Equal(t, 3, len(ptH["H1"].PreferenceList().Members()))
+	})
+}
+
+func TestOpenTables(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "R1", Preferences: []string{"H1", "H2"}},
+			{Name: "R2", Preferences: []string{"H2"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "H1", Preferences: []string{"R1"}, Capacity: 2, LowerQuota: 2},
+			{Name: "H2", Preferences: []string{"R2", "R1"}, Capacity: 3, LowerQuota: 1},
+		},
+	)
+
+	ptR, ptH := tables[0], tables[1]
+
+	open := openTables(&ptR, &ptH, map[string]bool{"H1": true})
+
+	// Members are copied with their effective capacity
+	wanted := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "R1", Preferences: []string{"H2"}, Capacity: 1},
+			{Name: "R2", Preferences: []string{"H2"}, Capacity: 1},
+		},
+		&[]core.MatchPreference{
+			{Name: "H2", Preferences: []string{"R2", "R1"}, Capacity: 3, LowerQuota: 1},
+		},
+	)
+
+	assert.Equal(t, wanted, open)
+}
@@ -0,0 +1,79 @@
+/*
+Package hrlq implements a solution to the "Hospitals/Residents Problem with
+Lower Quotas" (HR-LQ).
+
+It extends the "Hospitals/Residents Problem" with a lower quota for each
+hospital, which is the minimum number of residents it must be assigned (e.g.
+the minimum staffing level of a rotation). Preference lists may be incomplete,
+so some residents may remain unmatched.
+
+ALGORITHM
+
+See: https://en.wikipedia.org/wiki/National_Resident_Matching_Program
+
+The algorithm runs the resident-proposing deferred acceptance algorithm of
+Gale-Shapley (1962). Each unassigned resident "proposes" to the next hospital
+on their list, and each hospital holds on to its most preferred proposals, up
+to its capacity.
+
+By the "Rural Hospitals Theorem" of Roth (1986), every stable matching assigns
+each hospital the same number of residents. So when the resident-optimal stable
+matching leaves a hospital below its lower quota, no stable matching meets
+every lower quota, and the problem has no solution.
+
+CLOSING HOSPITALS
+
+Hospitals that can not meet their lower quota may instead be closed, so that
+they are assigned no residents at all, as in the model of Biró, Fleiner,
+Irving and Manlove (2010).
+
+The open hospital that is furthest below its lower quota is closed, and the
+deferred acceptance algorithm is run again among the open hospitals. The
+residents it held propose further down their lists, which may help another
+hospital meet its lower quota. This continues until every open hospital meets
+its lower quota. The closed hospitals are recorded in the order they were
+closed.
+
+The matching is stable among the open hospitals. However, deciding whether any
+set of hospitals can be closed to give a stable matching is NP-complete, so a
+group of residents may still prefer a closed hospital that they would fill up
+to its lower quota.
+
+DETERMINISM
+
+The resident-optimal stable matching is unique, and hospitals that are equally
+far below their lower quota are closed in alphabetical order, so the result is
+always deterministic.
+
+ALGORITHM EXAMPLE
+
+Take the following preference tables
+
+	// residents
+	R1 => [H1]
+	R2 => [H2, H3]
+	R3 => [H3]
+
+	// hospitals (lower quota, capacity)
+	H1 (0, 1) => [R1]
+	H2 (3, 3) => [R2]
+	H3 (2, 2) => [R3, R2]
+
+Every resident is held by their first choice
+
+	R1 => H1
+	R2 => H2
+	R3 => H3
+
+"H2" is 2 residents short of its lower quota, and "H3" is 1 short, so no stable
+matching meets every lower quota.
+
+When hospitals may be closed, "H2" is closed first since it is furthest below
+its lower quota. "R2" then proposes to "H3", which holds them, and "H3" meets
+its lower quota
+
+	R1 => H1
+	R2 => H3
+	R3 => H3
+*/
+package hrlq
@@ -0,0 +1,59 @@
+package hrlq
+
+import (
+	"errors"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// ErrLowerQuotasNotMet is returned when no stable matching gives every
+// hospital at least its lower quota of residents, and hospitals may not be
+// closed.
+var ErrLowerQuotasNotMet = errors.New("No stable matching meets every lower quota")
+
+// Run executes the algorithm to solve the "Hospitals/Residents Problem with
+// Lower Quotas" for a set of given preference inputs. `TableA` holds the
+// residents and `TableB` holds the hospitals, each with a lower quota.
+//
+// When some hospital can not meet its lower quota, the resident-optimal
+// stable matching is returned along with `ErrLowerQuotasNotMet`. Setting the
+// algorithm context's `CloseUnderfilled` closes such hospitals one at a time
+// instead, and records them in the result's `Closed` list.
+//
+// See hrlq package documentation for more detail
+func Run(algoCtx core.AlgorithmContext) (core.MatchResult, error) {
+	ptR := algoCtx.TableA
+	ptH := algoCtx.TableB
+
+	closed := make(map[string]bool)
+	var closedOrder []string
+
+	res, err := residentOptimal(ptR, ptH, closed)
+	if err != nil {
+		return res, err
+	}
+
+	under := underfilled(ptH, res.Assignments, closed)
+
+	for len(under) > 0 {
+		if !algoCtx.CloseUnderfilled {
+			return res, ErrLowerQuotasNotMet
+		}
+
+		// Close the hospital furthest from its lower quota, and let its
+		// residents propose elsewhere
+		closed[under[0].Name()] = true
+		closedOrder = append(closedOrder, under[0].Name())
+
+		res, err = residentOptimal(ptR, ptH, closed)
+		if err != nil {
+			return res, err
+		}
+
+		under = underfilled(ptH, res.Assignments, closed)
+	}
+
+	res.Closed = closedOrder
+
+	return res, nil
+}
@@ -0,0 +1,156 @@
+package hrlq
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	prefsSet := []*[]core.MatchPreference{
+		{
+			{Name: "R1", Preferences: []string{"H1"}},
+			{Name: "R2", Preferences: []string{"H2", "H3"}},
+			{Name: "R3", Preferences: []string{"H3"}},
+			{Name: "R4", Preferences: []string{"H2"}},
+		},
+		{
+			{Name: "H1", Preferences: []string{"R1"}},
+			{Name: "H2", Preferences: []string{"R2"}, Capacity: 3, LowerQuota: 3},
+			{Name: "H3", Preferences: []string{"R3", "R2"}, Capacity: 2, LowerQuota: 2},
+		},
+	}
+
+	t.Run("lower quotas met", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "R1", Preferences: []string{"H1", "H2"}},
+				{Name: "R2", Preferences: []string{"H1", "H2"}},
+				{Name: "R3", Preferences: []string{"H2"}},
+			},
+			{
+				{Name: "H1", Preferences: []string{"R2", "R1"}},
+				{Name: "H2", Preferences: []string{"R1", "R3"}, Capacity: 2, LowerQuota: 2},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"R1": "H2",
+				"R2": "H1",
+				"R3": "H2",
+			},
+			Assignments: map[string][]string{
+				"H1": {"R2"},
+				"H2": {"R1", "R3"},
+			},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("lower quotas not met", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"R1": "H1",
+				"R2": "H2",
+				"R3": "H3",
+			},
+			Assignments: map[string][]string{
+				"H1": {"R1"},
+				"H2": {"R2"},
+				"H3": {"R3"},
+			},
+			Unmatched: []string{"R4"},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Equal(t, ErrLowerQuotasNotMet, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("closing underfilled hospitals", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA:           &tables[0],
+			TableB:           &tables[1],
+			CloseUnderfilled: true,
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"R1": "H1",
+				"R2": "H3",
+				"R3": "H3",
+			},
+			Assignments: map[string][]string{
+				"H1": {"R1"},
+				"H2": {},
+				"H3": {"R3", "R2"},
+			},
+			Unmatched: []string{"R4"},
+			Closed:    []string{"H2"},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("closing every hospital", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "R1", Preferences: []string{"H1", "H2"}},
+			},
+			{
+				{Name: "H1", Preferences: []string{"R1"}, Capacity: 2, LowerQuota: 2},
+				{Name: "H2", Preferences: []string{"R1"}, Capacity: 3, LowerQuota: 3},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA:           &tables[0],
+			TableB:           &tables[1],
+			CloseUnderfilled: true,
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{},
+			Assignments: map[string][]string{
+				"H1": {},
+				"H2": {},
+			},
+			Unmatched: []string{"R1"},
+			Closed:    []string{"H2", "H1"},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+}
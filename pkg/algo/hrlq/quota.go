@@ -0,0 +1,40 @@
+package hrlq
+
+import (
+	"sort"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// underfilled returns the open hospitals that are assigned fewer residents than
+// their lower quota, ordered by how many residents they are short of it (most
+// first) and then by name.
+func underfilled(ptH *core.PreferenceTable, assigned map[string][]string, closed map[string]bool) []*core.Member {
+	var hospitals []*core.Member
+
+	for name, hospital := range *ptH {
+		if closed[name] {
+			continue
+		}
+
+		if len(assigned[name]) < hospital.LowerQuota() {
+			hospitals = append(hospitals, hospital)
+		}
+	}
+
+	shortfall := func(hospital *core.Member) int {
+		return hospital.LowerQuota() - len(assigned[hospital.Name()])
+	}
+
+	sort.Slice(hospitals, func(i, j int) bool {
+		si, sj := shortfall(hospitals[i]), shortfall(hospitals[j])
+
+		if si != sj {
+			return si > sj
+		}
+
+		return hospitals[i].Name() < hospitals[j].Name()
+	})
+
+	return hospitals
+}
@@ -0,0 +1,36 @@
+package hrlq
+
+import (
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnderfilled(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "R1", Preferences: []string{"H1"}},
+			{Name: "R2", Preferences: []string{"H2"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "H1", Preferences: []string{"R1"}, Capacity: 2, LowerQuota: 2},
+			{Name: "H2", Preferences: []string{"R2"}, Capacity: 2, LowerQuota: 2},
+			{Name: "H3", Preferences: []string{"R1"}, Capacity: 3, LowerQuota: 2},
+			{Name: "H4", Preferences: []string{"R2"}, Capacity: 2},
+			{Name: "H5", Preferences: []string{"R1"}, Capacity: 2, LowerQuota: 1},
+		},
+	)
+
+	ptH := tables[1]
+
+	assigned := map[string][]string{
+		"H1": {"R1"},
+		"H2": {"R2"},
+	}
+
+	// Ordered by shortfall, then by name, skipping closed hospitals
+	wanted := []*core.Member{ptH["H3"], ptH["H1"]}
+
+	assert.Equal(t, wanted, underfilled(&ptH, assigned, map[string]bool{"H2": true, "H5": true}))
+}
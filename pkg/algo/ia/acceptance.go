@@ -0,0 +1,82 @@
+package ia
+
+import (
+	"sort"
+
+	"github.com/abhchand/libmatch/pkg/algo/internal/schoolchoice"
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// immediateAcceptance implements the immediate acceptance algorithm, commonly
+// known as the Boston mechanism.
+//
+// In round "k" each student who has not been admitted to a school applies to
+// the k-th school on their list. Each school admits the applicants it gives the
+// highest priority, up to its remaining capacity, and admissions are final.
+// Ties in priority are broken by the school's lottery.
+//
+// The preference tables are not modified.
+//
+// See ia package documentation for more detail
+func immediateAcceptance(ptS, ptC *core.PreferenceTable, lot schoolchoice.LotteryNumbers) schoolchoice.Assignments {
+	admitted := make(schoolchoice.Assignments, len(*ptC))
+
+	seats := make(map[string]int, len(*ptC))
+	for name, school := range *ptC {
+		seats[name] = school.Capacity()
+	}
+
+	students := ptS.SortedNames()
+	placed := make(map[string]bool, len(students))
+
+	for round := 0; ; round++ {
+		applicants := make(map[string][]*core.Member)
+		applied := false
+
+		for _, name := range students {
+			student := (*ptS)[name]
+			schools := student.PreferenceList().Members()
+
+			if placed[name] || round >= len(schools) {
+				continue
+			}
+
+			school := schools[round]
+			applicants[school.Name()] = append(applicants[school.Name()], student)
+			applied = true
+		}
+
+		if !applied {
+			break
+		}
+
+		for name, students := range applicants {
+			school := (*ptC)[name]
+
+			for _, student := range admit(school, students, seats[name], lot) {
+				admitted[name] = append(admitted[name], student)
+				placed[student.Name()] = true
+				seats[name]--
+			}
+		}
+	}
+
+	return admitted
+}
+
+// admit returns the applicants a school admits in a round, which are those it
+// gives the highest priority, up to the number of seats it has left.
+func admit(school *core.Member, applicants []*core.Member, seats int, lot schoolchoice.LotteryNumbers) []*core.Member {
+	ordered := make([]*core.Member, len(applicants))
+	copy(ordered, applicants)
+
+	sort.Slice(ordered, func(i, j int) bool {
+		return schoolchoice.Precedes(school, ordered[i], ordered[j], lot)
+	})
+
+	if seats < len(ordered) {
+		ordered = ordered[:seats]
+	}
+
+	return ordered
+}
@@ -0,0 +1,96 @@
+package ia
+
+import (
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/algo/internal/schoolchoice"
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImmediateAcceptance(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "S1", Preferences: []string{"X", "Y"}},
+			{Name: "S2", Preferences: []string{"Y", "X"}},
+			{Name: "S3", Preferences: []string{"Y", "X"}},
+		},
+		&[]core.MatchPreference{
+			{
+				Name:              "X",
+				Preferences:       []string{"S2", "S1", "S3"},
+				RankedPreferences: [][]string{{"S2"}, {"S1", "S3"}},
+			},
+			{
+				Name:              "Y",
+				Preferences:       []string{"S1", "S2", "S3"},
+				RankedPreferences: [][]string{{"S1", "S2", "S3"}},
+			},
+		},
+	)
+
+	ptS, ptC := tables[0], tables[1]
+
+	numbers := map[string]int{"S1": 2, "S2": 3, "S3": 1}
+	lot := schoolchoice.LotteryNumbers{"X": numbers, "Y": numbers}
+
+	admitted := immediateAcceptance(&ptS, &ptC, lot)
+
+	wanted := schoolchoice.Assignments{
+		"X": {ptS["S1"]},
+		"Y": {ptS["S3"]},
+	}
+
+	assert.Equal(t, wanted, admitted)
+
+	// The preference tables are not modified
+	assert.Equal(t, 2, len(ptS["S2"].PreferenceList().Members()))
+}
+
+func TestAdmit(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "S1", Preferences: []string{"X"}},
+			{Name: "S2", Preferences: []string{"X"}},
+			{Name: "S3", Preferences: []string{"X"}},
+		},
+		&[]core.MatchPreference{
+			{
+				Name:              "X",
+				Preferences:       []string{"S3", "S1", "S2"},
+				RankedPreferences: [][]string{{"S3"}, {"S1", "S2"}},
+				Capacity:          3,
+			},
+		},
+	)
+
+	ptS, ptC := tables[0], tables[1]
+
+	lot := schoolchoice.LotteryNumbers{"X": {"S1": 3, "S2": 1, "S3": 2}}
+
+	applicants := []*core.Member{ptS["S1"], ptS["S2"], ptS["S3"]}
+
+	t.Run("admits applicants in order of priority", func(t *testing.T) {
+		admitted := admit(ptC["X"], applicants, 2, lot)
+
+		assert.Equal(t, []*core.Member{ptS["S3"], ptS["S2"]}, admitted)
+	})
+
+	t.Run("admits every applicant when seats are left", func(t *testing.T) {
+		admitted := admit(ptC["X"], applicants, 5, lot)
+
+		assert.Equal(t, []*core.Member{ptS["S3"], ptS["S2"], ptS["S1"]}, admitted)
+	})
+
+	t.Run("admits no one when full", func(t *testing.T) {
+		admitted := admit(ptC["X"], applicants, 0, lot)
+
+		assert.Equal(t, []*core.Member{}, admitted)
+	})
+
+	t.Run("does not modify the applicants", func(t *testing.T) {
+		admit(ptC["X"], applicants, 2, lot)
+
+		assert.Equal(t, []*core.Member{ptS["S1"], ptS["S2"], ptS["S3"]}, applicants)
+	})
+}
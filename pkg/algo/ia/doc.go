@@ -0,0 +1,73 @@
+/*
+Package ia implements the "Immediate Acceptance" algorithm (IA) for school
+choice, commonly known as the Boston mechanism, with ties in priority broken by
+lottery.
+
+It takes the same inputs as the deferred acceptance algorithm (see package da):
+students rank the schools they would like to attend, and schools have a
+capacity and rank students in coarse priority classes. Students a school does
+not rank form its lowest priority class. The same seed draws the same lottery
+in both algorithms, so that their results can be compared side by side (e.g.
+to count the students that would be better or worse off under each).
+
+Unlike deferred acceptance, admissions are final as soon as they are made. The
+result is therefore not stable: a student may be refused a school they prefer,
+and have a higher priority at, than a student it admitted in an earlier round.
+Students may also do better by ranking schools strategically, rather than in
+their true order of preference.
+
+ALGORITHM
+
+See: https://en.wikipedia.org/wiki/School_choice
+
+Ties between students of the same priority class are first broken by lottery,
+exactly as in the deferred acceptance algorithm.
+
+Students then apply to schools in rounds. In round "k" each student who has not
+been admitted applies to the k-th school on their list, and each school admits
+the applicants it gives the highest priority, up to its remaining capacity.
+The other applicants are rejected, and try their next school in the next
+round. Students who are rejected by every school they ranked are left
+unmatched.
+
+DETERMINISM
+
+The lottery is drawn from a seeded random source, with students and schools
+listed by name. Admissions in a round only depend on the applicants to each
+school, so the same seed always produces the same result.
+
+ALGORITHM EXAMPLE
+
+Take the following preference tables, where tied students are grouped in
+brackets
+
+	// students
+	S1 => [X, Y]
+	S2 => [Y, X]
+	S3 => [Y, X]
+
+	// schools (capacity)
+	X (1) => [S2, [S1, S3]]
+	Y (1) => [[S1, S2, S3]]
+
+A single lottery draws the following numbers
+
+	S1 => 2
+	S2 => 3
+	S3 => 1
+
+In the first round "S1" applies to "X", which admits them. "S2" and "S3" apply
+to "Y", which admits "S3", who has the better lottery number.
+
+In the second round "S2" applies to "X", which has no seats left. This gives us
+the following assignments
+
+	S1 => X
+	S2 => (unmatched)
+	S3 => Y
+
+"S2" has the highest priority at "X", but lost their seat there by applying to
+"Y" first. Deferred acceptance would instead give "X" to "S2" and leave "S1"
+unmatched.
+*/
+package ia
@@ -0,0 +1,32 @@
+package ia
+
+import (
+	"github.com/abhchand/libmatch/pkg/algo/internal/schoolchoice"
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// Run executes the immediate acceptance algorithm (IA) for school choice,
+// commonly known as the Boston mechanism, for a set of given preference inputs.
+// `TableA` holds the students and `TableB` holds the schools.
+//
+// It accepts the same inputs and options as the deferred acceptance algorithm,
+// and draws the same lottery from the same seed, so that the two may be
+// compared side by side.
+//
+// Schools rank students in coarse priority classes, which may contain ties.
+// Ties are broken by a lottery drawn from the algorithm context's `Seed`,
+// either once for every school (`core.LotterySingle`, the default) or
+// separately for each school (`core.LotteryMultiple`). The lottery numbers are
+// recorded in the result, and the same seed always produces the same result.
+//
+// See ia package documentation for more detail
+func Run(algoCtx core.AlgorithmContext) (core.MatchResult, error) {
+	ptS := algoCtx.TableA
+	ptC := algoCtx.TableB
+
+	lot := schoolchoice.DrawLottery(ptS, ptC, algoCtx.Lottery, algoCtx.Seed)
+
+	admitted := immediateAcceptance(ptS, ptC, lot)
+
+	return schoolchoice.BuildResult(ptS, ptC, admitted, lot), nil
+}
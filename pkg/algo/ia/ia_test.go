@@ -0,0 +1,123 @@
+package ia
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	prefsSet := []*[]core.MatchPreference{
+		{
+			{Name: "S1", Preferences: []string{"X", "Y"}},
+			{Name: "S2", Preferences: []string{"Y", "X"}},
+			{Name: "S3", Preferences: []string{"Y", "X"}},
+		},
+		{
+			{
+				Name:              "X",
+				Preferences:       []string{"S2", "S1", "S3"},
+				RankedPreferences: [][]string{{"S2"}, {"S1", "S3"}},
+			},
+			{
+				Name:              "Y",
+				Preferences:       []string{"S1", "S2", "S3"},
+				RankedPreferences: [][]string{{"S1", "S2", "S3"}},
+			},
+		},
+	}
+
+	t.Run("single lottery", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"S1": "X",
+				"S3": "Y",
+			},
+			Assignments: map[string][]string{
+				"X": {"S1"},
+				"Y": {"S3"},
+			},
+			Unmatched: []string{"S2"},
+			Lottery: map[string]map[string]int{
+				"X": {"S1": 2, "S2": 3, "S3": 1},
+				"Y": {"S1": 2, "S2": 3, "S3": 1},
+			},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("seed changes the lottery", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+			Seed:   1,
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"S1": "X",
+				"S2": "Y",
+			},
+			Assignments: map[string][]string{
+				"X": {"S1"},
+				"Y": {"S2"},
+			},
+			Unmatched: []string{"S3"},
+			Lottery: map[string]map[string]int{
+				"X": {"S1": 1, "S2": 2, "S3": 3},
+				"Y": {"S1": 1, "S2": 2, "S3": 3},
+			},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("multiple lotteries", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA:  &tables[0],
+			TableB:  &tables[1],
+			Lottery: core.LotteryMultiple,
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"S1": "X",
+				"S3": "Y",
+			},
+			Assignments: map[string][]string{
+				"X": {"S1"},
+				"Y": {"S3"},
+			},
+			Unmatched: []string{"S2"},
+			Lottery: map[string]map[string]int{
+				"X": {"S1": 2, "S2": 3, "S3": 1},
+				"Y": {"S1": 1, "S2": 3, "S3": 2},
+			},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+}
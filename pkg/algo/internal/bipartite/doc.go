@@ -0,0 +1,13 @@
+/*
+Package bipartite implements maximum matchings of bipartite graphs, shared by
+the one-sided matching algorithms.
+
+A graph is given as the list of right nodes adjacent to each left node (e.g.
+the houses each applicant finds acceptable), where nodes on each side are
+identified by their index.
+
+Given a maximum matching, each node can also be labelled by the alternating
+paths that reach it from an unmatched node. This is the Gallai-Edmonds
+decomposition, which tells which edges may belong to any maximum matching.
+*/
+package bipartite
@@ -0,0 +1,68 @@
+package bipartite
+
+// Matching is a matching of a bipartite graph, tracking the right node matched
+// with each left node and the left node matched with each right node by index.
+// A value of -1 means the node is unmatched.
+type Matching struct {
+	LeftMate  []int
+	RightMate []int
+}
+
+// NewMatching returns an empty matching of a graph with the specified number
+// of left and right nodes.
+func NewMatching(left, right int) Matching {
+	m := Matching{
+		LeftMate:  make([]int, left),
+		RightMate: make([]int, right),
+	}
+
+	for l := range m.LeftMate {
+		m.LeftMate[l] = -1
+	}
+
+	for r := range m.RightMate {
+		m.RightMate[r] = -1
+	}
+
+	return m
+}
+
+// Match matches a left node with a right node
+func (m *Matching) Match(l, r int) {
+	m.LeftMate[l] = r
+	m.RightMate[r] = l
+}
+
+// Maximize extends a matching into a maximum matching of a graph, given as the
+// list of right nodes adjacent to each left node, by repeatedly finding
+// augmenting paths. Nodes matched before remain matched, though possibly with
+// another node.
+func Maximize(edges [][]int, m *Matching) {
+	for l := range edges {
+		if m.LeftMate[l] != -1 {
+			continue
+		}
+
+		visited := make(map[int]bool)
+		augment(l, edges, m, visited)
+	}
+}
+
+// augment searches for an augmenting path starting at an unmatched left node,
+// using depth-first search. When found, the matching is flipped along the path
+// so that it grows by one.
+func augment(l int, edges [][]int, m *Matching, visited map[int]bool) bool {
+	for _, r := range edges[l] {
+		if visited[r] {
+			continue
+		}
+		visited[r] = true
+
+		if m.RightMate[r] == -1 || augment(m.RightMate[r], edges, m, visited) {
+			m.Match(l, r)
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,56 @@
+package bipartite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMatching(t *testing.T) {
+	m := NewMatching(2, 3)
+
+	assert.Equal(t, []int{-1, -1}, m.LeftMate)
+	assert.Equal(t, []int{-1, -1, -1}, m.RightMate)
+}
+
+func TestMatch(t *testing.T) {
+	m := NewMatching(2, 3)
+
+	m.Match(1, 2)
+
+	assert.Equal(t, []int{-1, 2}, m.LeftMate)
+	assert.Equal(t, []int{-1, -1, 1}, m.RightMate)
+}
+
+func TestMaximize(t *testing.T) {
+	edges := [][]int{
+		{0, 1},
+		{0},
+		{1, 2},
+	}
+
+	m := Matching{
+		LeftMate:  []int{0, -1, -1},
+		RightMate: []int{0, -1, -1},
+	}
+
+	Maximize(edges, &m)
+
+	assert.Equal(t, []int{1, 0, 2}, m.LeftMate)
+	assert.Equal(t, []int{1, 0, 2}, m.RightMate)
+}
+
+func TestAugment(t *testing.T) {
+	edges := [][]int{
+		{0},
+		{0},
+	}
+
+	m := Matching{
+		LeftMate:  []int{0, -1},
+		RightMate: []int{0},
+	}
+
+	assert.False(t, augment(1, edges, &m, make(map[int]bool)))
+	assert.Equal(t, []int{0, -1}, m.LeftMate)
+}
@@ -0,0 +1,101 @@
+package bipartite
+
+// Parity labels a node of a graph by the alternating paths that reach it from
+// an unmatched node, given a maximum matching.
+type Parity int
+
+const (
+	// Unreachable nodes can not be reached by any alternating path
+	Unreachable Parity = iota
+
+	// Even nodes can be reached by an alternating path of even length
+	Even
+
+	// Odd nodes can be reached by an alternating path of odd length
+	Odd
+)
+
+// IsMaximumMatchingEdge indicates whether an edge between nodes of the given
+// parities may belong to a maximum matching. Every maximum matching only
+// contains edges between an even and an odd node, or between two unreachable
+// nodes.
+func IsMaximumMatchingEdge(left, right Parity) bool {
+	if left == Unreachable || right == Unreachable {
+		return left == right
+	}
+
+	return left != right
+}
+
+// Label computes the parity of every left and right node with respect to a
+// maximum matching of a graph, given as the list of right nodes adjacent to
+// each left node.
+//
+// Starting from every unmatched node, which is even, alternating paths are
+// followed in breadth-first order. Unmatched edges lead from an even node to
+// an odd node, and matched edges lead from an odd node to an even node.
+func Label(edges [][]int, m Matching) ([]Parity, []Parity) {
+	leftParity := make([]Parity, len(m.LeftMate))
+	rightParity := make([]Parity, len(m.RightMate))
+
+	// The left nodes adjacent to each right node
+	neighbours := make([][]int, len(m.RightMate))
+	for l := range edges {
+		for _, r := range edges[l] {
+			neighbours[r] = append(neighbours[r], l)
+		}
+	}
+
+	// Queue nodes as (side, index) pairs, where side 0 is a left node and
+	// side 1 is a right node
+	type node struct{ side, index int }
+	var queue []node
+
+	for l := range leftParity {
+		if m.LeftMate[l] == -1 {
+			leftParity[l] = Even
+			queue = append(queue, node{0, l})
+		}
+	}
+
+	for r := range rightParity {
+		if m.RightMate[r] == -1 {
+			rightParity[r] = Even
+			queue = append(queue, node{1, r})
+		}
+	}
+
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+
+		switch {
+		case n.side == 0 && leftParity[n.index] == Even:
+			for _, r := range edges[n.index] {
+				if rightParity[r] == Unreachable {
+					rightParity[r] = Odd
+					queue = append(queue, node{1, r})
+				}
+			}
+		case n.side == 0 && leftParity[n.index] == Odd:
+			if r := m.LeftMate[n.index]; rightParity[r] == Unreachable {
+				rightParity[r] = Even
+				queue = append(queue, node{1, r})
+			}
+		case n.side == 1 && rightParity[n.index] == Even:
+			for _, l := range neighbours[n.index] {
+				if leftParity[l] == Unreachable {
+					leftParity[l] = Odd
+					queue = append(queue, node{0, l})
+				}
+			}
+		case n.side == 1 && rightParity[n.index] == Odd:
+			if l := m.RightMate[n.index]; leftParity[l] == Unreachable {
+				leftParity[l] = Even
+				queue = append(queue, node{0, l})
+			}
+		}
+	}
+
+	return leftParity, rightParity
+}
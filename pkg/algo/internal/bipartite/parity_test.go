@@ -0,0 +1,46 @@
+package bipartite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsMaximumMatchingEdge(t *testing.T) {
+	assert.True(t, IsMaximumMatchingEdge(Even, Odd))
+	assert.True(t, IsMaximumMatchingEdge(Odd, Even))
+	assert.True(t, IsMaximumMatchingEdge(Unreachable, Unreachable))
+
+	assert.False(t, IsMaximumMatchingEdge(Odd, Odd))
+	assert.False(t, IsMaximumMatchingEdge(Even, Even))
+	assert.False(t, IsMaximumMatchingEdge(Odd, Unreachable))
+	assert.False(t, IsMaximumMatchingEdge(Unreachable, Odd))
+}
+
+func TestLabel(t *testing.T) {
+	t.Run("every right node reachable", func(t *testing.T) {
+		edges := [][]int{{0}, {2}, {2}}
+
+		m := NewMatching(3, 3)
+		m.Match(0, 0)
+		m.Match(1, 2)
+
+		leftParity, rightParity := Label(edges, m)
+
+		assert.Equal(t, []Parity{Unreachable, Even, Even}, leftParity)
+		assert.Equal(t, []Parity{Unreachable, Even, Odd}, rightParity)
+	})
+
+	t.Run("more right nodes than left nodes", func(t *testing.T) {
+		edges := [][]int{{0, 1}, {0}, {0}}
+
+		m := NewMatching(3, 6)
+		m.Match(0, 1)
+		m.Match(1, 0)
+
+		leftParity, rightParity := Label(edges, m)
+
+		assert.Equal(t, []Parity{Unreachable, Even, Even}, leftParity)
+		assert.Equal(t, []Parity{Odd, Unreachable, Even, Even, Even, Even}, rightParity)
+	})
+}
@@ -0,0 +1,10 @@
+/*
+Package schoolchoice implements the building blocks shared by the school
+choice algorithms.
+
+Students rank the schools they would like to attend, and schools rank students
+in coarse priority classes which may contain ties. Ties are broken by a lottery,
+drawn from a seeded random source so that the same seed always produces the
+same result.
+*/
+package schoolchoice
@@ -0,0 +1,55 @@
+package schoolchoice
+
+import (
+	"math/rand"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// LotteryNumbers maps each school name to the lottery number it drew for each
+// student, keyed by student name. Numbers start at 1, and a lower number
+// breaks a tie in the student's favour.
+type LotteryNumbers map[string]map[string]int
+
+// DrawLottery draws the lottery numbers of every school in `ptC` for every
+// student in `ptS`, from a seeded random source. Defaults to a single lottery
+// when unspecified.
+//
+// With a single lottery, the students are shuffled once and every school uses
+// the same numbers. With multiple lotteries, the students are shuffled again
+// for each school, by name. Students are listed by name before being shuffled,
+// so that the same seed always draws the same numbers, whichever algorithm
+// draws them.
+func DrawLottery(ptS, ptC *core.PreferenceTable, lottery core.Lottery, seed int64) LotteryNumbers {
+	r := rand.New(rand.NewSource(seed))
+
+	students := ptS.SortedNames()
+	schools := ptC.SortedNames()
+
+	numbers := make(LotteryNumbers, len(schools))
+
+	var drawn map[string]int
+
+	for _, school := range schools {
+		if drawn == nil || lottery == core.LotteryMultiple {
+			drawn = drawNumbers(students, r)
+		}
+
+		numbers[school] = drawn
+	}
+
+	return numbers
+}
+
+// drawNumbers shuffles a list of students and numbers them in their shuffled
+// order, starting at 1.
+func drawNumbers(students []string, r *rand.Rand) map[string]int {
+	numbers := make(map[string]int, len(students))
+
+	perm := r.Perm(len(students))
+	for i := range students {
+		numbers[students[i]] = perm[i] + 1
+	}
+
+	return numbers
+}
@@ -0,0 +1,62 @@
+package schoolchoice
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDrawLottery(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "S1", Preferences: []string{"X", "Y"}},
+			{Name: "S2", Preferences: []string{"X", "Y"}},
+			{Name: "S3", Preferences: []string{"X", "Y"}},
+			{Name: "S4", Preferences: []string{"Y", "X"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "X", Preferences: []string{"S1", "S2", "S3", "S4"}},
+			{Name: "Y", Preferences: []string{"S1", "S2", "S3", "S4"}},
+		},
+	)
+
+	ptS, ptC := tables[0], tables[1]
+
+	t.Run("single lottery", func(t *testing.T) {
+		numbers := map[string]int{"S1": 2, "S2": 3, "S3": 4, "S4": 1}
+		wanted := LotteryNumbers{"X": numbers, "Y": numbers}
+
+		assert.Equal(t, wanted, DrawLottery(&ptS, &ptC, core.LotterySingle, 0))
+	})
+
+	t.Run("multiple lotteries", func(t *testing.T) {
+		wanted := LotteryNumbers{
+			"X": {"S1": 2, "S2": 3, "S3": 4, "S4": 1},
+			"Y": {"S1": 2, "S2": 4, "S3": 1, "S4": 3},
+		}
+
+		assert.Equal(t, wanted, DrawLottery(&ptS, &ptC, core.LotteryMultiple, 0))
+	})
+
+	t.Run("defaults to a single lottery", func(t *testing.T) {
+		assert.Equal(t, DrawLottery(&ptS, &ptC, core.LotterySingle, 5), DrawLottery(&ptS, &ptC, "", 5))
+	})
+}
+
+func TestDrawNumbers(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	numbers := drawNumbers([]string{"A", "B", "C"}, r)
+
+	// Every student draws a distinct number from 1 to 3
+	drawn := make([]int, 0, len(numbers))
+	for _, number := range numbers {
+		drawn = append(drawn, number)
+	}
+	sort.Ints(drawn)
+
+	assert.Equal(t, []int{1, 2, 3}, drawn)
+}
@@ -0,0 +1,31 @@
+package schoolchoice
+
+import (
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// PriorityClass returns the priority class a school gives a student, where a
+// lower value is a higher priority. Students the school does not rank come
+// last, in a class of their own.
+func PriorityClass(school, student *core.Member) int {
+	pl := school.PreferenceList()
+
+	if rank := pl.RankOf(*student); rank != -1 {
+		return rank
+	}
+
+	return len(pl.Members())
+}
+
+// Precedes indicates whether a school gives one student a higher priority than
+// another, breaking ties between students of the same priority class by the
+// school's lottery.
+func Precedes(school, a, b *core.Member, lot LotteryNumbers) bool {
+	ca, cb := PriorityClass(school, a), PriorityClass(school, b)
+
+	if ca != cb {
+		return ca < cb
+	}
+
+	return lot[school.Name()][a.Name()] < lot[school.Name()][b.Name()]
+}
@@ -0,0 +1,64 @@
+package schoolchoice
+
+import (
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriorityClass(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "S1", Preferences: []string{"X"}},
+			{Name: "S2", Preferences: []string{"X"}},
+			{Name: "S3", Preferences: []string{"X"}},
+			{Name: "S4", Preferences: []string{"X"}},
+		},
+		&[]core.MatchPreference{
+			{
+				Name:              "X",
+				Preferences:       []string{"S2", "S1", "S3"},
+				RankedPreferences: [][]string{{"S2"}, {"S1", "S3"}},
+			},
+		},
+	)
+
+	ptS, ptC := tables[0], tables[1]
+
+	assert.Equal(t, 0, PriorityClass(ptC["X"], ptS["S2"]))
+	assert.Equal(t, 1, PriorityClass(ptC["X"], ptS["S1"]))
+	assert.Equal(t, 1, PriorityClass(ptC["X"], ptS["S3"]))
+	assert.Equal(t, 3, PriorityClass(ptC["X"], ptS["S4"]))
+}
+
+func TestPrecedes(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "S1", Preferences: []string{"X"}},
+			{Name: "S2", Preferences: []string{"X"}},
+			{Name: "S3", Preferences: []string{"X"}},
+		},
+		&[]core.MatchPreference{
+			{
+				Name:              "X",
+				Preferences:       []string{"S3", "S1", "S2"},
+				RankedPreferences: [][]string{{"S3"}, {"S1", "S2"}},
+			},
+		},
+	)
+
+	ptS, ptC := tables[0], tables[1]
+
+	lot := LotteryNumbers{"X": {"S1": 3, "S2": 2, "S3": 1}}
+
+	t.Run("higher priority class", func(t *testing.T) {
+		assert.True(t, Precedes(ptC["X"], ptS["S3"], ptS["S2"], lot))
+		assert.False(t, Precedes(ptC["X"], ptS["S2"], ptS["S3"], lot))
+	})
+
+	t.Run("same priority class", func(t *testing.T) {
+		assert.True(t, Precedes(ptC["X"], ptS["S2"], ptS["S1"], lot))
+		assert.False(t, Precedes(ptC["X"], ptS["S1"], ptS["S2"], lot))
+	})
+}
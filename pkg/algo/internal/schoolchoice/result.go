@@ -0,0 +1,56 @@
+package schoolchoice
+
+import (
+	"sort"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// Assignments tracks the students assigned to each school, keyed by school
+// name.
+type Assignments map[string][]*core.Member
+
+// BuildResult constructs a Match Result from the students assigned to each
+// school at the end of an algorithm run, along with the lottery numbers drawn.
+// Each school's students are listed in its order of priority, and students
+// assigned to no school are unmatched.
+func BuildResult(ptS, ptC *core.PreferenceTable, assigned Assignments, lot LotteryNumbers) core.MatchResult {
+	res := core.MatchResult{}
+
+	res.Mapping = make(map[string]string)
+	res.Assignments = make(map[string][]string, len(*ptC))
+	res.Lottery = make(map[string]map[string]int, len(lot))
+
+	for name, school := range *ptC {
+		students := assigned[name]
+
+		// List students in the school's order of priority
+		sort.Slice(students, func(i, j int) bool {
+			return Precedes(school, students[i], students[j], lot)
+		})
+
+		res.Assignments[name] = make([]string, len(students))
+		for i := range students {
+			res.Assignments[name][i] = students[i].Name()
+			res.Mapping[students[i].Name()] = name
+		}
+	}
+
+	for name := range *ptS {
+		if _, ok := res.Mapping[name]; !ok {
+			res.Unmatched = append(res.Unmatched, name)
+		}
+	}
+
+	sort.Strings(res.Unmatched)
+
+	for school, numbers := range lot {
+		res.Lottery[school] = make(map[string]int, len(numbers))
+
+		for student, number := range numbers {
+			res.Lottery[school][student] = number
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,41 @@
+package schoolchoice
+
+import (
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildResult(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "S1", Preferences: []string{"X"}},
+			{Name: "S2", Preferences: []string{"X"}},
+			{Name: "S3", Preferences: []string{"X", "Y"}},
+		},
+		&[]core.MatchPreference{
+			{
+				Name:              "X",
+				Capacity:          2,
+				Preferences:       []string{"S1", "S2", "S3"},
+				RankedPreferences: [][]string{{"S1", "S2", "S3"}},
+			},
+			{Name: "Y", Preferences: []string{"S3"}},
+		},
+	)
+
+	ptS, ptC := tables[0], tables[1]
+
+	numbers := map[string]int{"S1": 3, "S2": 1, "S3": 2}
+	lot := LotteryNumbers{"X": numbers, "Y": numbers}
+
+	assigned := Assignments{"X": {ptS["S1"], ptS["S2"]}}
+
+	res := BuildResult(&ptS, &ptC, assigned, lot)
+
+	assert.Equal(t, map[string]string{"S1": "X", "S2": "X"}, res.Mapping)
+	assert.Equal(t, map[string][]string{"X": {"S2", "S1"}, "Y": {}}, res.Assignments)
+	assert.Equal(t, []string{"S3"}, res.Unmatched)
+	assert.Equal(t, map[string]map[string]int{"X": numbers, "Y": numbers}, res.Lottery)
+}
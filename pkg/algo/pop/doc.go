@@ -0,0 +1,100 @@
+/*
+Package pop implements a solution to the "Popular Matching" problem (POP) for
+one-sided house allocation.
+
+Only applicants have preferences, ranking the houses they find acceptable.
+Houses have no preferences and a capacity of 1. Applicants may rank houses
+equally (ties).
+
+A matching is "popular" if there is no other matching that more applicants
+prefer than prefer it. A popular matching is not guaranteed to exist.
+
+It implements the algorithm of Abraham, Irving, Kavitha and Mehlhorn (2007)
+"Popular Matchings", which either finds a popular matching or determines that
+none exists.
+
+ALGORITHM
+
+See: https://en.wikipedia.org/wiki/Popular_matching
+
+First, each applicant is given a "last resort" house of their own, ranked
+below all other houses. Being matched with it is the same as being unmatched.
+
+Without ties, let f(a) be the first choice of applicant "a", and s(a) their
+most preferred house that is not the first choice of any applicant. A matching
+is popular if, and only if -
+
+1. Every house that is the first choice of some applicant is matched
+
+2. Every applicant "a" is matched with either f(a) or s(a)
+
+With ties, f(a) is the set of houses that "a" ranks first. A maximum matching
+of the "first choice" graph, with an edge between each applicant "a" and each
+house in f(a), labels every applicant and house as "even", "odd" or
+"unreachable", by the length of the alternating paths that reach it from an
+unmatched applicant or house. s(a) is the set of most preferred houses of "a"
+that are even. A matching is popular if, and only if -
+
+1. The edges it shares with the first choice graph form a maximum matching of
+the first choice graph
+
+2. Every applicant "a" is matched with a house in f(a) or s(a)
+
+Without ties, both characterizations are the same.
+
+The algorithm starts from the maximum matching of the first choice graph. It
+removes the edges of the first choice graph that can not be part of any of its
+maximum matchings (those between two odd nodes, or between an odd and an
+unreachable node), and adds an edge between each even applicant "a" and each
+house in s(a). The matching is then extended into a maximum matching of this
+"reduced" graph.
+
+A popular matching exists if, and only if, this matches every applicant.
+
+DETERMINISM
+
+Applicants and houses are considered in alphabetical order, and each
+applicant's houses in order of preference. The result is therefore always
+deterministic, though it may be one of many popular matchings.
+
+ALGORITHM EXAMPLE
+
+Take the following preference table
+
+	A1 => [H1, H2, H3]
+	A2 => [H1, H3]
+	A3 => [H2, H1]
+	A4 => [H2, H4]
+
+The first choices are "H1" and "H2". The most preferred houses that are not a
+first choice give
+
+	s(A1) = H3
+	s(A2) = H3
+	s(A3) = (last resort)
+	s(A4) = H4
+
+Each applicant must be matched with their first choice or with s(a), and
+both "H1" and "H2" must be matched. This gives us the popular matching
+
+	A1 => H3
+	A2 => H1
+	A3 => (unmatched)
+	A4 => H2
+
+Matching "A3" with "H2" and "A4" with "H4" instead is also popular, since "A3"
+and "A4" disagree on which of the two matchings is better.
+
+Now take the following preference table, where every applicant ranks the
+houses identically
+
+	A1 => [H1, H2, H3]
+	A2 => [H1, H2, H3]
+	A3 => [H1, H2, H3]
+
+Only "H1" is a first choice, and s(a) is "H2" for every applicant. Two
+applicants would have to be matched with "H2", so no popular matching exists.
+Indeed, for any matching, the two applicants who are not matched with "H1" both
+prefer a matching that gives one of them "H1" and the other "H2".
+*/
+package pop
@@ -0,0 +1,72 @@
+package pop
+
+import (
+	"github.com/abhchand/libmatch/pkg/algo/internal/bipartite"
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// houseGraph models the preferences of applicants over houses as a bipartite
+// graph, where applicants and houses are identified by their index.
+//
+// Houses are sorted by name, and each applicant is given a "last resort" house
+// of their own that they rank below all other houses. The last resort house of
+// the applicant at index `a` has index `len(houses) + a`. Being matched with
+// it is the same as being unmatched.
+type houseGraph struct {
+	applicants []*core.Member
+	houses     []*core.Member
+
+	// groups lists the houses each applicant ranks, grouped by rank in order
+	// of preference, ending with their last resort house.
+	groups [][][]int
+}
+
+// newHouseGraph builds the house graph of a table of applicants and a table of
+// houses.
+func newHouseGraph(ptA, ptH *core.PreferenceTable) houseGraph {
+	g := houseGraph{
+		applicants: ptA.SortedMembers(),
+		houses:     ptH.SortedMembers(),
+	}
+
+	index := make(map[string]int, len(g.houses))
+	for h := range g.houses {
+		index[g.houses[h].Name()] = h
+	}
+
+	g.groups = make([][][]int, len(g.applicants))
+
+	for a := range g.applicants {
+		groups := g.applicants[a].PreferenceList().Groups()
+
+		for i := range groups {
+			group := make([]int, len(groups[i]))
+			for j := range groups[i] {
+				group[j] = index[groups[i][j].Name()]
+			}
+
+			g.groups[a] = append(g.groups[a], group)
+		}
+
+		g.groups[a] = append(g.groups[a], []int{g.lastResort(a)})
+	}
+
+	return g
+}
+
+// numHouses returns the number of houses, including last resort houses.
+func (g houseGraph) numHouses() int {
+	return len(g.houses) + len(g.applicants)
+}
+
+// lastResort returns the index of the last resort house of an applicant.
+func (g houseGraph) lastResort(a int) int {
+	return len(g.houses) + a
+}
+
+// newMatching returns an empty matching of the house graph, where applicants
+// are the left nodes and houses (including last resort houses) are the right
+// nodes.
+func (g houseGraph) newMatching() bipartite.Matching {
+	return bipartite.NewMatching(len(g.applicants), g.numHouses())
+}
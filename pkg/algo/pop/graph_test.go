@@ -0,0 +1,55 @@
+package pop
+
+import (
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHouseGraph(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "A2", Preferences: []string{"H2"}},
+			{
+				Name:              "A1",
+				Preferences:       []string{"H2", "H1", "H3"},
+				RankedPreferences: [][]string{{"H2", "H1"}, {"H3"}},
+			},
+		},
+		&[]core.MatchPreference{
+			{Name: "H3"},
+			{Name: "H1"},
+			{Name: "H2"},
+		},
+	)
+
+	ptA, ptH := tables[0], tables[1]
+
+	g := newHouseGraph(&ptA, &ptH)
+
+	assert.Equal(t, []*core.Member{ptA["A1"], ptA["A2"]}, g.applicants)
+	assert.Equal(t, []*core.Member{ptH["H1"], ptH["H2"], ptH["H3"]}, g.houses)
+	assert.Equal(t, [][][]int{
+		{{1, 0}, {2}, {3}},
+		{{1}, {4}},
+	}, g.groups)
+	assert.Equal(t, 5, g.numHouses())
+}
+
+func TestLastResort(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "A1", Preferences: []string{"H1"}},
+			{Name: "A2", Preferences: []string{"H1"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "H1"},
+		},
+	)
+
+	g := newHouseGraph(&tables[0], &tables[1])
+
+	assert.Equal(t, 1, g.lastResort(0))
+	assert.Equal(t, 2, g.lastResort(1))
+}
@@ -0,0 +1,50 @@
+package pop
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/abhchand/libmatch/pkg/algo/internal/bipartite"
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// Run executes the popular matching algorithm of Abraham, Irving, Kavitha and
+// Mehlhorn (2007) for a set of given preference inputs. `TableA` holds the
+// applicants and `TableB` holds the houses, which have no preferences.
+//
+// Returns an error when no popular matching exists.
+//
+// See pop package documentation for more detail
+func Run(algoCtx core.AlgorithmContext) (core.MatchResult, error) {
+	g := newHouseGraph(algoCtx.TableA, algoCtx.TableB)
+
+	m, ok := popularMatching(g)
+	if !ok {
+		return core.MatchResult{}, errors.New("No popular matching exists")
+	}
+
+	return buildResult(g, m), nil
+}
+
+// buildResult constructs a Match Result from the popular matching. Applicants
+// matched with their last resort house are unmatched.
+func buildResult(g houseGraph, m bipartite.Matching) core.MatchResult {
+	res := core.MatchResult{}
+
+	res.Mapping = make(map[string]string)
+
+	for a, applicant := range g.applicants {
+		h := m.LeftMate[a]
+
+		if h == g.lastResort(a) {
+			res.Unmatched = append(res.Unmatched, applicant.Name())
+			continue
+		}
+
+		res.Mapping[applicant.Name()] = g.houses[h].Name()
+	}
+
+	sort.Strings(res.Unmatched)
+
+	return res
+}
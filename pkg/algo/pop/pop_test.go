@@ -0,0 +1,114 @@
+package pop
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A1", Preferences: []string{"H1", "H2", "H3"}},
+				{Name: "A2", Preferences: []string{"H1", "H3"}},
+				{Name: "A3", Preferences: []string{"H2", "H1"}},
+				{Name: "A4", Preferences: []string{"H2", "H4"}},
+			},
+			{
+				{Name: "H1"},
+				{Name: "H2"},
+				{Name: "H3"},
+				{Name: "H4"},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A1": "H3",
+				"A2": "H1",
+				"A4": "H2",
+			},
+			Unmatched: []string{"A3"},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("with ties", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{
+					Name:              "A1",
+					Preferences:       []string{"H1", "H2", "H3"},
+					RankedPreferences: [][]string{{"H1", "H2"}, {"H3"}},
+				},
+				{Name: "A2", Preferences: []string{"H1", "H3"}},
+				{Name: "A3", Preferences: []string{"H1", "H2"}},
+			},
+			{
+				{Name: "H1"},
+				{Name: "H2"},
+				{Name: "H3"},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A1": "H2",
+				"A2": "H3",
+				"A3": "H1",
+			},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("no popular matching", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A1", Preferences: []string{"H1", "H2", "H3"}},
+				{Name: "A2", Preferences: []string{"H1", "H2", "H3"}},
+				{Name: "A3", Preferences: []string{"H1", "H2", "H3"}},
+			},
+			{
+				{Name: "H1"},
+				{Name: "H2"},
+				{Name: "H3"},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+		}
+
+		_, err := Run(algoCtx)
+
+		assert.Equal(t, "No popular matching exists", err.Error())
+	})
+}
@@ -0,0 +1,75 @@
+package pop
+
+import (
+	"github.com/abhchand/libmatch/pkg/algo/internal/bipartite"
+)
+
+// popularMatching implements the algorithm of Abraham, Irving, Kavitha and
+// Mehlhorn (2007) for preference lists with ties.
+//
+// A maximum matching of the "first choice" graph is found and each node is
+// labelled even, odd or unreachable. Each even applicant may additionally be
+// matched with their most preferred even houses. Edges that can not belong to
+// any maximum matching of the first choice graph are removed, and the
+// matching is augmented in the resulting "reduced" graph.
+//
+// A popular matching exists if, and only if, this matches every applicant.
+// Applicants matched with their last resort house are unmatched.
+//
+// See pop package documentation for more detail
+func popularMatching(g houseGraph) (bipartite.Matching, bool) {
+	firstChoices := make([][]int, len(g.applicants))
+	for a := range g.groups {
+		firstChoices[a] = g.groups[a][0]
+	}
+
+	m := g.newMatching()
+	bipartite.Maximize(firstChoices, &m)
+
+	applicantParity, houseParity := bipartite.Label(firstChoices, m)
+
+	reduced := make([][]int, len(g.applicants))
+
+	for a := range g.applicants {
+		for _, h := range firstChoices[a] {
+			if bipartite.IsMaximumMatchingEdge(applicantParity[a], houseParity[h]) {
+				reduced[a] = append(reduced[a], h)
+			}
+		}
+
+		if applicantParity[a] == bipartite.Even {
+			reduced[a] = append(reduced[a], secondChoices(g, a, houseParity)...)
+		}
+	}
+
+	bipartite.Maximize(reduced, &m)
+
+	for a := range m.LeftMate {
+		if m.LeftMate[a] == -1 {
+			return m, false
+		}
+	}
+
+	return m, true
+}
+
+// secondChoices returns the most preferred houses of an applicant that are
+// even in the first choice graph. Since every last resort house is even, one
+// always exists.
+func secondChoices(g houseGraph, a int, houseParity []bipartite.Parity) []int {
+	for _, group := range g.groups[a] {
+		var houses []int
+
+		for _, h := range group {
+			if houseParity[h] == bipartite.Even {
+				houses = append(houses, h)
+			}
+		}
+
+		if len(houses) > 0 {
+			return houses
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package pop
+
+import (
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/algo/internal/bipartite"
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func tiedHouseGraph() houseGraph {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{
+				Name:              "A1",
+				Preferences:       []string{"H1", "H2", "H3"},
+				RankedPreferences: [][]string{{"H1", "H2"}, {"H3"}},
+			},
+			{Name: "A2", Preferences: []string{"H1", "H3"}},
+			{Name: "A3", Preferences: []string{"H1", "H2"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "H1"},
+			{Name: "H2"},
+			{Name: "H3"},
+		},
+	)
+
+	return newHouseGraph(&tables[0], &tables[1])
+}
+
+func TestPopularMatching(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		g := tiedHouseGraph()
+
+		m, ok := popularMatching(g)
+
+		assert.True(t, ok)
+		assert.Equal(t, []int{1, 2, 0}, m.LeftMate)
+	})
+
+	t.Run("no popular matching", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(
+			&[]core.MatchPreference{
+				{Name: "A1", Preferences: []string{"H1", "H2"}},
+				{Name: "A2", Preferences: []string{"H1", "H2"}},
+				{Name: "A3", Preferences: []string{"H1", "H2"}},
+			},
+			&[]core.MatchPreference{
+				{Name: "H1"},
+				{Name: "H2"},
+			},
+		)
+
+		_, ok := popularMatching(newHouseGraph(&tables[0], &tables[1]))
+
+		assert.False(t, ok)
+	})
+}
+
+func TestSecondChoices(t *testing.T) {
+	g := tiedHouseGraph()
+
+	// H1, H2, H3, followed by the last resort houses of A1, A2 and A3
+	houseParity := []bipartite.Parity{
+		bipartite.Odd,
+		bipartite.Unreachable,
+		bipartite.Even,
+		bipartite.Even,
+		bipartite.Even,
+		bipartite.Even,
+	}
+
+	assert.Equal(t, []int{2}, secondChoices(g, 0, houseParity))
+	assert.Equal(t, []int{2}, secondChoices(g, 1, houseParity))
+	assert.Equal(t, []int{g.lastResort(2)}, secondChoices(g, 2, houseParity))
+}
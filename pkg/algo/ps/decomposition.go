@@ -0,0 +1,255 @@
+package ps
+
+import (
+	"math/big"
+	"math/rand"
+	"sort"
+	"strings"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// lottery is a lottery over deterministic matchings, each drawn with an
+// exact probability. Matchings are keyed by a canonical description of their
+// pairs.
+type lottery map[string]*weightedAssignment
+
+// weightedAssignment is a deterministic assignment of items to members,
+// keyed by member name, along with the probability of drawing it.
+type weightedAssignment struct {
+	weight   *big.Rat
+	assigned map[string]string
+}
+
+// birkhoffDecomposition expresses the fractional assignment eaten by the
+// members as a lottery over deterministic assignments, using the
+// Birkhoff-von Neumann theorem.
+//
+// Each item is first split into one "seat" for each unit of capacity, so that
+// the assignment becomes a matrix of members and seats whose rows and columns
+// add up to at most 1. It is then padded into a square "doubly stochastic"
+// matrix, whose rows and columns all add up to exactly 1, with dummy entries
+// for the chance of each member going unmatched and each seat going empty.
+//
+// Every doubly stochastic matrix contains a perfect matching among its
+// positive entries. That matching is drawn with the probability of its
+// smallest entry, which is subtracted from every entry it covers. This repeats
+// until nothing is left, and at least one more entry drops to zero each time.
+func birkhoffDecomposition(ptA, ptI *core.PreferenceTable, eaten shares) lottery {
+	members := ptA.SortedNames()
+	seats, fractions := seatFractions(ptI, members, eaten)
+
+	matrix := paddedMatrix(members, seats, fractions)
+	size := len(matrix)
+
+	decomposition := make(lottery)
+
+	for size > 0 {
+		columns := perfectMatching(matrix)
+
+		if columns == nil {
+			break
+		}
+
+		weight := new(big.Rat).Set(matrix[0][columns[0]])
+		for row := 1; row < size; row++ {
+			if matrix[row][columns[row]].Cmp(weight) < 0 {
+				weight.Set(matrix[row][columns[row]])
+			}
+		}
+
+		assigned := make(map[string]string)
+
+		for row := 0; row < size; row++ {
+			matrix[row][columns[row]].Sub(matrix[row][columns[row]], weight)
+
+			if row < len(members) && columns[row] < len(seats) {
+				assigned[members[row]] = seats[columns[row]]
+			}
+		}
+
+		key := assignmentKey(assigned)
+
+		if decomposition[key] == nil {
+			decomposition[key] = &weightedAssignment{weight: new(big.Rat), assigned: assigned}
+		}
+
+		decomposition[key].weight.Add(decomposition[key].weight, weight)
+	}
+
+	return decomposition
+}
+
+// seatFractions splits each item into one seat for each unit of its
+// capacity, and divides the fraction of the item eaten by each member among
+// its seats. Seats are filled one at a time, with members in alphabetical
+// order.
+//
+// Returns the name of the item each seat belongs to, and the fraction of each
+// seat eaten by each member, keyed by member name.
+func seatFractions(ptI *core.PreferenceTable, members []string, eaten shares) ([]string, []map[string]*big.Rat) {
+	var seats []string
+	var fractions []map[string]*big.Rat
+
+	for _, item := range ptI.SortedNames() {
+		first := len(seats)
+
+		for c := 0; c < (*ptI)[item].Capacity(); c++ {
+			seats = append(seats, item)
+			fractions = append(fractions, make(map[string]*big.Rat))
+		}
+
+		seat, room := first, big.NewRat(1, 1)
+
+		for _, member := range members {
+			share := eaten[member][item]
+			if share == nil {
+				continue
+			}
+
+			left := new(big.Rat).Set(share)
+
+			for left.Sign() > 0 {
+				if room.Sign() == 0 {
+					seat, room = seat+1, big.NewRat(1, 1)
+				}
+
+				portion := new(big.Rat).Set(left)
+				if room.Cmp(portion) < 0 {
+					portion.Set(room)
+				}
+
+				if fractions[seat][member] == nil {
+					fractions[seat][member] = new(big.Rat)
+				}
+
+				fractions[seat][member].Add(fractions[seat][member], portion)
+				left.Sub(left, portion)
+				room.Sub(room, portion)
+			}
+		}
+	}
+
+	return seats, fractions
+}
+
+// paddedMatrix builds a square doubly stochastic matrix from the fraction of
+// each seat eaten by each member. With `n` members and `m` seats, it has the
+// layout
+//
+//	[ P  R  ]
+//	[ C  Pᵀ ]
+//
+// where `P` is the `n` by `m` matrix of fractions, `R` is a diagonal matrix of
+// the chance of each member going unmatched, and `C` a diagonal matrix of the
+// chance of each seat going empty.
+func paddedMatrix(members, seats []string, fractions []map[string]*big.Rat) [][]*big.Rat {
+	n, m := len(members), len(seats)
+	size := n + m
+
+	matrix := make([][]*big.Rat, size)
+	for row := range matrix {
+		matrix[row] = make([]*big.Rat, size)
+
+		for col := range matrix[row] {
+			matrix[row][col] = new(big.Rat)
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		matrix[i][m+i].SetInt64(1)
+	}
+
+	for j := 0; j < m; j++ {
+		matrix[n+j][j].SetInt64(1)
+
+		for i, member := range members {
+			if fraction := fractions[j][member]; fraction != nil {
+				matrix[i][j].Set(fraction)
+				matrix[n+j][m+i].Set(fraction)
+				matrix[i][m+i].Sub(matrix[i][m+i], fraction)
+				matrix[n+j][j].Sub(matrix[n+j][j], fraction)
+			}
+		}
+	}
+
+	return matrix
+}
+
+// perfectMatching finds a perfect matching among the positive entries of a
+// square matrix, using augmenting paths. The result maps each row to its
+// column, or is nil when no positive entries are left.
+func perfectMatching(matrix [][]*big.Rat) []int {
+	size := len(matrix)
+
+	columns := make([]int, size)
+	rows := make([]int, size)
+
+	for i := range rows {
+		columns[i], rows[i] = -1, -1
+	}
+
+	for row := 0; row < size; row++ {
+		visited := make([]bool, size)
+
+		if !augment(matrix, row, columns, rows, visited) {
+			return nil
+		}
+	}
+
+	return columns
+}
+
+// augment searches for an augmenting path starting at the specified row, and
+// flips the matching along it when found.
+func augment(matrix [][]*big.Rat, row int, columns, rows []int, visited []bool) bool {
+	for col := range matrix[row] {
+		if matrix[row][col].Sign() <= 0 || visited[col] {
+			continue
+		}
+
+		visited[col] = true
+
+		if rows[col] == -1 || augment(matrix, rows[col], columns, rows, visited) {
+			columns[row], rows[col] = col, row
+			return true
+		}
+	}
+
+	return false
+}
+
+// assignmentKey describes an assignment canonically, as its pairs in order of
+// member name.
+func assignmentKey(assigned map[string]string) string {
+	pairs := make([]string, 0, len(assigned))
+
+	for member, item := range assigned {
+		pairs = append(pairs, member+"="+item)
+	}
+
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ",")
+}
+
+// sample draws one matching from a lottery with a random source derived from
+// a seed, so that the same seed always draws the same matching.
+func sample(decomposition []core.WeightedMatching, seed int64) map[string]string {
+	if len(decomposition) == 0 {
+		return map[string]string{}
+	}
+
+	draw := rand.New(rand.NewSource(seed)).Float64()
+
+	for i := range decomposition {
+		draw -= decomposition[i].Probability
+
+		if draw < 0 {
+			return decomposition[i].Mapping
+		}
+	}
+
+	// Rounding may leave a tiny remainder past the last matching
+	return decomposition[len(decomposition)-1].Mapping
+}
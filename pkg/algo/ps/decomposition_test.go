@@ -0,0 +1,199 @@
+package ps
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBirkhoffDecomposition(t *testing.T) {
+	t.Run("decomposes into a lottery", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(
+			&[]core.MatchPreference{
+				{Name: "A", Preferences: []string{"X", "Y", "Z"}},
+				{Name: "B", Preferences: []string{"X", "Z", "Y"}},
+				{Name: "C", Preferences: []string{"Y", "X", "Z"}},
+			},
+			&[]core.MatchPreference{
+				{Name: "X"},
+				{Name: "Y"},
+				{Name: "Z"},
+			},
+		)
+
+		ptA, ptI := tables[0], tables[1]
+
+		wanted := lottery{
+			"A=X,B=Z,C=Y": {
+				weight:   big.NewRat(1, 2),
+				assigned: map[string]string{"A": "X", "B": "Z", "C": "Y"},
+			},
+			"A=Y,B=X,C=Z": {
+				weight:   big.NewRat(1, 4),
+				assigned: map[string]string{"A": "Y", "B": "X", "C": "Z"},
+			},
+			"A=Z,B=X,C=Y": {
+				weight:   big.NewRat(1, 4),
+				assigned: map[string]string{"A": "Z", "B": "X", "C": "Y"},
+			},
+		}
+
+		assert.Equal(t, wanted, birkhoffDecomposition(&ptA, &ptI, simultaneousEating(&ptA, &ptI)))
+	})
+
+	t.Run("includes unmatched members and shared items", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(
+			&[]core.MatchPreference{
+				{Name: "A", Preferences: []string{"X"}},
+				{Name: "B", Preferences: []string{"X"}},
+				{Name: "C", Preferences: []string{"X"}},
+			},
+			&[]core.MatchPreference{
+				{Name: "X", Capacity: 2},
+			},
+		)
+
+		ptA, ptI := tables[0], tables[1]
+
+		result := birkhoffDecomposition(&ptA, &ptI, simultaneousEating(&ptA, &ptI))
+
+		marginals := map[string]*big.Rat{"A": new(big.Rat), "B": new(big.Rat), "C": new(big.Rat)}
+		total := new(big.Rat)
+
+		for _, matching := range result {
+			total.Add(total, matching.weight)
+
+			// Every matching fills both seats of "X"
+			assert.Equal(t, 2, len(matching.assigned))
+
+			for member := range matching.assigned {
+				marginals[member].Add(marginals[member], matching.weight)
+			}
+		}
+
+		assert.Equal(t, big.NewRat(1, 1), total)
+
+		for _, name := range []string{"A", "B", "C"} {
+			assert.Equal(t, big.NewRat(2, 3), marginals[name])
+		}
+	})
+
+	t.Run("without any preferences", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(
+			&[]core.MatchPreference{
+				{Name: "A", Preferences: []string{}},
+			},
+			&[]core.MatchPreference{
+				{Name: "X"},
+			},
+		)
+
+		ptA, ptI := tables[0], tables[1]
+
+		wanted := lottery{
+			"": {weight: big.NewRat(1, 1), assigned: map[string]string{}},
+		}
+
+		assert.Equal(t, wanted, birkhoffDecomposition(&ptA, &ptI, simultaneousEating(&ptA, &ptI)))
+	})
+}
+
+func TestSeatFractions(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "A", Preferences: []string{"X"}},
+			{Name: "B", Preferences: []string{"X"}},
+			{Name: "C", Preferences: []string{"X"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "X", Capacity: 2},
+		},
+	)
+
+	eaten := shares{
+		"A": {"X": big.NewRat(2, 3)},
+		"B": {"X": big.NewRat(2, 3)},
+		"C": {"X": big.NewRat(2, 3)},
+	}
+
+	seats, fractions := seatFractions(&tables[1], []string{"A", "B", "C"}, eaten)
+
+	assert.Equal(t, []string{"X", "X"}, seats)
+	assert.Equal(t, []map[string]*big.Rat{
+		{"A": big.NewRat(2, 3), "B": big.NewRat(1, 3)},
+		{"B": big.NewRat(1, 3), "C": big.NewRat(2, 3)},
+	}, fractions)
+}
+
+func TestPaddedMatrix(t *testing.T) {
+	fractions := []map[string]*big.Rat{
+		{"A": big.NewRat(1, 2)},
+	}
+
+	matrix := paddedMatrix([]string{"A", "B"}, []string{"X"}, fractions)
+
+	// Rows are A, B, X and columns are X, A, B
+	wanted := [][]*big.Rat{
+		{big.NewRat(1, 2), big.NewRat(1, 2), new(big.Rat)},
+		{new(big.Rat), new(big.Rat), big.NewRat(1, 1)},
+		{big.NewRat(1, 2), big.NewRat(1, 2), new(big.Rat)},
+	}
+
+	for row := range wanted {
+		for col := range wanted[row] {
+			assert.Zero(t, wanted[row][col].Cmp(matrix[row][col]), "entry (%v, %v)", row, col)
+		}
+	}
+}
+
+func TestPerfectMatching(t *testing.T) {
+	t.Run("finds a perfect matching", func(t *testing.T) {
+		matrix := [][]*big.Rat{
+			{big.NewRat(1, 2), big.NewRat(1, 2)},
+			{big.NewRat(1, 1), new(big.Rat)},
+		}
+
+		assert.Equal(t, []int{1, 0}, perfectMatching(matrix))
+	})
+
+	t.Run("when no perfect matching exists", func(t *testing.T) {
+		matrix := [][]*big.Rat{
+			{big.NewRat(1, 1), new(big.Rat)},
+			{big.NewRat(1, 1), new(big.Rat)},
+		}
+
+		assert.Nil(t, perfectMatching(matrix))
+	})
+}
+
+func TestAssignmentKey(t *testing.T) {
+	assert.Equal(t, "A=X,B=Y,C=X", assignmentKey(map[string]string{"C": "X", "A": "X", "B": "Y"}))
+	assert.Equal(t, "", assignmentKey(map[string]string{}))
+}
+
+func TestSample(t *testing.T) {
+	decomposition := []core.WeightedMatching{
+		{Probability: 0.5, Mapping: map[string]string{"A": "X", "B": "Y"}},
+		{Probability: 0.5, Mapping: map[string]string{"A": "Y", "B": "X"}},
+	}
+
+	t.Run("same seed draws the same matching", func(t *testing.T) {
+		assert.Equal(t, sample(decomposition, 7), sample(decomposition, 7))
+	})
+
+	t.Run("draws every matching", func(t *testing.T) {
+		drawn := make(map[string]bool)
+
+		for seed := int64(0); seed < 20; seed++ {
+			drawn[sample(decomposition, seed)["A"]] = true
+		}
+
+		assert.Equal(t, map[string]bool{"X": true, "Y": true}, drawn)
+	})
+
+	t.Run("with an empty lottery", func(t *testing.T) {
+		assert.Equal(t, map[string]string{}, sample([]core.WeightedMatching{}, 7))
+	})
+}
@@ -0,0 +1,88 @@
+/*
+Package ps implements the "Probabilistic Serial" (PS) mechanism, also known as
+the "simultaneous eating" algorithm.
+
+It allocates items to members in a one-sided problem, where only members rank
+the items they find acceptable and each item has a capacity (defaults to 1).
+Items have no preferences of their own.
+
+Instead of a single matching, the result is a fractional assignment: the
+probability of each member receiving each item. It is ordinally efficient (no
+other fractional assignment is at least as good for every member and better
+for some) and envy-free (no member prefers the probabilities given to another
+member over their own).
+
+ALGORITHM
+
+See: https://en.wikipedia.org/wiki/Probabilistic-serial_procedure
+
+Every item has a supply equal to its capacity. All members "eat" their most
+preferred item that has supply left, at the same speed, from time 0 until time
+1. Whenever an item runs out, the members eating it move on to their next most
+preferred item that has supply left. Members who find no such item stop
+eating.
+
+The fraction of an item each member has eaten by time 1 is the probability of
+them receiving that item.
+
+LOTTERY
+
+See: https://en.wikipedia.org/wiki/Doubly_stochastic_matrix#Birkhoff_polytope_and_Birkhoff%E2%80%93von_Neumann_theorem
+
+The fractional assignment is then decomposed into a lottery over deterministic
+matchings with the Birkhoff-von Neumann theorem. Drawing a matching from the
+lottery gives every member each item with exactly the probability above.
+
+Each item is split into one "seat" for each unit of capacity, and the
+assignment is padded into a square matrix whose rows and columns all add up to
+1. Such a matrix always contains a perfect matching among its positive
+entries. That matching is added to the lottery with the probability of its
+smallest entry, which is subtracted from every entry it covers, until nothing
+is left.
+
+A single matching may optionally be drawn from the lottery with a random source
+derived from a seed, so that the same seed always draws the same matching.
+
+DETERMINISM
+
+Eating is exact and does not depend on any ordering. The decomposition splits
+items into seats and searches for matchings with members and items in
+alphabetical order, so that the same inputs always produce the same lottery.
+
+ALGORITHM EXAMPLE
+
+Take the following preference tables
+
+	// members
+	A => [X, Y, Z]
+	B => [X, Z, Y]
+	C => [Y, X, Z]
+
+	// items (capacity)
+	X (1)
+	Y (1)
+	Z (1)
+
+"A" and "B" start eating "X", while "C" starts eating "Y".
+
+At time 1/2, "X" runs out. "A" moves on to "Y", and "B" moves on to "Z".
+
+At time 3/4, "Y" runs out, with 1/4 eaten by "A" and 3/4 eaten by "C". "A"
+and "C" both move on to "Z".
+
+At time 1, "Z" runs out, with 1/2 eaten by "B" and 1/4 each eaten by "A" and
+"C".
+
+This gives us the following probabilities
+
+	A => X (1/2), Y (1/4), Z (1/4)
+	B => X (1/2), Z (1/2)
+	C => Y (3/4), Z (1/4)
+
+Which decomposes into the following lottery
+
+	1/2 => A: X, B: Z, C: Y
+	1/4 => A: Y, B: X, C: Z
+	1/4 => A: Z, B: X, C: Y
+*/
+package ps
@@ -0,0 +1,91 @@
+package ps
+
+import (
+	"math/big"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// shares tracks the fraction of each item that each member has eaten, keyed
+// by member name and then by item name. Fractions are exact.
+type shares map[string]map[string]*big.Rat
+
+// simultaneousEating lets every member "eat" their most preferred item that
+// has supply left, all at the same speed, from time 0 to time 1. Each item has
+// a supply equal to its capacity. Whenever an item runs out, the members
+// eating it move on to their next preferred item with supply left. Members who
+// find no such item stop eating.
+//
+// The preference tables are not modified.
+func simultaneousEating(ptA, ptI *core.PreferenceTable) shares {
+	eaten := make(shares, len(*ptA))
+	for name := range *ptA {
+		eaten[name] = make(map[string]*big.Rat)
+	}
+
+	supply := make(map[string]*big.Rat, len(*ptI))
+	for name, item := range *ptI {
+		supply[name] = big.NewRat(int64(item.Capacity()), 1)
+	}
+
+	members := ptA.SortedNames()
+	clock := new(big.Rat)
+	end := big.NewRat(1, 1)
+
+	for clock.Cmp(end) < 0 {
+		eating := make(map[string]*core.Member, len(members))
+		eaters := make(map[string]int64)
+
+		for _, name := range members {
+			if item := favouriteItem((*ptA)[name], supply); item != nil {
+				eating[name] = item
+				eaters[item.Name()]++
+			}
+		}
+
+		if len(eating) == 0 {
+			break
+		}
+
+		// Eat until the next item runs out, or until time runs out
+		step := new(big.Rat).Sub(end, clock)
+
+		for name, count := range eaters {
+			runsOut := new(big.Rat).Quo(supply[name], big.NewRat(count, 1))
+
+			if runsOut.Cmp(step) < 0 {
+				step = runsOut
+			}
+		}
+
+		for name, item := range eating {
+			if eaten[name][item.Name()] == nil {
+				eaten[name][item.Name()] = new(big.Rat)
+			}
+
+			eaten[name][item.Name()].Add(eaten[name][item.Name()], step)
+		}
+
+		for name, count := range eaters {
+			supply[name].Sub(supply[name], new(big.Rat).Mul(step, big.NewRat(count, 1)))
+		}
+
+		clock.Add(clock, step)
+	}
+
+	return eaten
+}
+
+// favouriteItem returns the most preferred item on a member's preference list
+// that has supply left, if any.
+func favouriteItem(member *core.Member, supply map[string]*big.Rat) *core.Member {
+	items := member.PreferenceList().Members()
+
+	for i := range items {
+		if supply[items[i].Name()].Sign() > 0 {
+			return items[i]
+		}
+	}
+
+	return nil
+}
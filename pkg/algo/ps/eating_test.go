@@ -0,0 +1,109 @@
+package ps
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimultaneousEating(t *testing.T) {
+	t.Run("moves on when an item runs out", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(
+			&[]core.MatchPreference{
+				{Name: "A", Preferences: []string{"X", "Y", "Z"}},
+				{Name: "B", Preferences: []string{"X", "Z", "Y"}},
+				{Name: "C", Preferences: []string{"Y", "X", "Z"}},
+			},
+			&[]core.MatchPreference{
+				{Name: "X"},
+				{Name: "Y"},
+				{Name: "Z"},
+			},
+		)
+
+		ptA, ptI := tables[0], tables[1]
+
+		wanted := shares{
+			"A": {"X": big.NewRat(1, 2), "Y": big.NewRat(1, 4), "Z": big.NewRat(1, 4)},
+			"B": {"X": big.NewRat(1, 2), "Z": big.NewRat(1, 2)},
+			"C": {"Y": big.NewRat(3, 4), "Z": big.NewRat(1, 4)},
+		}
+
+		assert.Equal(t, wanted, simultaneousEating(&ptA, &ptI))
+
+		// Preference lists are left unchanged
+		assert.Equal(t, []*core.Member{ptI["X"], ptI["Y"], ptI["Z"]}, ptA["A"].PreferenceList().Members())
+	})
+
+	t.Run("supply is the item capacity", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(
+			&[]core.MatchPreference{
+				{Name: "A", Preferences: []string{"X"}},
+				{Name: "B", Preferences: []string{"X"}},
+				{Name: "C", Preferences: []string{"X"}},
+			},
+			&[]core.MatchPreference{
+				{Name: "X", Capacity: 2},
+			},
+		)
+
+		ptA, ptI := tables[0], tables[1]
+
+		wanted := shares{
+			"A": {"X": big.NewRat(2, 3)},
+			"B": {"X": big.NewRat(2, 3)},
+			"C": {"X": big.NewRat(2, 3)},
+		}
+
+		assert.Equal(t, wanted, simultaneousEating(&ptA, &ptI))
+	})
+
+	t.Run("members stop eating when nothing is left", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(
+			&[]core.MatchPreference{
+				{Name: "A", Preferences: []string{"X", "Y"}},
+				{Name: "B", Preferences: []string{"X"}},
+				{Name: "C", Preferences: []string{}},
+			},
+			&[]core.MatchPreference{
+				{Name: "X"},
+				{Name: "Y"},
+			},
+		)
+
+		ptA, ptI := tables[0], tables[1]
+
+		wanted := shares{
+			"A": {"X": big.NewRat(1, 2), "Y": big.NewRat(1, 2)},
+			"B": {"X": big.NewRat(1, 2)},
+			"C": {},
+		}
+
+		assert.Equal(t, wanted, simultaneousEating(&ptA, &ptI))
+	})
+}
+
+func TestFavouriteItem(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "A", Preferences: []string{"X", "Y"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "X"},
+			{Name: "Y"},
+		},
+	)
+
+	ptA, ptI := tables[0], tables[1]
+
+	supply := map[string]*big.Rat{"X": big.NewRat(1, 3), "Y": big.NewRat(1, 1)}
+	assert.Equal(t, ptI["X"], favouriteItem(ptA["A"], supply))
+
+	supply["X"] = new(big.Rat)
+	assert.Equal(t, ptI["Y"], favouriteItem(ptA["A"], supply))
+
+	supply["Y"] = new(big.Rat)
+	assert.Nil(t, favouriteItem(ptA["A"], supply))
+}
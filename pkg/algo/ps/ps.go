@@ -0,0 +1,100 @@
+package ps
+
+import (
+	"sort"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// Run executes the Probabilistic Serial algorithm (PS) for a set of given
+// preference inputs. `TableA` holds the members, who "eat" the items held by
+// `TableB`.
+//
+// The result holds the probability of each member receiving each item, and a
+// lottery over deterministic matchings that gives every member each item with
+// exactly that probability. When `Sample` is set, a single matching is also
+// drawn from the lottery using `Seed`.
+//
+// See ps package documentation for more detail
+func Run(algoCtx core.AlgorithmContext) (core.MatchResult, error) {
+	ptA := algoCtx.TableA
+	ptI := algoCtx.TableB
+
+	eaten := simultaneousEating(ptA, ptI)
+	decomposition := birkhoffDecomposition(ptA, ptI, eaten)
+
+	res := buildResult(eaten, decomposition)
+
+	if algoCtx.Sample {
+		drawMatching(&res, ptA, ptI, sample(res.Decomposition, algoCtx.Seed))
+	}
+
+	return res, nil
+}
+
+// buildResult constructs a Match Result from the fraction of each item eaten
+// by each member, and its decomposition into a lottery. Matchings are listed
+// from most to least likely.
+func buildResult(eaten shares, decomposition lottery) core.MatchResult {
+	res := core.MatchResult{}
+
+	res.Mapping = make(map[string]string)
+	res.Probabilities = make(map[string]map[string]float64, len(eaten))
+
+	for name, items := range eaten {
+		res.Probabilities[name] = make(map[string]float64, len(items))
+
+		for item, share := range items {
+			res.Probabilities[name][item], _ = share.Float64()
+		}
+	}
+
+	keys := make([]string, 0, len(decomposition))
+	for key := range decomposition {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if c := decomposition[keys[i]].weight.Cmp(decomposition[keys[j]].weight); c != 0 {
+			return c > 0
+		}
+
+		return keys[i] < keys[j]
+	})
+
+	res.Decomposition = make([]core.WeightedMatching, len(keys))
+
+	for i, key := range keys {
+		probability, _ := decomposition[key].weight.Float64()
+
+		res.Decomposition[i] = core.WeightedMatching{
+			Probability: probability,
+			Mapping:     decomposition[key].assigned,
+		}
+	}
+
+	return res
+}
+
+// drawMatching records a matching drawn from the lottery in a Match Result.
+// Members are assigned to each item in alphabetical order.
+func drawMatching(res *core.MatchResult, ptA, ptI *core.PreferenceTable, drawn map[string]string) {
+	res.Mapping = make(map[string]string, len(drawn))
+	res.Assignments = make(map[string][]string, len(*ptI))
+
+	for name := range *ptI {
+		res.Assignments[name] = []string{}
+	}
+
+	for _, name := range ptA.SortedNames() {
+		item, ok := drawn[name]
+
+		if !ok {
+			res.Unmatched = append(res.Unmatched, name)
+			continue
+		}
+
+		res.Mapping[name] = item
+		res.Assignments[item] = append(res.Assignments[item], name)
+	}
+}
@@ -0,0 +1,170 @@
+package ps
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	prefsSet := []*[]core.MatchPreference{
+		{
+			{Name: "A", Preferences: []string{"X", "Y", "Z"}},
+			{Name: "B", Preferences: []string{"X", "Z", "Y"}},
+			{Name: "C", Preferences: []string{"Y", "X", "Z"}},
+		},
+		{
+			{Name: "X"},
+			{Name: "Y"},
+			{Name: "Z"},
+		},
+	}
+
+	probabilities := map[string]map[string]float64{
+		"A": {"X": 0.5, "Y": 0.25, "Z": 0.25},
+		"B": {"X": 0.5, "Z": 0.5},
+		"C": {"Y": 0.75, "Z": 0.25},
+	}
+
+	decomposition := []core.WeightedMatching{
+		{Probability: 0.5, Mapping: map[string]string{"A": "X", "B": "Z", "C": "Y"}},
+		{Probability: 0.25, Mapping: map[string]string{"A": "Y", "B": "X", "C": "Z"}},
+		{Probability: 0.25, Mapping: map[string]string{"A": "Z", "B": "X", "C": "Y"}},
+	}
+
+	t.Run("without sampling", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+		}
+
+		wanted := core.MatchResult{
+			Mapping:       map[string]string{},
+			Probabilities: probabilities,
+			Decomposition: decomposition,
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("with sampling", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+			Sample: true,
+			Seed:   42,
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "X",
+				"B": "Z",
+				"C": "Y",
+			},
+			Assignments: map[string][]string{
+				"X": {"A"},
+				"Y": {"C"},
+				"Z": {"B"},
+			},
+			Probabilities: probabilities,
+			Decomposition: decomposition,
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("with unmatched members", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(
+			&[]core.MatchPreference{
+				{Name: "A", Preferences: []string{"X"}},
+				{Name: "B", Preferences: []string{"X"}},
+			},
+			&[]core.MatchPreference{
+				{Name: "X"},
+			},
+		)
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+			Sample: true,
+			Seed:   42,
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]map[string]float64{
+			"A": {"X": 0.5},
+			"B": {"X": 0.5},
+		}, result.Probabilities)
+		assert.Equal(t, 2, len(result.Decomposition))
+		assert.Equal(t, 1, len(result.Mapping))
+		assert.Equal(t, 1, len(result.Unmatched))
+		assert.Equal(t, 1, len(result.Assignments["X"]))
+	})
+}
+
+func TestBuildResult(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "A", Preferences: []string{"X"}},
+			{Name: "B", Preferences: []string{"X"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "X"},
+		},
+	)
+
+	ptA, ptI := tables[0], tables[1]
+
+	eaten := simultaneousEating(&ptA, &ptI)
+
+	wanted := core.MatchResult{
+		Mapping: map[string]string{},
+		Probabilities: map[string]map[string]float64{
+			"A": {"X": 0.5},
+			"B": {"X": 0.5},
+		},
+		Decomposition: []core.WeightedMatching{
+			{Probability: 0.5, Mapping: map[string]string{"A": "X"}},
+			{Probability: 0.5, Mapping: map[string]string{"B": "X"}},
+		},
+	}
+
+	assert.Equal(t, wanted, buildResult(eaten, birkhoffDecomposition(&ptA, &ptI, eaten)))
+}
+
+func TestDrawMatching(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "A", Preferences: []string{"X", "Y"}},
+			{Name: "B", Preferences: []string{"X"}},
+			{Name: "C", Preferences: []string{"X"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "X", Capacity: 2},
+			{Name: "Y"},
+		},
+	)
+
+	res := core.MatchResult{}
+
+	drawMatching(&res, &tables[0], &tables[1], map[string]string{"C": "X", "A": "X"})
+
+	assert.Equal(t, map[string]string{"A": "X", "C": "X"}, res.Mapping)
+	assert.Equal(t, map[string][]string{"X": {"A", "C"}, "Y": {}}, res.Assignments)
+	assert.Equal(t, []string{"B"}, res.Unmatched)
+}
@@ -0,0 +1,79 @@
+/*
+Package rmm implements a solution to the "Rank-Maximal Matching" problem (RMM)
+for one-sided house allocation.
+
+Only applicants have preferences, ranking the houses they find acceptable.
+Houses have no preferences and an optional capacity (defaults to 1).
+Applicants may rank houses equally (ties).
+
+The "signature" of a matching counts the applicants matched with their first
+choice, their second choice, and so on. A matching is "rank-maximal" if its
+signature is the largest when compared in order: it matches as many applicants
+as possible with their first choice, then, subject to that, as many as
+possible with their second choice, and so on. A rank-maximal matching always
+exists, though it may leave applicants unmatched that another matching would
+have matched.
+
+It implements the algorithm of Irving, Kavitha, Mehlhorn, Michail and Paluch
+(2006) "Rank-Maximal Matchings".
+
+ALGORITHM
+
+See: https://en.wikipedia.org/wiki/Rank-maximal_allocation
+
+A house with a capacity greater than 1 is treated as that many separate
+houses.
+
+The algorithm runs in phases, one for each rank. In phase "i" every edge
+between an applicant and a house they rank i-th is added to the graph, and the
+current matching is augmented into a maximum matching of the graph. Augmenting
+never unmatches anyone, so the number of applicants matched at earlier ranks
+can not decrease.
+
+Before the next phase, every applicant and house is labelled "even", "odd" or
+"unreachable", by the length of the alternating paths that reach it from an
+unmatched applicant or house. Odd and unreachable nodes are matched in every
+maximum matching of the graph. To keep it that way -
+
+1. Edges between two odd nodes, or between an odd and an unreachable node,
+are removed, since they belong to no maximum matching
+
+2. No edges of a later rank are added to odd or unreachable nodes
+
+DETERMINISM
+
+Applicants and houses are considered in alphabetical order, and each
+applicant's houses in order of preference. The result is therefore always
+deterministic, though it may be one of many rank-maximal matchings.
+
+ALGORITHM EXAMPLE
+
+Take the following preference table
+
+	A1 => [H1, H2]
+	A2 => [H3, H1]
+	A3 => [H3]
+
+In the first phase, only first choices are added
+
+	A1 - H1
+	A2 - H3
+	A3 - H3
+
+"A1" is matched with "H1" and "A2" with "H3", which leaves "A3" unmatched and
+"H2" empty. "A1" and "H1" can not be reached from either, so they are
+unreachable and "A1" keeps "H1".
+
+In the second phase, the second choices "A1 - H2" and "A2 - H1" would be
+added, but "A1" and "H1" are unreachable, so neither is. This gives us the
+following assignments, with a signature of (2, 0)
+
+	A1 => H1
+	A2 => H3
+	A3 => (unmatched)
+
+Matching "A1" with "H2", "A2" with "H1" and "A3" with "H3" would match every
+applicant, but with a signature of (1, 2). It gives fewer applicants their
+first choice, and so is not rank-maximal.
+*/
+package rmm
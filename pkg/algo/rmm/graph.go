@@ -0,0 +1,76 @@
+package rmm
+
+import (
+	"github.com/abhchand/libmatch/pkg/algo/internal/bipartite"
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// houseGraph models the preferences of applicants over houses as a bipartite
+// graph, where applicants and houses are identified by their index.
+//
+// A house with a capacity greater than 1 is split into as many "slots", each
+// of which may be matched with a single applicant. Slots are identified by
+// their index, and ordered by house name.
+type houseGraph struct {
+	applicants []*core.Member
+	houses     []*core.Member
+
+	// slotHouse is the index of the house that each slot belongs to
+	slotHouse []int
+
+	// rankedSlots lists the slots each applicant ranks, grouped by rank in
+	// order of preference.
+	rankedSlots [][][]int
+}
+
+// newHouseGraph builds the house graph of a table of applicants and a table of
+// houses.
+func newHouseGraph(ptA, ptH *core.PreferenceTable) houseGraph {
+	g := houseGraph{
+		applicants: ptA.SortedMembers(),
+		houses:     ptH.SortedMembers(),
+	}
+
+	slots := make(map[string][]int, len(g.houses))
+
+	for h := range g.houses {
+		for c := 0; c < g.houses[h].Capacity(); c++ {
+			slots[g.houses[h].Name()] = append(slots[g.houses[h].Name()], len(g.slotHouse))
+			g.slotHouse = append(g.slotHouse, h)
+		}
+	}
+
+	g.rankedSlots = make([][][]int, len(g.applicants))
+
+	for a := range g.applicants {
+		groups := g.applicants[a].PreferenceList().Groups()
+		g.rankedSlots[a] = make([][]int, len(groups))
+
+		for i := range groups {
+			for j := range groups[i] {
+				g.rankedSlots[a][i] = append(g.rankedSlots[a][i], slots[groups[i][j].Name()]...)
+			}
+		}
+	}
+
+	return g
+}
+
+// numRanks returns the number of ranks of the longest preference list.
+func (g houseGraph) numRanks() int {
+	ranks := 0
+
+	for a := range g.rankedSlots {
+		if len(g.rankedSlots[a]) > ranks {
+			ranks = len(g.rankedSlots[a])
+		}
+	}
+
+	return ranks
+}
+
+// newMatching returns an empty matching of the house graph, where applicants
+// are the left nodes and slots are the right nodes.
+func (g houseGraph) newMatching() bipartite.Matching {
+	return bipartite.NewMatching(len(g.applicants), len(g.slotHouse))
+}
@@ -0,0 +1,50 @@
+package rmm
+
+import (
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHouseGraph(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "A2", Preferences: []string{"H2"}},
+			{
+				Name:              "A1",
+				Preferences:       []string{"H2", "H1", "H3"},
+				RankedPreferences: [][]string{{"H2", "H1"}, {"H3"}},
+			},
+		},
+		&[]core.MatchPreference{
+			{Name: "H3"},
+			{Name: "H1", Capacity: 2},
+			{Name: "H2"},
+		},
+	)
+
+	ptA, ptH := tables[0], tables[1]
+
+	g := newHouseGraph(&ptA, &ptH)
+
+	assert.Equal(t, []*core.Member{ptA["A1"], ptA["A2"]}, g.applicants)
+	assert.Equal(t, []*core.Member{ptH["H1"], ptH["H2"], ptH["H3"]}, g.houses)
+	assert.Equal(t, []int{0, 0, 1, 2}, g.slotHouse)
+	assert.Equal(t, [][][]int{
+		{{2, 0, 1}, {3}},
+		{{2}},
+	}, g.rankedSlots)
+}
+
+func TestNumRanks(t *testing.T) {
+	g := houseGraph{
+		rankedSlots: [][][]int{
+			{{0}},
+			{{1}, {0}, {2}},
+			{},
+		},
+	}
+
+	assert.Equal(t, 3, g.numRanks())
+}
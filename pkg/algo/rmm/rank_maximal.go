@@ -0,0 +1,61 @@
+package rmm
+
+import (
+	"github.com/abhchand/libmatch/pkg/algo/internal/bipartite"
+)
+
+// rankMaximalMatching implements the algorithm of Irving, Kavitha, Mehlhorn,
+// Michail and Paluch (2006).
+//
+// Edges are added one rank at a time, starting with each applicant's first
+// choices, and the matching is augmented into a maximum matching after each
+// rank. Before adding the next rank, each node is labelled even, odd or
+// unreachable. Odd and unreachable nodes are matched in every maximum
+// matching, so they keep their current rank: edges between two such nodes are
+// removed, and no edges of a later rank are added to them.
+//
+// See rmm package documentation for more detail
+func rankMaximalMatching(g houseGraph) bipartite.Matching {
+	edges := make([][]int, len(g.applicants))
+	applicantClosed := make([]bool, len(g.applicants))
+	slotClosed := make([]bool, len(g.slotHouse))
+
+	m := g.newMatching()
+
+	for r := 0; r < g.numRanks(); r++ {
+		for a := range g.applicants {
+			if applicantClosed[a] || r >= len(g.rankedSlots[a]) {
+				continue
+			}
+
+			for _, s := range g.rankedSlots[a][r] {
+				if !slotClosed[s] {
+					edges[a] = append(edges[a], s)
+				}
+			}
+		}
+
+		bipartite.Maximize(edges, &m)
+
+		applicantParity, slotParity := bipartite.Label(edges, m)
+
+		for a := range edges {
+			kept := edges[a][:0]
+
+			for _, s := range edges[a] {
+				if bipartite.IsMaximumMatchingEdge(applicantParity[a], slotParity[s]) {
+					kept = append(kept, s)
+				}
+			}
+
+			edges[a] = kept
+			applicantClosed[a] = applicantClosed[a] || applicantParity[a] != bipartite.Even
+		}
+
+		for s := range slotParity {
+			slotClosed[s] = slotClosed[s] || slotParity[s] != bipartite.Even
+		}
+	}
+
+	return m
+}
@@ -0,0 +1,30 @@
+package rmm
+
+import (
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRankMaximalMatching(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "A1", Preferences: []string{"H1", "H2"}},
+			{Name: "A2", Preferences: []string{"H3", "H1"}},
+			{Name: "A3", Preferences: []string{"H3"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "H1"},
+			{Name: "H2"},
+			{Name: "H3"},
+		},
+	)
+
+	g := newHouseGraph(&tables[0], &tables[1])
+
+	m := rankMaximalMatching(g)
+
+	assert.Equal(t, []int{0, 2, -1}, m.LeftMate)
+	assert.Equal(t, []int{0, -1, 1}, m.RightMate)
+}
@@ -0,0 +1,54 @@
+package rmm
+
+import (
+	"sort"
+
+	"github.com/abhchand/libmatch/pkg/algo/internal/bipartite"
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// Run executes the rank-maximal matching algorithm (RMM) of Irving et al.
+// (2006) for a set of given preference inputs. `TableA` holds the applicants
+// and `TableB` holds the houses, which have no preferences.
+//
+// See rmm package documentation for more detail
+func Run(algoCtx core.AlgorithmContext) (core.MatchResult, error) {
+	g := newHouseGraph(algoCtx.TableA, algoCtx.TableB)
+
+	m := rankMaximalMatching(g)
+
+	return buildResult(g, m), nil
+}
+
+// buildResult constructs a Match Result from the rank-maximal matching, along
+// with its signature.
+func buildResult(g houseGraph, m bipartite.Matching) core.MatchResult {
+	res := core.MatchResult{}
+
+	res.Mapping = make(map[string]string)
+	res.Assignments = make(map[string][]string, len(g.houses))
+	res.Signature = make([]int, g.numRanks())
+
+	for h := range g.houses {
+		res.Assignments[g.houses[h].Name()] = []string{}
+	}
+
+	for a, applicant := range g.applicants {
+		s := m.LeftMate[a]
+
+		if s == -1 {
+			res.Unmatched = append(res.Unmatched, applicant.Name())
+			continue
+		}
+
+		house := g.houses[g.slotHouse[s]]
+
+		res.Mapping[applicant.Name()] = house.Name()
+		res.Assignments[house.Name()] = append(res.Assignments[house.Name()], applicant.Name())
+		res.Signature[applicant.PreferenceList().RankOf(*house)]++
+	}
+
+	sort.Strings(res.Unmatched)
+
+	return res
+}
@@ -0,0 +1,144 @@
+package rmm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A1", Preferences: []string{"H1", "H2"}},
+				{Name: "A2", Preferences: []string{"H1", "H3"}},
+				{Name: "A3", Preferences: []string{"H1"}},
+				{Name: "A4", Preferences: []string{"H2", "H3"}},
+			},
+			{
+				{Name: "H1"},
+				{Name: "H2"},
+				{Name: "H3"},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A1": "H1",
+				"A2": "H3",
+				"A4": "H2",
+			},
+			Assignments: map[string][]string{
+				"H1": {"A1"},
+				"H2": {"A4"},
+				"H3": {"A2"},
+			},
+			Unmatched: []string{"A3"},
+			Signature: []int{2, 1},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("with ties and capacities", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{
+					Name:              "A1",
+					Preferences:       []string{"H1", "H2", "H3"},
+					RankedPreferences: [][]string{{"H1", "H2"}, {"H3"}},
+				},
+				{Name: "A2", Preferences: []string{"H2", "H3"}},
+				{Name: "A3", Preferences: []string{"H2"}},
+				{Name: "A4", Preferences: []string{"H3", "H1"}},
+			},
+			{
+				{Name: "H1"},
+				{Name: "H2", Capacity: 2},
+				{Name: "H3"},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A1": "H1",
+				"A2": "H2",
+				"A3": "H2",
+				"A4": "H3",
+			},
+			Assignments: map[string][]string{
+				"H1": {"A1"},
+				"H2": {"A2", "A3"},
+				"H3": {"A4"},
+			},
+			Signature: []int{4, 0},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("prefers more first choices over more matches", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A1", Preferences: []string{"H1", "H2"}},
+				{Name: "A2", Preferences: []string{"H3", "H1"}},
+				{Name: "A3", Preferences: []string{"H3"}},
+			},
+			{
+				{Name: "H1"},
+				{Name: "H2"},
+				{Name: "H3"},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+		}
+
+		// Matching "A1" with "H2" and "A2" with "H1" would match everyone, but
+		// with one fewer first choice
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A1": "H1",
+				"A2": "H3",
+			},
+			Assignments: map[string][]string{
+				"H1": {"A1"},
+				"H2": {},
+				"H3": {"A2"},
+			},
+			Unmatched: []string{"A3"},
+			Signature: []int{2, 0},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+}
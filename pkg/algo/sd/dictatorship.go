@@ -0,0 +1,64 @@
+package sd
+
+import (
+	"math/rand"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// assignments tracks the item each member has picked, keyed by member name.
+type assignments map[string]*core.Member
+
+// serialDictatorship lets each member, in the given order, pick their most
+// preferred item that still has capacity left. Members who find no such item
+// are left without one.
+//
+// The preference tables are not modified, so that the same tables may be used
+// for many different orders.
+func serialDictatorship(order []*core.Member, ptI *core.PreferenceTable) assignments {
+	assigned := make(assignments, len(order))
+
+	seats := make(map[string]int, len(*ptI))
+	for name, item := range *ptI {
+		seats[name] = item.Capacity()
+	}
+
+	for _, member := range order {
+		items := member.PreferenceList().Members()
+
+		for i := range items {
+			if seats[items[i].Name()] > 0 {
+				assigned[member.Name()] = items[i]
+				seats[items[i].Name()]--
+				break
+			}
+		}
+	}
+
+	return assigned
+}
+
+// priorityOrder returns the members of a preference table in the order of the
+// given member names.
+func priorityOrder(pt *core.PreferenceTable, names []string) []*core.Member {
+	order := make([]*core.Member, len(names))
+
+	for i := range names {
+		order[i] = (*pt)[names[i]]
+	}
+
+	return order
+}
+
+// randomOrder returns the members of a preference table in a random order.
+// Members are sorted by name before being shuffled, so that the same source
+// always produces the same order.
+func randomOrder(pt *core.PreferenceTable, r *rand.Rand) []*core.Member {
+	names := pt.SortedNames()
+
+	r.Shuffle(len(names), func(i, j int) {
+		names[i], names[j] = names[j], names[i]
+	})
+
+	return priorityOrder(pt, names)
+}
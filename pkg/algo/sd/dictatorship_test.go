@@ -0,0 +1,60 @@
+package sd
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSerialDictatorship(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "A", Preferences: []string{"X", "Y"}},
+			{Name: "B", Preferences: []string{"X"}},
+			{Name: "C", Preferences: []string{"X", "Y"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "X", Capacity: 2},
+			{Name: "Y"},
+		},
+	)
+
+	ptA, ptI := tables[0], tables[1]
+
+	order := priorityOrder(&ptA, []string{"A", "B", "C"})
+
+	wanted := assignments{
+		"A": ptI["X"],
+		"B": ptI["X"],
+		"C": ptI["Y"],
+	}
+
+	assert.Equal(t, wanted, serialDictatorship(order, &ptI))
+
+	// Preference lists are left unchanged
+	assert.Equal(t, []*core.Member{ptI["X"], ptI["Y"]}, ptA["C"].PreferenceList().Members())
+}
+
+func TestPriorityOrder(t *testing.T) {
+	pt := core.NewPreferenceTable(&[]core.MatchPreference{
+		{Name: "A", Preferences: []string{"B"}},
+		{Name: "B", Preferences: []string{"A"}},
+	})
+
+	assert.Equal(t, []*core.Member{pt["B"], pt["A"]}, priorityOrder(&pt, []string{"B", "A"}))
+}
+
+func TestRandomOrder(t *testing.T) {
+	pt := core.NewPreferenceTable(&[]core.MatchPreference{
+		{Name: "A", Preferences: []string{"B", "C"}},
+		{Name: "B", Preferences: []string{"A", "C"}},
+		{Name: "C", Preferences: []string{"A", "B"}},
+	})
+
+	order := randomOrder(&pt, rand.New(rand.NewSource(3)))
+
+	assert.ElementsMatch(t, []*core.Member{pt["A"], pt["B"], pt["C"]}, order)
+	assert.Equal(t, order, randomOrder(&pt, rand.New(rand.NewSource(3))))
+}
@@ -0,0 +1,68 @@
+/*
+Package sd implements the "Serial Dictatorship" (SD) and "Random Serial
+Dictatorship" (RSD) algorithms.
+
+Both allocate items to members in a one-sided problem, where only members rank
+the items they find acceptable and each item has a capacity (defaults to 1).
+Items have no preferences of their own.
+
+The result is not stable, since items have no preferences, but it is Pareto
+efficient: no member can be given an item they prefer without giving another
+member an item they like less. No member can gain an item they prefer by
+misreporting their preferences.
+
+ALGORITHM
+
+See: https://en.wikipedia.org/wiki/Random_serial_dictatorship
+
+Members pick one at a time, in a given priority order. Each member picks their
+most preferred item that still has capacity left. Members who find no such item
+are left unmatched.
+
+Serial Dictatorship uses a priority order given as input, and is deterministic.
+
+Random Serial Dictatorship draws the priority order uniformly at random. The
+random order is derived from a seed, so that the same seed always produces the
+same result. Since a single draw may favour some members over others, many
+orders may be drawn instead, in which case the result holds the probability of
+each member picking each item over all draws.
+
+ALGORITHM EXAMPLE
+
+Take the following preference tables
+
+	// members
+	A => [Y, X]
+	B => [Y, Z]
+	C => [X]
+	D => [Y, X, Z]
+	E => [Z]
+
+	// items (capacity)
+	X (2)
+	Y (1)
+	Z (1)
+
+And the priority order
+
+	D, A, B, C, E
+
+"D" picks their first choice "Y", which has no capacity left.
+
+"A" can not pick "Y", and picks "X" instead.
+
+"B" can not pick "Y", and picks "Z" instead.
+
+"C" picks "X", which now has no capacity left.
+
+"E" can not pick "Z", and has no other choice. "E" is left unmatched.
+
+This gives us the following assignments
+
+	A => X
+	B => Z
+	C => X
+	D => Y
+	E => (unmatched)
+*/
+package sd
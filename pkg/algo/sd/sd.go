@@ -0,0 +1,116 @@
+package sd
+
+import (
+	"math/rand"
+	"sort"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// Run executes the Serial Dictatorship algorithm (SD) for a set of given
+// preference inputs. `TableA` holds the members, who pick in the order given
+// by `PriorityOrder`, and `TableB` holds the items they pick from. Members
+// pick in alphabetical order when no priority order is given.
+//
+// See sd package documentation for more detail
+func Run(algoCtx core.AlgorithmContext) (core.MatchResult, error) {
+	ptA := algoCtx.TableA
+	ptI := algoCtx.TableB
+
+	names := algoCtx.PriorityOrder
+	if names == nil {
+		names = ptA.SortedNames()
+	}
+
+	order := priorityOrder(ptA, names)
+	assigned := serialDictatorship(order, ptI)
+
+	return buildResult(ptA, ptI, order, assigned), nil
+}
+
+// RunRandom executes the Random Serial Dictatorship algorithm (RSD) for a set
+// of given preference inputs. `TableA` holds the members, who pick in a random
+// order derived from `Seed`, and `TableB` holds the items they pick from.
+//
+// When `Draws` is greater than 1, that many random orders are drawn and the
+// result holds the probability of each member picking each item instead of a
+// single matching.
+//
+// See sd package documentation for more detail
+func RunRandom(algoCtx core.AlgorithmContext) (core.MatchResult, error) {
+	ptA := algoCtx.TableA
+	ptI := algoCtx.TableB
+
+	r := rand.New(rand.NewSource(algoCtx.Seed))
+
+	if algoCtx.Draws <= 1 {
+		order := randomOrder(ptA, r)
+		assigned := serialDictatorship(order, ptI)
+
+		return buildResult(ptA, ptI, order, assigned), nil
+	}
+
+	counts := make(map[string]map[string]int, len(*ptA))
+	for name := range *ptA {
+		counts[name] = make(map[string]int)
+	}
+
+	for d := 0; d < algoCtx.Draws; d++ {
+		assigned := serialDictatorship(randomOrder(ptA, r), ptI)
+
+		for name, item := range assigned {
+			counts[name][item.Name()]++
+		}
+	}
+
+	return buildProbabilities(counts, algoCtx.Draws), nil
+}
+
+// buildResult constructs a Match Result from the item picked by each member
+// at the end of the algorithm run. Members are assigned to each item in the
+// order in which they picked.
+func buildResult(ptA, ptI *core.PreferenceTable, order []*core.Member, assigned assignments) core.MatchResult {
+	res := core.MatchResult{}
+
+	res.Mapping = make(map[string]string)
+	res.Assignments = make(map[string][]string, len(*ptI))
+
+	for name := range *ptI {
+		res.Assignments[name] = []string{}
+	}
+
+	for _, member := range order {
+		item := assigned[member.Name()]
+
+		if item == nil {
+			res.Unmatched = append(res.Unmatched, member.Name())
+			continue
+		}
+
+		res.Mapping[member.Name()] = item.Name()
+		res.Assignments[item.Name()] = append(res.Assignments[item.Name()], member.Name())
+	}
+
+	sort.Strings(res.Unmatched)
+
+	return res
+}
+
+// buildProbabilities constructs a Match Result from the number of times each
+// member picked each item over a number of draws.
+func buildProbabilities(counts map[string]map[string]int, draws int) core.MatchResult {
+	res := core.MatchResult{}
+
+	res.Mapping = make(map[string]string)
+	res.Probabilities = make(map[string]map[string]float64, len(counts))
+
+	for name, items := range counts {
+		res.Probabilities[name] = make(map[string]float64, len(items))
+
+		for item, count := range items {
+			res.Probabilities[name][item] = float64(count) / float64(draws)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,144 @@
+package sd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	prefsSet := []*[]core.MatchPreference{
+		{
+			{Name: "A", Preferences: []string{"Y", "X"}},
+			{Name: "B", Preferences: []string{"Y", "Z"}},
+			{Name: "C", Preferences: []string{"X"}},
+			{Name: "D", Preferences: []string{"Y", "X", "Z"}},
+			{Name: "E", Preferences: []string{"Z"}},
+		},
+		{
+			{Name: "X", Capacity: 2},
+			{Name: "Y"},
+			{Name: "Z"},
+		},
+	}
+
+	t.Run("with priority order", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA:        &tables[0],
+			TableB:        &tables[1],
+			PriorityOrder: []string{"D", "A", "B", "C", "E"},
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "X",
+				"B": "Z",
+				"C": "X",
+				"D": "Y",
+			},
+			Assignments: map[string][]string{
+				"X": {"A", "C"},
+				"Y": {"D"},
+				"Z": {"B"},
+			},
+			Unmatched: []string{"E"},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("without priority order", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "Y",
+				"B": "Z",
+				"C": "X",
+				"D": "X",
+			},
+			Assignments: map[string][]string{
+				"X": {"C", "D"},
+				"Y": {"A"},
+				"Z": {"B"},
+			},
+			Unmatched: []string{"E"},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+}
+
+func TestRunRandom(t *testing.T) {
+	prefsSet := []*[]core.MatchPreference{
+		{
+			{Name: "A", Preferences: []string{"X", "Y"}},
+			{Name: "B", Preferences: []string{"X", "Y"}},
+		},
+		{
+			{Name: "X"},
+			{Name: "Y"},
+		},
+	}
+
+	t.Run("single draw", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+			Seed:   7,
+		}
+
+		result, err := RunRandom(algoCtx)
+
+		assert.Nil(t, err)
+		assert.Len(t, result.Mapping, 2)
+		assert.NotEqual(t, result.Mapping["A"], result.Mapping["B"])
+		assert.Nil(t, result.Probabilities)
+
+		// The same seed always produces the same result
+		again, _ := RunRandom(algoCtx)
+		assert.Equal(t, result, again)
+	})
+
+	t.Run("many draws", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+			Seed:   7,
+			Draws:  1000,
+		}
+
+		result, err := RunRandom(algoCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{}, result.Mapping)
+
+		for _, name := range []string{"A", "B"} {
+			p := result.Probabilities[name]
+
+			assert.InDelta(t, 1.0, p["X"]+p["Y"], 1e-9)
+			assert.InDelta(t, 0.5, p["X"], 0.1)
+		}
+
+		assert.InDelta(t, 1.0, result.Probabilities["A"]["X"]+result.Probabilities["B"]["X"], 1e-9)
+	})
+}
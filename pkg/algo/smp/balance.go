@@ -0,0 +1,174 @@
+package smp
+
+// sideCost is the total rank cost of the proposers (`x`) and of the receivers
+// (`y`) in the stable matching given by a closed set of rotations.
+type sideCost struct {
+	closure []bool
+	x, y    int
+}
+
+// sexEqualScore scores a matching by the difference between the total rank
+// costs of both sides
+func sexEqualScore(proposerCost, receiverCost int) int {
+	if proposerCost > receiverCost {
+		return proposerCost - receiverCost
+	}
+
+	return receiverCost - proposerCost
+}
+
+// balancedScore scores a matching by the larger of the total rank costs of
+// both sides
+func balancedScore(proposerCost, receiverCost int) int {
+	if proposerCost > receiverCost {
+		return proposerCost
+	}
+
+	return receiverCost
+}
+
+// balancedMatching searches the stable matchings for one that minimises a
+// score of the total rank costs of both sides, such as the sex-equal or
+// balanced score. Finding an optimal matching for either score is NP-hard, so
+// this is a heuristic.
+//
+// Every stable matching is a point `(x, y)` of proposer and receiver costs.
+// The matchings on the lower convex hull of these points minimise
+// `alpha * x + beta * y` for some positive weights, so each is found with a
+// minimum cut, starting from the proposer-optimal and receiver-optimal
+// matchings and recursively searching between neighbouring points on the hull.
+//
+// The best matching on the hull is then improved by local search, eliminating
+// or restoring one rotation at a time while the score improves. Ties in score
+// are broken by the total cost of both sides.
+func (poset rotationPoset) balancedMatching(score func(proposerCost, receiverCost int) int) map[string]string {
+	proposerWeights := poset.rotationWeights(func(p, r string) int { return poset.originalRanks[p][r] })
+	receiverWeights := poset.rotationWeights(func(p, r string) int { return poset.originalRanks[r][p] })
+	baseX, baseY := poset.sideCosts(poset.base)
+
+	costOf := func(closure []bool) sideCost {
+		c := sideCost{closure: closure, x: baseX, y: baseY}
+
+		for i := range closure {
+			if closure[i] {
+				c.x += proposerWeights[i]
+				c.y += receiverWeights[i]
+			}
+		}
+
+		return c
+	}
+
+	better := func(a, b sideCost) bool {
+		scoreA, scoreB := score(a.x, a.y), score(b.x, b.y)
+		return scoreA < scoreB || (scoreA == scoreB && a.x+a.y < b.x+b.y)
+	}
+
+	// Search the lower convex hull
+	full := make([]bool, len(poset.rotations))
+	for i := range full {
+		full[i] = true
+	}
+
+	hull := []sideCost{costOf(make([]bool, len(poset.rotations))), costOf(full)}
+
+	var search func(p, q sideCost)
+	search = func(p, q sideCost) {
+		alpha, beta := p.y-q.y, q.x-p.x
+		if alpha <= 0 || beta <= 0 {
+			return
+		}
+
+		weights := make([]int, len(poset.rotations))
+		for i := range weights {
+			weights[i] = alpha*proposerWeights[i] + beta*receiverWeights[i]
+		}
+
+		s := costOf(minimumWeightClosure(weights, poset.predecessors))
+
+		if alpha*s.x+beta*s.y < alpha*p.x+beta*p.y {
+			hull = append(hull, s)
+			search(p, s)
+			search(s, q)
+		}
+	}
+
+	search(hull[0], hull[1])
+
+	best := hull[0]
+	for i := range hull {
+		if better(hull[i], best) {
+			best = hull[i]
+		}
+	}
+
+	// Improve the best matching by local search
+	successors := make([][]int, len(poset.rotations))
+	for i := range poset.predecessors {
+		for _, pred := range poset.predecessors[i] {
+			successors[pred] = append(successors[pred], i)
+		}
+	}
+
+	closure := append([]bool{}, best.closure...)
+	current := sideCost{closure: closure, x: best.x, y: best.y}
+
+	for true {
+		move := -1
+		next := current
+
+		for i := range poset.rotations {
+			var candidate sideCost
+
+			if closure[i] {
+				if anyIncluded(closure, successors[i]) {
+					continue
+				}
+				candidate = sideCost{x: current.x - proposerWeights[i], y: current.y - receiverWeights[i]}
+			} else {
+				if !allIncluded(closure, poset.predecessors[i]) {
+					continue
+				}
+				candidate = sideCost{x: current.x + proposerWeights[i], y: current.y + receiverWeights[i]}
+			}
+
+			if better(candidate, next) {
+				move = i
+				next = candidate
+			}
+		}
+
+		if move == -1 {
+			break
+		}
+
+		closure[move] = !closure[move]
+		current = sideCost{closure: closure, x: next.x, y: next.y}
+	}
+
+	return poset.closureMatching(closure)
+}
+
+// allIncluded indicates whether all of the specified rotations are included
+// in a set of rotations
+func allIncluded(included []bool, rotations []int) bool {
+	for _, r := range rotations {
+		if !included[r] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// anyIncluded indicates whether any of the specified rotations are included
+// in a set of rotations
+func anyIncluded(included []bool, rotations []int) bool {
+	for _, r := range rotations {
+		if included[r] {
+			return true
+		}
+	}
+
+	return false
+}
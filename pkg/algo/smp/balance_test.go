@@ -0,0 +1,78 @@
+package smp
+
+import (
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBalancedMatching(t *testing.T) {
+	// The stable matchings have (proposer, receiver) costs of (5, 11), (8, 7)
+	// and (11, 5)
+	wanted := map[string]string{"A": "E", "B": "H", "C": "F", "D": "G"}
+
+	t.Run("sex-equal", func(t *testing.T) {
+		prefsSet := objectiveTestPrefs()
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+		poset := newRotationPoset(&tables[0], &tables[1])
+
+		matching := poset.balancedMatching(sexEqualScore)
+
+		assert.Equal(t, wanted, matching)
+	})
+
+	t.Run("balanced", func(t *testing.T) {
+		prefsSet := objectiveTestPrefs()
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+		poset := newRotationPoset(&tables[0], &tables[1])
+
+		matching := poset.balancedMatching(balancedScore)
+
+		assert.Equal(t, wanted, matching)
+	})
+
+	t.Run("single stable matching", func(t *testing.T) {
+		prefsA := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"C", "D"}},
+			{Name: "B", Preferences: []string{"D", "C"}},
+		}
+		prefsB := []core.MatchPreference{
+			{Name: "C", Preferences: []string{"A", "B"}},
+			{Name: "D", Preferences: []string{"B", "A"}},
+		}
+
+		tables := core.NewPreferenceTablePair(&prefsA, &prefsB)
+		poset := newRotationPoset(&tables[0], &tables[1])
+
+		assert.Equal(t, map[string]string{"A": "C", "B": "D"}, poset.balancedMatching(sexEqualScore))
+	})
+}
+
+func TestSexEqualScore(t *testing.T) {
+	assert.Equal(t, 6, sexEqualScore(5, 11))
+	assert.Equal(t, 6, sexEqualScore(11, 5))
+	assert.Equal(t, 0, sexEqualScore(7, 7))
+}
+
+func TestBalancedScore(t *testing.T) {
+	assert.Equal(t, 11, balancedScore(5, 11))
+	assert.Equal(t, 11, balancedScore(11, 5))
+	assert.Equal(t, 7, balancedScore(7, 7))
+}
+
+func TestAllIncluded(t *testing.T) {
+	included := []bool{true, false, true}
+
+	assert.True(t, allIncluded(included, []int{0, 2}))
+	assert.True(t, allIncluded(included, nil))
+	assert.False(t, allIncluded(included, []int{0, 1}))
+}
+
+func TestAnyIncluded(t *testing.T) {
+	included := []bool{true, false, true}
+
+	assert.True(t, anyIncluded(included, []int{1, 2}))
+	assert.False(t, anyIncluded(included, nil))
+	assert.False(t, anyIncluded(included, []int{1}))
+}
@@ -36,8 +36,10 @@ Notes:
 1. Multiple stable matchings may exist, and this process will only return one
 possible stable matching.
 
-2. The algorithm itself prioritizes the preferences of the first specified
-preference table over the second.
+2. The algorithm itself prioritizes the preferences of the proposing table over
+the other. By default the first specified preference table proposes; set
+`Proposer` to `core.SideB` to let the second table propose instead. The
+result's `OptimalSide` records which side the matching is optimal for.
 
 3. While the algorithm is deterministic, the `libmatch` implementation is not.
 That is, it is not guranteed to return the same matching everytime. This is due
@@ -45,6 +47,161 @@ to the underlying storage mechanism, which uses a `map` type. Go randomizes the
 access keys of a `map` and so the algorithm may produce different results on
 different runs.
 
+INCOMPLETE LISTS
+
+A variant of the problem, the "Stable Marriage Problem with Incomplete Lists"
+(SMI), allows members to omit unacceptable members of the other group from
+their preference lists. The two groups may also be of different sizes.
+
+A pair of members is only acceptable when both list each other, so any
+one-sided preferences are removed before the algorithm runs. Proposals then
+continue until every unmatched member has exhausted their preference list.
+
+A stable solution is still guaranteed, but it may leave some members
+unmatched. Every stable matching leaves the same set of members unmatched.
+
+TIES
+
+A variant of the problem, the "Stable Marriage Problem with Ties" (SMT), allows
+members to be indifferent between several members of the other group. Tied
+members share the same rank on a preference list.
+
+Here a matching is "weakly stable" when no pair of members would both strictly
+prefer each other over their current matches. Breaking every tie in some
+arbitrary order and running the algorithm above always yields a weakly stable
+matching. Ties may be broken in the order in which they are listed (default),
+alphabetically by name, or randomly from a seed.
+
+Different tie breaking policies may yield weakly stable matchings of different
+sizes when lists are also incomplete (SMTI).
+
+STRONG AND SUPER STABILITY
+
+Two stricter notions of stability exist for preference lists with ties. A
+matching is "strongly stable" when no pair of members exists where one strictly
+prefers the other, and the other is at least indifferent. It is "super-stable"
+when no pair of members exists where both are at least indifferent. Unlike
+weakly stable matchings, neither is guaranteed to exist.
+
+Both are found with Irving's (1994) algorithms, as extended by Manlove (1999)
+for incomplete lists. Ties are not broken. Instead each free member proposes to
+every member tied at the head of their list at once and becomes "engaged" to
+each of them. A recipient of a proposal deletes every member they like strictly
+less than the proposer from their list.
+
+For super-stability, any recipient engaged to several members breaks all of
+those engagements and deletes every member tied at the tail of their list.
+
+For strong stability, the engagements form a bipartite graph. The "critical
+set" is the set of proposers left unmatched by some maximum matching of that
+graph. Each recipient engaged to a member of the critical set deletes every
+member tied at the tail of their list.
+
+Both processes repeat until no further deletions are needed. If the remaining
+engagements (or, for strong stability, a maximum matching of them) match every
+member who was ever engaged, that matching is returned. Otherwise no such
+matching exists and an error is returned.
+
+ENUMERATING ALL STABLE MATCHINGS
+
+An instance may have exponentially many stable matchings. `Enumerate` lists all
+of them using the "rotation poset" of Gusfield and Irving (1989).
+
+Given a stable matching, let each proposer `p` look past their partner for the
+first member who would prefer `p` to their own partner. A "rotation" is a cycle
+of proposers where each one is sent to the partner of the next. Eliminating a
+rotation moves each of its proposers to that member, which yields another
+stable matching that is worse for the proposers and better for the others.
+
+Starting from the proposer-optimal matching, rotations are eliminated one at a
+time until none remain, at which point the matching is optimal for the other
+side. Every rotation is found exactly once this way. Some rotations can only be
+eliminated after others, and these dependencies form a partial order.
+
+Every stable matching corresponds to exactly one set of rotations that is
+closed under this order. The closed sets are generated one at a time by
+deciding, for each rotation in turn, whether it is eliminated, so matchings
+are streamed rather than stored.
+
+EGALITARIAN AND MINIMUM COST MATCHINGS
+
+The proposer-optimal matching favours one side at the expense of the other. An
+"egalitarian" stable matching instead minimises the sum of the ranks that every
+member gives their partner. More generally, a cost may be given for every pair
+of members, and the stable matching with the lowest total cost is returned.
+
+Eliminating a rotation changes the total cost of a matching by a fixed amount,
+its "weight". The best matching corresponds to the closed set of rotations with
+the lowest total weight, which is found with a minimum cut of a flow network
+(Irving, Leather and Gusfield, 1987) rather than by enumerating every stable
+matching.
+
+MINIMUM REGRET MATCHINGS
+
+The "regret" of a matching is the worst rank that any member gives their
+partner. Gusfield's (1987) algorithm finds the stable matching with minimum
+regret. Starting from the proposer-optimal matching, proposers only get worse
+partners and receivers only better ones. While the regret is only suffered by
+receivers, the rotations that give those receivers a better partner (and their
+predecessors) are eliminated. Once a proposer suffers the regret it can not be
+lowered any further, and the best matching seen along the way is returned.
+
+SEX-EQUAL AND BALANCED MATCHINGS
+
+The total rank cost of a side is the sum of the ranks that its members give
+their partners. A "sex-equal" stable matching minimises the difference between
+the costs of both sides, and a "balanced" stable matching minimises the larger
+of the two. Finding either is NP-hard, so a heuristic is used.
+
+Each stable matching is a point on a plane of both sides' costs. The matchings
+on the lower convex hull of those points each minimise a weighted sum of the
+two costs, so they are found with a minimum cut for a handful of weights. The
+best of them is then improved by eliminating or restoring one rotation at a
+time for as long as the result improves.
+
+MEDIAN MATCHINGS
+
+When each proposer's partners across all `k` stable matchings are listed from
+best to worst, matching every proposer with the `i`th partner on their list
+gives another stable matching (Teo and Sethuraman, 1998). With
+`i = (k + 1) / 2`, every member is matched with their median partner, which is
+a compromise between the proposer-optimal and receiver-optimal matchings. When
+`k` is even, the better of the two middle partners is used for proposers.
+
+Counting stable matchings is #P-complete, so they are enumerated from the
+rotation poset and only the number of times each pair is matched is kept.
+
+MAXIMUM CARDINALITY MATCHINGS
+
+With ties and incomplete lists (SMTI), weakly stable matchings may differ in
+size, and finding the largest is NP-hard. An approximate solution is found
+with the 3/2-approximation algorithm of Király (2013), which aims to match at
+least 2/3 as many pairs as the largest weakly stable matching.
+
+Proposers propose to a member tied at the head of their list, preferring one
+who has never been proposed to. A receiver holds on to the proposer they like
+best, and a rejected proposer crosses the receiver off their list. Between
+tied proposers a receiver prefers -
+
+1. A proposer on their second pass through their list, over one on their first
+
+2. Otherwise the new proposer, when the current partner is "uncertain", that is
+they have another member tied with the receiver left to propose to
+
+A proposer who exhausts their list is given a second pass through it.
+
+In addition, a receiver "releases" their partner in favour of a proposer they like
+less when the partner has a member tied with the receiver who has never been
+proposed to, and the receiver has not rejected anyone they like more than the
+proposer. The released partner keeps the receiver on their list and proposes
+again straight away, so neither member is left unmatched. Every rejection and
+release only ever hands a receiver to someone at least as good as anyone they
+rejected, so the matching is always weakly stable.
+
+The result records the size of the matching, along with the size of a maximum
+matching of all acceptable pairs that ignores preferences. No weakly stable
+matching can be larger than this upper bound.
+
 ALGORITHM EXAMPLE
 
 See: https://www.youtube.com/watch?v=GsBf3fJFpSw
@@ -0,0 +1,118 @@
+package smp
+
+import (
+	"sort"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// engagements models the engagement relation used by the strong and super
+// stability algorithms. Unlike an accepted proposal, a member may be engaged
+// to several members at once.
+type engagements map[string]map[string]*core.Member
+
+// engage marks a pair of members as engaged to each other
+func (e engagements) engage(a, b *core.Member) {
+	for _, pair := range [][]*core.Member{{a, b}, {b, a}} {
+		if e[pair[0].Name()] == nil {
+			e[pair[0].Name()] = make(map[string]*core.Member)
+		}
+
+		e[pair[0].Name()][pair[1].Name()] = pair[1]
+	}
+}
+
+// breakEngagement removes the engagement between a pair of members, if any
+func (e engagements) breakEngagement(a, b *core.Member) {
+	delete(e[a.Name()], b.Name())
+	delete(e[b.Name()], a.Name())
+}
+
+// partners returns all members engaged to the specified member, in order of
+// name.
+func (e engagements) partners(m *core.Member) []*core.Member {
+	partners := make([]*core.Member, 0, len(e[m.Name()]))
+
+	for _, partner := range e[m.Name()] {
+		partners = append(partners, partner)
+	}
+
+	sort.Slice(partners, func(i, j int) bool {
+		return partners[i].Name() < partners[j].Name()
+	})
+
+	return partners
+}
+
+// isEngaged indicates whether the specified member is engaged to anyone
+func (e engagements) isEngaged(m *core.Member) bool {
+	return len(e[m.Name()]) > 0
+}
+
+// deletePair breaks any engagement between a pair of members and removes them
+// from each others' preference lists.
+func deletePair(e engagements, a, b *core.Member) {
+	e.breakEngagement(a, b)
+	a.Reject(b)
+}
+
+// freeProposers returns all members of a table who are not engaged and have
+// not yet exhausted their preference list, in order of name.
+func freeProposers(pt *core.PreferenceTable, e engagements) []*core.Member {
+	var proposers []*core.Member
+
+	for _, name := range pt.SortedNames() {
+		member := (*pt)[name]
+
+		if !e.isEngaged(member) && member.FirstPreference() != nil {
+			proposers = append(proposers, member)
+		}
+	}
+
+	return proposers
+}
+
+// proposeToHead lets a member propose to every member at the head of their
+// preference list. Each recipient becomes engaged to the proposer and deletes
+// every member they like strictly less than the proposer.
+func proposeToHead(e engagements, proposer *core.Member, everEngaged map[string]bool) {
+	head := proposer.PreferenceList().Groups()[0]
+
+	for _, recipient := range head {
+		e.engage(proposer, recipient)
+		everEngaged[recipient.Name()] = true
+
+		for _, successor := range strictSuccessors(recipient, proposer) {
+			deletePair(e, recipient, successor)
+		}
+	}
+}
+
+// strictSuccessors returns the members that a member likes strictly less than
+// the specified member.
+func strictSuccessors(m, other *core.Member) []*core.Member {
+	var successors []*core.Member
+
+	pl := m.PreferenceList()
+	rank := pl.RankOf(*other)
+	prefs := pl.Members()
+
+	for i := range prefs {
+		if pl.RankOf(*prefs[i]) > rank {
+			successors = append(successors, prefs[i])
+		}
+	}
+
+	return successors
+}
+
+// tail returns the members tied in last place on a member's preference list
+func tail(m *core.Member) []*core.Member {
+	groups := m.PreferenceList().Groups()
+
+	if len(groups) == 0 {
+		return nil
+	}
+
+	return groups[len(groups)-1]
+}
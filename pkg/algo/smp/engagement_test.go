@@ -0,0 +1,146 @@
+package smp
+
+import (
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEngagements(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "A", Preferences: []string{"K", "L"}},
+			{Name: "B", Preferences: []string{"K", "L"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "K", Preferences: []string{"A", "B"}},
+			{Name: "L", Preferences: []string{"A", "B"}},
+		},
+	)
+
+	a, b := tables[0]["A"], tables[0]["B"]
+	k, l := tables[1]["K"], tables[1]["L"]
+
+	e := make(engagements)
+	e.engage(a, l)
+	e.engage(a, k)
+	e.engage(b, k)
+
+	assert.Equal(t, []*core.Member{k, l}, e.partners(a))
+	assert.Equal(t, []*core.Member{a, b}, e.partners(k))
+	assert.True(t, e.isEngaged(l))
+
+	e.breakEngagement(l, a)
+
+	assert.Equal(t, []*core.Member{k}, e.partners(a))
+	assert.False(t, e.isEngaged(l))
+}
+
+func TestDeletePair(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "A", Preferences: []string{"K", "L"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "K", Preferences: []string{"A"}},
+			{Name: "L", Preferences: []string{"A"}},
+		},
+	)
+
+	a, k := tables[0]["A"], tables[1]["K"]
+
+	e := make(engagements)
+	e.engage(a, k)
+
+	deletePair(e, k, a)
+
+	assert.False(t, e.isEngaged(a))
+	assert.Equal(t, []*core.Member{tables[1]["L"]}, a.PreferenceList().Members())
+	assert.Equal(t, []*core.Member{}, k.PreferenceList().Members())
+}
+
+func TestFreeProposers(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "A", Preferences: []string{"K"}},
+			{Name: "B", Preferences: []string{}},
+			{Name: "C", Preferences: []string{"K"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "K", Preferences: []string{"A", "C"}},
+		},
+	)
+
+	e := make(engagements)
+	e.engage(tables[0]["A"], tables[1]["K"])
+
+	assert.Equal(t, []*core.Member{tables[0]["C"]}, freeProposers(&tables[0], e))
+}
+
+func TestProposeToHead(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "A", RankedPreferences: [][]string{{"K", "L"}}},
+			{Name: "B", Preferences: []string{"K", "L"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "K", Preferences: []string{"A", "B"}},
+			{Name: "L", RankedPreferences: [][]string{{"A", "B"}}},
+		},
+	)
+
+	a, b := tables[0]["A"], tables[0]["B"]
+	k, l := tables[1]["K"], tables[1]["L"]
+
+	e := make(engagements)
+	everEngaged := make(map[string]bool)
+
+	proposeToHead(e, a, everEngaged)
+
+	assert.Equal(t, []*core.Member{k, l}, e.partners(a))
+	assert.Equal(t, map[string]bool{"K": true, "L": true}, everEngaged)
+
+	// "K" strictly prefers "A" to "B", but "L" is indifferent between them
+	assert.Equal(t, []*core.Member{l}, b.PreferenceList().Members())
+	assert.Equal(t, []*core.Member{a, b}, l.PreferenceList().Members())
+}
+
+func TestStrictSuccessors(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "A", RankedPreferences: [][]string{{"K"}, {"L", "M"}, {"N"}}},
+		},
+		&[]core.MatchPreference{
+			{Name: "K", Preferences: []string{"A"}},
+			{Name: "L", Preferences: []string{"A"}},
+			{Name: "M", Preferences: []string{"A"}},
+			{Name: "N", Preferences: []string{"A"}},
+		},
+	)
+
+	a := tables[0]["A"]
+
+	assert.Equal(t,
+		[]*core.Member{tables[1]["L"], tables[1]["M"], tables[1]["N"]},
+		strictSuccessors(a, tables[1]["K"]))
+	assert.Equal(t, []*core.Member{tables[1]["N"]}, strictSuccessors(a, tables[1]["L"]))
+	assert.Nil(t, strictSuccessors(a, tables[1]["N"]))
+}
+
+func TestTail(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "A", RankedPreferences: [][]string{{"K"}, {"L", "M"}}},
+			{Name: "B", Preferences: []string{}},
+		},
+		&[]core.MatchPreference{
+			{Name: "K", Preferences: []string{"A"}},
+			{Name: "L", Preferences: []string{"A"}},
+			{Name: "M", Preferences: []string{"A"}},
+		},
+	)
+
+	assert.Equal(t, []*core.Member{tables[1]["L"], tables[1]["M"]}, tail(tables[0]["A"]))
+	assert.Nil(t, tail(tables[0]["B"]))
+}
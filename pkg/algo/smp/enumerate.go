@@ -0,0 +1,106 @@
+package smp
+
+import (
+	"errors"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// Enumerate lists every stable matching for a set of given preference inputs,
+// calling `visit` with each matching in turn. Enumeration stops early when
+// `visit` returns false.
+//
+// Matchings are generated one at a time from the rotation poset, so they
+// never need to be held in memory together. The first matching is optimal
+// for the proposing side and the last is optimal for the other side.
+//
+// Ties in preference lists are broken with the tie breaker specified in the
+// algorithm context, and only weakly stable matchings are supported.
+//
+// See smp package documentation for more detail
+func Enumerate(algoCtx core.AlgorithmContext, visit func(core.MatchResult) bool) error {
+	if algoCtx.Stability != "" && algoCtx.Stability != core.StabilityWeak {
+		return errors.New("Only weakly stable matchings can be enumerated")
+	}
+
+	poset := newRotationPosetFromContext(algoCtx)
+
+	included := make([]bool, len(poset.rotations))
+	matching := poset.copyBase()
+
+	poset.enumerateClosedSets(0, included, matching, func(matching map[string]string) bool {
+		return visit(poset.result(matching))
+	})
+
+	return nil
+}
+
+// newRotationPosetFromContext prepares the preference tables of an algorithm
+// context and computes their rotation poset, with the proposing side
+// specified in the context.
+func newRotationPosetFromContext(algoCtx core.AlgorithmContext) rotationPoset {
+	proposers, receivers := algoCtx.TableA, algoCtx.TableB
+	proposerSide := core.SideA
+
+	if algoCtx.Proposer == core.SideB {
+		proposers, receivers = receivers, proposers
+		proposerSide = core.SideB
+	}
+
+	// Ranks are recorded before ties are broken
+	ranks := originalRanks(proposers, receivers)
+
+	if algoCtx.IncompleteLists {
+		proposers.RemoveUnacceptable()
+		receivers.RemoveUnacceptable()
+	}
+
+	tieBreaker := algoCtx.TieBreaker
+	if tieBreaker == nil {
+		tieBreaker = core.TieBreakByOrder
+	}
+
+	proposers.BreakTies(tieBreaker)
+	receivers.BreakTies(tieBreaker)
+
+	poset := newRotationPoset(proposers, receivers)
+	poset.proposerSide = proposerSide
+	poset.originalRanks = ranks
+
+	return poset
+}
+
+// enumerateClosedSets recursively decides whether each rotation, in
+// topological order, is eliminated. A rotation may only be eliminated when
+// all of its predecessors have been, so every leaf of the recursion is a
+// distinct closed subset of rotations and therefore a distinct stable
+// matching.
+//
+// `visit` is called with the matching at each leaf, keyed by proposer. The
+// matching is modified as the recursion continues, so it must be copied to be
+// kept. Returns false when enumeration was stopped by `visit`.
+func (poset rotationPoset) enumerateClosedSets(idx int, included []bool, matching map[string]string, visit func(map[string]string) bool) bool {
+	if idx == len(poset.rotations) {
+		return visit(matching)
+	}
+
+	if !poset.enumerateClosedSets(idx+1, included, matching, visit) {
+		return false
+	}
+
+	for _, pred := range poset.predecessors[idx] {
+		if !included[pred] {
+			return true
+		}
+	}
+
+	included[idx] = true
+	eliminate(matching, poset.rotations[idx])
+
+	more := poset.enumerateClosedSets(idx+1, included, matching, visit)
+
+	restore(matching, poset.rotations[idx])
+	included[idx] = false
+
+	return more
+}
@@ -0,0 +1,137 @@
+package smp
+
+import (
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnumerate(t *testing.T) {
+	prefsA := []core.MatchPreference{
+		{Name: "A", Preferences: []string{"E", "F", "G", "H"}},
+		{Name: "B", Preferences: []string{"F", "E", "G", "H"}},
+		{Name: "C", Preferences: []string{"G", "H", "E", "F"}},
+		{Name: "D", Preferences: []string{"H", "G", "E", "F"}},
+	}
+	prefsB := []core.MatchPreference{
+		{Name: "E", Preferences: []string{"B", "A", "C", "D"}},
+		{Name: "F", Preferences: []string{"A", "B", "C", "D"}},
+		{Name: "G", Preferences: []string{"D", "C", "A", "B"}},
+		{Name: "H", Preferences: []string{"C", "D", "A", "B"}},
+	}
+
+	mapping := func(pairs ...string) map[string]string {
+		m := make(map[string]string)
+		for i := range pairs {
+			m[pairs[i][:1]] = pairs[i][1:]
+			m[pairs[i][1:]] = pairs[i][:1]
+		}
+		return m
+	}
+
+	t.Run("success", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(&prefsA, &prefsB)
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+		}
+
+		var results []core.MatchResult
+		err := Enumerate(algoCtx, func(res core.MatchResult) bool {
+			results = append(results, res)
+			return true
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []core.MatchResult{
+			{Mapping: mapping("AE", "BF", "CG", "DH"), Costs: map[core.Side]int{core.SideA: 4, core.SideB: 8}},
+			{Mapping: mapping("AE", "BF", "CH", "DG"), Costs: map[core.Side]int{core.SideA: 6, core.SideB: 6}},
+			{Mapping: mapping("AF", "BE", "CG", "DH"), Costs: map[core.Side]int{core.SideA: 6, core.SideB: 6}},
+			{Mapping: mapping("AF", "BE", "CH", "DG"), Costs: map[core.Side]int{core.SideA: 8, core.SideB: 4}},
+		}, results)
+	})
+
+	t.Run("second table proposes", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(&prefsA, &prefsB)
+		algoCtx := core.AlgorithmContext{
+			TableA:   &tables[0],
+			TableB:   &tables[1],
+			Proposer: core.SideB,
+		}
+
+		var results []core.MatchResult
+		err := Enumerate(algoCtx, func(res core.MatchResult) bool {
+			results = append(results, res)
+			return true
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, 4, len(results))
+		assert.Equal(t, mapping("AF", "BE", "CH", "DG"), results[0].Mapping)
+		assert.Equal(t, mapping("AE", "BF", "CG", "DH"), results[3].Mapping)
+	})
+
+	t.Run("stops early", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(&prefsA, &prefsB)
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+		}
+
+		count := 0
+		err := Enumerate(algoCtx, func(res core.MatchResult) bool {
+			count++
+			return count < 2
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("incomplete lists", func(t *testing.T) {
+		prefsA := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"D", "E"}},
+			{Name: "B", Preferences: []string{"E", "D"}},
+			{Name: "C", Preferences: []string{"D"}},
+		}
+		prefsB := []core.MatchPreference{
+			{Name: "D", Preferences: []string{"B", "A"}},
+			{Name: "E", Preferences: []string{"A", "B"}},
+		}
+
+		tables := core.NewPreferenceTablePair(&prefsA, &prefsB)
+		algoCtx := core.AlgorithmContext{
+			TableA:          &tables[0],
+			TableB:          &tables[1],
+			IncompleteLists: true,
+		}
+
+		var results []core.MatchResult
+		err := Enumerate(algoCtx, func(res core.MatchResult) bool {
+			results = append(results, res)
+			return true
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []core.MatchResult{
+			{Mapping: mapping("AD", "BE"), Unmatched: []string{"C"}, Costs: map[core.Side]int{core.SideA: 2, core.SideB: 4}},
+			{Mapping: mapping("AE", "BD"), Unmatched: []string{"C"}, Costs: map[core.Side]int{core.SideA: 4, core.SideB: 2}},
+		}, results)
+	})
+
+	t.Run("strong stability is not supported", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(&prefsA, &prefsB)
+		algoCtx := core.AlgorithmContext{
+			TableA:    &tables[0],
+			TableB:    &tables[1],
+			Stability: core.StabilityStrong,
+		}
+
+		err := Enumerate(algoCtx, func(res core.MatchResult) bool {
+			return true
+		})
+
+		assert.Equal(t, "Only weakly stable matchings can be enumerated", err.Error())
+	})
+}
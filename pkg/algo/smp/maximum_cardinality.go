@@ -0,0 +1,282 @@
+package smp
+
+import (
+	"errors"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// cardinalitySearch holds the state of Király's (2013) approximation
+// algorithm for a maximum weakly stable matching.
+type cardinalitySearch struct {
+	// ranks maps each member to the rank of every member on their preference
+	// list. Tied members share a rank.
+	ranks map[string]map[string]int
+
+	// deleted records the members each proposer has been rejected by during
+	// their current pass through their preference list
+	deleted map[string]map[string]bool
+
+	// promoted records the proposers who have exhausted their preference list
+	// once, and are given a second pass through it
+	promoted map[string]bool
+
+	// maiden records the receivers who have never been proposed to
+	maiden map[string]bool
+
+	// bestRejected records the best rank of any proposer each receiver has
+	// rejected
+	bestRejected map[string]int
+
+	// partner maps each engaged member (of either side) to their partner
+	partner map[string]*core.Member
+}
+
+// maximumCardinalityMatching finds a weakly stable matching that aims to be
+// at least 2/3 the size of the largest weakly stable matching, using Király's
+// (2013) approximation algorithm. The result records the size of the matching, along
+// with the size of a maximum matching of acceptable pairs, which bounds the
+// size of any weakly stable matching.
+//
+// Finding the largest weakly stable matching is NP-hard when preference lists
+// contain ties and are incomplete (SMTI).
+//
+// See smp package documentation for more detail
+func maximumCardinalityMatching(algoCtx core.AlgorithmContext) (core.MatchResult, error) {
+	var res core.MatchResult
+
+	if algoCtx.Stability != "" && algoCtx.Stability != core.StabilityWeak {
+		return res, errors.New("Only weakly stable matchings can be optimised for an objective")
+	}
+
+	proposers, receivers := algoCtx.TableA, algoCtx.TableB
+	proposerSide, receiverSide := core.SideA, core.SideB
+
+	if algoCtx.Proposer == core.SideB {
+		proposers, receivers = receivers, proposers
+		proposerSide, receiverSide = core.SideB, core.SideA
+	}
+
+	if algoCtx.IncompleteLists {
+		proposers.RemoveUnacceptable()
+		receivers.RemoveUnacceptable()
+	}
+
+	search := cardinalitySearch{
+		ranks:        originalRanks(proposers, receivers),
+		deleted:      make(map[string]map[string]bool),
+		promoted:     make(map[string]bool),
+		maiden:       make(map[string]bool),
+		bestRejected: make(map[string]int),
+		partner:      make(map[string]*core.Member),
+	}
+
+	for name := range *receivers {
+		search.maiden[name] = true
+	}
+
+	search.run(proposers)
+
+	mapping := make(map[string]string)
+	proposerCost, receiverCost := 0, 0
+
+	for name, partner := range search.partner {
+		mapping[name] = partner.Name()
+	}
+
+	for p := range *proposers {
+		if r, ok := mapping[p]; ok {
+			proposerCost += search.ranks[p][r] + 1
+			receiverCost += search.ranks[r][p] + 1
+		}
+	}
+
+	res = buildResultFromMapping(algoCtx.TableA, algoCtx.TableB, mapping)
+
+	res.Costs = map[core.Side]int{
+		proposerSide: proposerCost,
+		receiverSide: receiverCost,
+	}
+
+	res.Size = len(mapping) / 2
+	res.SizeUpperBound = maximumAcceptableMatching(proposers)
+
+	return res, nil
+}
+
+// run lets each free proposer, in order of name, propose until every
+// proposer is engaged or has exhausted their preference list twice.
+func (s cardinalitySearch) run(proposers *core.PreferenceTable) {
+	var queue []*core.Member
+
+	for _, name := range proposers.SortedNames() {
+		queue = append(queue, (*proposers)[name])
+	}
+
+	for len(queue) > 0 {
+		proposer := queue[0]
+		queue = queue[1:]
+
+		head := s.head(proposer)
+
+		if len(head) == 0 {
+			if s.promoted[proposer.Name()] {
+				continue
+			}
+
+			// Start a second pass through the preference list, in which the
+			// proposer wins ties against proposers on their first pass
+			s.promoted[proposer.Name()] = true
+			s.deleted[proposer.Name()] = nil
+
+			queue = append(queue, proposer)
+			continue
+		}
+
+		receiver := s.choose(head)
+
+		rejected, released := s.propose(proposer, receiver)
+
+		switch {
+		case rejected == nil:
+		case released:
+			// The released proposer proposes again straight away, to a member
+			// they like as much who has never been proposed to
+			queue = append([]*core.Member{rejected}, queue...)
+		default:
+			s.reject(rejected, receiver)
+			queue = append(queue, rejected)
+		}
+	}
+}
+
+// head returns the members tied at the head of a proposer's preference list,
+// skipping the members that have rejected them.
+func (s cardinalitySearch) head(proposer *core.Member) []*core.Member {
+	for _, group := range proposer.PreferenceList().Groups() {
+		var head []*core.Member
+
+		for _, member := range group {
+			if !s.deleted[proposer.Name()][member.Name()] {
+				head = append(head, member)
+			}
+		}
+
+		if len(head) > 0 {
+			return head
+		}
+	}
+
+	return nil
+}
+
+// choose returns the member of a tie that a proposer proposes to. A member who
+// has never been proposed to is chosen when possible, so that they are not
+// left unmatched while the proposer is matched with someone they like as much.
+func (s cardinalitySearch) choose(head []*core.Member) *core.Member {
+	for _, member := range head {
+		if s.maiden[member.Name()] {
+			return member
+		}
+	}
+
+	return head[0]
+}
+
+// propose lets a proposer propose to a receiver, and returns the proposer
+// who is rejected as a result, if any. A rejected proposer is "released"
+// rather than rejected when they keep the receiver on their list.
+//
+// A receiver always rejects the proposer they like strictly less, unless
+// their current partner can be released: that is, the partner has a member
+// they like as much who has never been proposed to, and the receiver has not
+// rejected anyone they like more than the new proposer. Between tied
+// proposers, they reject one on their first pass in favour of one on their
+// second pass, or otherwise reject the current partner when they are
+// "uncertain", that is they have another member tied with the receiver left
+// to propose to.
+func (s cardinalitySearch) propose(proposer, receiver *core.Member) (*core.Member, bool) {
+	s.maiden[receiver.Name()] = false
+
+	current := s.partner[receiver.Name()]
+
+	if current == nil {
+		s.engage(proposer, receiver)
+		return nil, false
+	}
+
+	ranks := s.ranks[receiver.Name()]
+
+	accept, released := false, false
+
+	switch {
+	case ranks[proposer.Name()] < ranks[current.Name()]:
+		accept = true
+	case ranks[proposer.Name()] > ranks[current.Name()]:
+		best, ok := s.bestRejected[receiver.Name()]
+		released = s.hasMaiden(current) && (!ok || ranks[proposer.Name()] <= best)
+		accept = released
+	case s.promoted[proposer.Name()] != s.promoted[current.Name()]:
+		accept = s.promoted[proposer.Name()]
+	default:
+		accept = len(s.head(current)) > 1
+	}
+
+	if !accept {
+		return proposer, false
+	}
+
+	delete(s.partner, current.Name())
+	s.engage(proposer, receiver)
+
+	return current, released
+}
+
+// reject removes a receiver from the list of a proposer they rejected, for
+// the rest of the proposer's current pass through their preference list.
+func (s cardinalitySearch) reject(proposer, receiver *core.Member) {
+	if s.deleted[proposer.Name()] == nil {
+		s.deleted[proposer.Name()] = make(map[string]bool)
+	}
+
+	s.deleted[proposer.Name()][receiver.Name()] = true
+
+	rank := s.ranks[receiver.Name()][proposer.Name()]
+
+	if best, ok := s.bestRejected[receiver.Name()]; !ok || rank < best {
+		s.bestRejected[receiver.Name()] = rank
+	}
+}
+
+// hasMaiden indicates whether the members tied at the head of a proposer's
+// preference list include one who has never been proposed to.
+func (s cardinalitySearch) hasMaiden(proposer *core.Member) bool {
+	for _, member := range s.head(proposer) {
+		if s.maiden[member.Name()] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// engage marks a pair of members as partners
+func (s cardinalitySearch) engage(proposer, receiver *core.Member) {
+	s.partner[proposer.Name()] = receiver
+	s.partner[receiver.Name()] = proposer
+}
+
+// maximumAcceptableMatching returns the size of a maximum matching between
+// the members of a table and the members on their preference lists, ignoring
+// preferences. No weakly stable matching can be any larger.
+func maximumAcceptableMatching(pt *core.PreferenceTable) int {
+	e := make(engagements)
+
+	for _, member := range *pt {
+		for _, pref := range member.PreferenceList().Members() {
+			e.engage(member, pref)
+		}
+	}
+
+	return len(maximumMatching(pt, e)) / 2
+}
@@ -0,0 +1,168 @@
+package smp
+
+import (
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaximumCardinalityMatching(t *testing.T) {
+	t.Run("releases a partner to a member never proposed to", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(
+			&[]core.MatchPreference{
+				{Name: "A", RankedPreferences: [][]string{{"X", "Y"}}},
+				{Name: "B", Preferences: []string{"X"}},
+			},
+			&[]core.MatchPreference{
+				{Name: "X", Preferences: []string{"A", "B"}},
+				{Name: "Y", Preferences: []string{"A"}},
+			},
+		)
+
+		algoCtx := core.AlgorithmContext{
+			TableA:          &tables[0],
+			TableB:          &tables[1],
+			IncompleteLists: true,
+			Objective:       core.ObjectiveMaximumCardinality,
+		}
+
+		result, err := maximumCardinalityMatching(algoCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, core.MatchResult{
+			Mapping: map[string]string{
+				"A": "Y", "B": "X",
+				"Y": "A", "X": "B",
+			},
+			Costs:          map[core.Side]int{core.SideA: 2, core.SideB: 3},
+			Size:           2,
+			SizeUpperBound: 2,
+		}, result)
+	})
+
+	t.Run("proposers on their second pass win ties", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(
+			&[]core.MatchPreference{
+				{Name: "A", Preferences: []string{"X", "Y"}},
+				{Name: "B", Preferences: []string{"X"}},
+			},
+			&[]core.MatchPreference{
+				{Name: "X", RankedPreferences: [][]string{{"A", "B"}}},
+				{Name: "Y", Preferences: []string{"A"}},
+			},
+		)
+
+		algoCtx := core.AlgorithmContext{
+			TableA:          &tables[0],
+			TableB:          &tables[1],
+			IncompleteLists: true,
+			Objective:       core.ObjectiveMaximumCardinality,
+		}
+
+		result, err := maximumCardinalityMatching(algoCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, core.MatchResult{
+			Mapping: map[string]string{
+				"A": "Y", "B": "X",
+				"Y": "A", "X": "B",
+			},
+			Costs:          map[core.Side]int{core.SideA: 3, core.SideB: 2},
+			Size:           2,
+			SizeUpperBound: 2,
+		}, result)
+	})
+
+	t.Run("upper bound not reached", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(
+			&[]core.MatchPreference{
+				{Name: "A", Preferences: []string{"X"}},
+				{Name: "B", Preferences: []string{"X", "Y"}},
+			},
+			&[]core.MatchPreference{
+				{Name: "X", Preferences: []string{"B", "A"}},
+				{Name: "Y", Preferences: []string{"B"}},
+			},
+		)
+
+		algoCtx := core.AlgorithmContext{
+			TableA:          &tables[0],
+			TableB:          &tables[1],
+			IncompleteLists: true,
+			Objective:       core.ObjectiveMaximumCardinality,
+		}
+
+		result, err := maximumCardinalityMatching(algoCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, core.MatchResult{
+			Mapping: map[string]string{
+				"B": "X", "X": "B",
+			},
+			Unmatched:      []string{"A", "Y"},
+			Costs:          map[core.Side]int{core.SideA: 1, core.SideB: 1},
+			Size:           1,
+			SizeUpperBound: 2,
+		}, result)
+	})
+
+	t.Run("second table proposes", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(
+			&[]core.MatchPreference{
+				{Name: "X", Preferences: []string{"A", "B"}},
+				{Name: "Y", Preferences: []string{"A"}},
+			},
+			&[]core.MatchPreference{
+				{Name: "A", RankedPreferences: [][]string{{"X", "Y"}}},
+				{Name: "B", Preferences: []string{"X"}},
+			},
+		)
+
+		algoCtx := core.AlgorithmContext{
+			TableA:          &tables[0],
+			TableB:          &tables[1],
+			Proposer:        core.SideB,
+			IncompleteLists: true,
+			Objective:       core.ObjectiveMaximumCardinality,
+		}
+
+		result, err := maximumCardinalityMatching(algoCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, result.Size)
+		assert.Equal(t, map[core.Side]int{core.SideA: 3, core.SideB: 2}, result.Costs)
+	})
+
+	t.Run("requires weak stability", func(t *testing.T) {
+		prefsSet := objectiveTestPrefs()
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA:    &tables[0],
+			TableB:    &tables[1],
+			Stability: core.StabilityStrong,
+			Objective: core.ObjectiveMaximumCardinality,
+		}
+
+		_, err := maximumCardinalityMatching(algoCtx)
+
+		assert.Equal(t, "Only weakly stable matchings can be optimised for an objective", err.Error())
+	})
+}
+
+func TestMaximumAcceptableMatching(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "A", Preferences: []string{"X"}},
+			{Name: "B", Preferences: []string{"X", "Y"}},
+			{Name: "C", Preferences: []string{"X", "Y"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "X", Preferences: []string{"B", "A", "C"}},
+			{Name: "Y", Preferences: []string{"B", "C"}},
+		},
+	)
+
+	assert.Equal(t, 2, maximumAcceptableMatching(&tables[0]))
+}
@@ -0,0 +1,60 @@
+package smp
+
+import (
+	"sort"
+)
+
+// medianMatching finds the median stable matching, in which each member is
+// matched with their median partner across all stable matchings.
+//
+// Teo and Sethuraman (1998) showed that when each proposer's partners in all
+// `k` stable matchings are listed from best to worst, matching every proposer
+// with the `i`th partner on their list yields a stable matching for any `i`,
+// and every receiver is given the `i`th partner on their own list from worst
+// to best. The median matching uses `i = (k + 1) / 2`, which favours the
+// proposers when `k` is even.
+//
+// Counting stable matchings is #P-complete, so the matchings are enumerated
+// from the rotation poset. Only the number of times each pair is matched is
+// recorded, but the running time grows with the number of stable matchings.
+func (poset rotationPoset) medianMatching() map[string]string {
+	counts := make(map[string]map[string]int)
+	for p := range poset.base {
+		counts[p] = make(map[string]int)
+	}
+
+	total := 0
+	included := make([]bool, len(poset.rotations))
+
+	poset.enumerateClosedSets(0, included, poset.copyBase(), func(matching map[string]string) bool {
+		for p, r := range matching {
+			counts[p][r]++
+		}
+		total++
+
+		return true
+	})
+
+	median := make(map[string]string, len(counts))
+
+	for p := range counts {
+		partners := make([]string, 0, len(counts[p]))
+		for r := range counts[p] {
+			partners = append(partners, r)
+		}
+
+		sort.Slice(partners, func(i, j int) bool {
+			return poset.ranks[p][partners[i]] < poset.ranks[p][partners[j]]
+		})
+
+		seen := 0
+		for _, r := range partners {
+			if seen += counts[p][r]; seen >= (total+1)/2 {
+				median[p] = r
+				break
+			}
+		}
+	}
+
+	return median
+}
@@ -0,0 +1,65 @@
+package smp
+
+import (
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMedianMatching(t *testing.T) {
+	t.Run("odd number of stable matchings", func(t *testing.T) {
+		prefsA := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"D", "E", "F"}},
+			{Name: "B", Preferences: []string{"E", "F", "D"}},
+			{Name: "C", Preferences: []string{"F", "D", "E"}},
+		}
+		prefsB := []core.MatchPreference{
+			{Name: "D", Preferences: []string{"B", "C", "A"}},
+			{Name: "E", Preferences: []string{"C", "A", "B"}},
+			{Name: "F", Preferences: []string{"A", "B", "C"}},
+		}
+
+		tables := core.NewPreferenceTablePair(&prefsA, &prefsB)
+		poset := newRotationPoset(&tables[0], &tables[1])
+
+		assert.Equal(t, map[string]string{"A": "E", "B": "F", "C": "D"}, poset.medianMatching())
+	})
+
+	t.Run("even number of stable matchings favours proposers", func(t *testing.T) {
+		prefsA := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"C", "D"}},
+			{Name: "B", Preferences: []string{"D", "C"}},
+		}
+		prefsB := []core.MatchPreference{
+			{Name: "C", Preferences: []string{"B", "A"}},
+			{Name: "D", Preferences: []string{"A", "B"}},
+		}
+
+		tables := core.NewPreferenceTablePair(&prefsA, &prefsB)
+		poset := newRotationPoset(&tables[0], &tables[1])
+
+		assert.Equal(t, map[string]string{"A": "C", "B": "D"}, poset.medianMatching())
+	})
+
+	t.Run("independent rotations", func(t *testing.T) {
+		// Two independent rotations give four stable matchings
+		prefsA := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"E", "F", "G", "H"}},
+			{Name: "B", Preferences: []string{"F", "E", "G", "H"}},
+			{Name: "C", Preferences: []string{"G", "H", "E", "F"}},
+			{Name: "D", Preferences: []string{"H", "G", "E", "F"}},
+		}
+		prefsB := []core.MatchPreference{
+			{Name: "E", Preferences: []string{"B", "A", "C", "D"}},
+			{Name: "F", Preferences: []string{"A", "B", "C", "D"}},
+			{Name: "G", Preferences: []string{"D", "C", "A", "B"}},
+			{Name: "H", Preferences: []string{"C", "D", "A", "B"}},
+		}
+
+		tables := core.NewPreferenceTablePair(&prefsA, &prefsB)
+		poset := newRotationPoset(&tables[0], &tables[1])
+
+		assert.Equal(t, map[string]string{"A": "E", "B": "F", "C": "G", "D": "H"}, poset.medianMatching())
+	})
+}
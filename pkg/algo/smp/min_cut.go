@@ -0,0 +1,153 @@
+package smp
+
+// flowNetwork is a directed graph with integer edge capacities, used to find
+// a minimum cut.
+//
+// Each edge is stored next to its reverse edge, so that the reverse of edge
+// `e` is edge `e ^ 1`.
+type flowNetwork struct {
+	to       []int
+	capacity []int
+	adjacent [][]int
+}
+
+// newFlowNetwork returns a flow network with `n` vertices and no edges
+func newFlowNetwork(n int) *flowNetwork {
+	return &flowNetwork{adjacent: make([][]int, n)}
+}
+
+// addEdge adds an edge with the specified capacity between two vertices
+func (fn *flowNetwork) addEdge(from, to, capacity int) {
+	fn.adjacent[from] = append(fn.adjacent[from], len(fn.to))
+	fn.to = append(fn.to, to)
+	fn.capacity = append(fn.capacity, capacity)
+
+	fn.adjacent[to] = append(fn.adjacent[to], len(fn.to))
+	fn.to = append(fn.to, from)
+	fn.capacity = append(fn.capacity, 0)
+}
+
+// maxFlow pushes the maximum flow from `source` to `sink` using the
+// Edmonds-Karp algorithm, leaving the residual capacities in the network.
+func (fn *flowNetwork) maxFlow(source, sink int) int {
+	flow := 0
+
+	for true {
+		via := fn.augmentingPath(source, sink)
+		if via == nil {
+			break
+		}
+
+		// Find the bottleneck capacity of the path, then push flow along it
+		bottleneck := -1
+		for v := sink; v != source; v = fn.to[via[v]^1] {
+			if bottleneck == -1 || fn.capacity[via[v]] < bottleneck {
+				bottleneck = fn.capacity[via[v]]
+			}
+		}
+
+		for v := sink; v != source; v = fn.to[via[v]^1] {
+			fn.capacity[via[v]] -= bottleneck
+			fn.capacity[via[v]^1] += bottleneck
+		}
+
+		flow += bottleneck
+	}
+
+	return flow
+}
+
+// augmentingPath finds a shortest path with residual capacity from `source`
+// to `sink`. It returns the edge used to reach each vertex on the path, or
+// nil when no such path exists.
+func (fn *flowNetwork) augmentingPath(source, sink int) []int {
+	via := make([]int, len(fn.adjacent))
+	for i := range via {
+		via[i] = -1
+	}
+
+	queue := []int{source}
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+
+		for _, e := range fn.adjacent[v] {
+			w := fn.to[e]
+
+			if fn.capacity[e] == 0 || w == source || via[w] != -1 {
+				continue
+			}
+
+			via[w] = e
+			if w == sink {
+				return via
+			}
+
+			queue = append(queue, w)
+		}
+	}
+
+	return nil
+}
+
+// reachable returns the vertices that can be reached from `source` through
+// edges with residual capacity. After `maxFlow`, these form the source side
+// of a minimum cut.
+func (fn *flowNetwork) reachable(source int) []bool {
+	seen := make([]bool, len(fn.adjacent))
+	seen[source] = true
+
+	stack := []int{source}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		for _, e := range fn.adjacent[v] {
+			if w := fn.to[e]; fn.capacity[e] > 0 && !seen[w] {
+				seen[w] = true
+				stack = append(stack, w)
+			}
+		}
+	}
+
+	return seen
+}
+
+// minimumWeightClosure finds a subset of items with minimum total weight that
+// contains all predecessors of each of its items (Picard, 1976).
+//
+// Each item with negative weight is joined to a source, and each item with
+// positive weight to a sink, by an edge with the absolute value of its weight
+// as capacity. Each item is joined to its predecessors by an edge that can not
+// be cut. The source side of a minimum cut is then a minimum weight closure.
+func minimumWeightClosure(weights []int, predecessors [][]int) []bool {
+	n := len(weights)
+	source, sink := n, n+1
+
+	infinite := 1
+	for i := range weights {
+		if weights[i] < 0 {
+			infinite -= weights[i]
+		} else {
+			infinite += weights[i]
+		}
+	}
+
+	fn := newFlowNetwork(n + 2)
+
+	for i := range weights {
+		if weights[i] < 0 {
+			fn.addEdge(source, i, -weights[i])
+		} else if weights[i] > 0 {
+			fn.addEdge(i, sink, weights[i])
+		}
+
+		for _, pred := range predecessors[i] {
+			fn.addEdge(i, pred, infinite)
+		}
+	}
+
+	fn.maxFlow(source, sink)
+
+	return fn.reachable(source)[:n]
+}
@@ -0,0 +1,42 @@
+package smp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlowNetworkMaxFlow(t *testing.T) {
+	fn := newFlowNetwork(4)
+	fn.addEdge(0, 1, 3)
+	fn.addEdge(0, 2, 2)
+	fn.addEdge(1, 2, 1)
+	fn.addEdge(1, 3, 1)
+	fn.addEdge(2, 3, 4)
+
+	assert.Equal(t, 4, fn.maxFlow(0, 3))
+	assert.Equal(t, []bool{true, true, false, false}, fn.reachable(0))
+}
+
+func TestMinimumWeightClosure(t *testing.T) {
+	testCases := []struct {
+		title        string
+		weights      []int
+		predecessors [][]int
+		wanted       []bool
+	}{
+		{"no items", []int{}, [][]int{}, []bool{}},
+		{"independent items", []int{-3, 2, 0}, [][]int{nil, nil, nil}, []bool{true, false, false}},
+		{"predecessor is worth including", []int{2, -5}, [][]int{nil, {0}}, []bool{true, true}},
+		{"predecessor is too costly", []int{4, -3}, [][]int{nil, {0}}, []bool{false, false}},
+		{"shared predecessor", []int{5, -3, -3}, [][]int{nil, {0}, {0}}, []bool{true, true, true}},
+	}
+
+	for tc := range testCases {
+		t.Run(testCases[tc].title, func(t *testing.T) {
+			closure := minimumWeightClosure(testCases[tc].weights, testCases[tc].predecessors)
+
+			assert.Equal(t, testCases[tc].wanted, closure)
+		})
+	}
+}
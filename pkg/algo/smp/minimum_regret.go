@@ -0,0 +1,100 @@
+package smp
+
+// minimumRegretMatching implements Gusfield's (1987) algorithm to find the
+// stable matching that minimises the worst rank that any member gives their
+// partner, known as the matching's "regret".
+//
+// Starting from the proposer-optimal matching, the proposers can only get
+// worse partners and the receivers better ones. While the regret of the
+// current matching is only suffered by receivers, every matching with lower
+// regret must eliminate the rotations that move those receivers to a better
+// partner, so they are eliminated along with their predecessors. Once a
+// proposer suffers the regret (or no such rotation exists) it can not be
+// lowered any further, and the best matching seen so far is returned.
+func (poset rotationPoset) minimumRegretMatching() map[string]string {
+	ranks := poset.originalRanks
+
+	// Each pair of members appears as an initial pair in at most one rotation
+	rotationOf := make(map[[2]string]int)
+	for i, r := range poset.rotations {
+		for j := range r.proposers {
+			rotationOf[[2]string{r.proposers[j], r.receivers[j]}] = i
+		}
+	}
+
+	included := make([]bool, len(poset.rotations))
+	matching := poset.copyBase()
+
+	var best map[string]string
+	bestRegret := -1
+
+	for true {
+		regret := matchingRegret(matching, ranks)
+		if best == nil || regret < bestRegret {
+			best = copyMatching(matching)
+			bestRegret = regret
+		}
+
+		var toEliminate []int
+		improvable := true
+
+		for p, r := range matching {
+			if ranks[p][r] == regret {
+				improvable = false
+				break
+			}
+
+			if ranks[r][p] == regret {
+				idx, ok := rotationOf[[2]string{p, r}]
+				if !ok {
+					improvable = false
+					break
+				}
+
+				toEliminate = append(toEliminate, idx)
+			}
+		}
+
+		if !improvable {
+			break
+		}
+
+		for _, idx := range toEliminate {
+			poset.eliminateWithPredecessors(idx, included, matching)
+		}
+	}
+
+	return best
+}
+
+// matchingRegret returns the worst rank that any member of a matching gives
+// their partner
+func matchingRegret(matching map[string]string, ranks map[string]map[string]int) int {
+	regret := 0
+
+	for p, r := range matching {
+		if ranks[p][r] > regret {
+			regret = ranks[p][r]
+		}
+		if ranks[r][p] > regret {
+			regret = ranks[r][p]
+		}
+	}
+
+	return regret
+}
+
+// eliminateWithPredecessors eliminates a rotation from a matching, along with
+// every predecessor that has not been eliminated yet.
+func (poset rotationPoset) eliminateWithPredecessors(idx int, included []bool, matching map[string]string) {
+	if included[idx] {
+		return
+	}
+
+	for _, pred := range poset.predecessors[idx] {
+		poset.eliminateWithPredecessors(pred, included, matching)
+	}
+
+	included[idx] = true
+	eliminate(matching, poset.rotations[idx])
+}
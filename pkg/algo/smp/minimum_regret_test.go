@@ -0,0 +1,72 @@
+package smp
+
+import (
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMinimumRegretMatching(t *testing.T) {
+	prefsA := []core.MatchPreference{
+		{Name: "A", Preferences: []string{"G", "F", "H", "E"}},
+		{Name: "B", Preferences: []string{"G", "H", "E", "F"}},
+		{Name: "C", Preferences: []string{"E", "F", "H", "G"}},
+		{Name: "D", Preferences: []string{"G", "H", "E", "F"}},
+	}
+	prefsB := []core.MatchPreference{
+		{Name: "E", Preferences: []string{"B", "A", "D", "C"}},
+		{Name: "F", Preferences: []string{"A", "B", "D", "C"}},
+		{Name: "G", Preferences: []string{"C", "B", "D", "A"}},
+		{Name: "H", Preferences: []string{"B", "A", "C", "D"}},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(&prefsA, &prefsB)
+		poset := newRotationPoset(&tables[0], &tables[1])
+
+		// The proposer-optimal and receiver-optimal matchings both have a regret
+		// of 3
+		matching := poset.minimumRegretMatching()
+
+		assert.Equal(t, map[string]string{"A": "F", "B": "G", "C": "H", "D": "E"}, matching)
+		assert.Equal(t, 2, matchingRegret(matching, poset.originalRanks))
+	})
+
+	t.Run("second table proposes", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(&prefsA, &prefsB)
+		poset := newRotationPoset(&tables[1], &tables[0])
+
+		matching := poset.minimumRegretMatching()
+
+		assert.Equal(t, map[string]string{"F": "A", "G": "B", "H": "C", "E": "D"}, matching)
+	})
+
+	t.Run("single stable matching", func(t *testing.T) {
+		prefsA := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"D", "C"}},
+			{Name: "B", Preferences: []string{"D", "C"}},
+		}
+		prefsB := []core.MatchPreference{
+			{Name: "C", Preferences: []string{"A", "B"}},
+			{Name: "D", Preferences: []string{"A", "B"}},
+		}
+
+		tables := core.NewPreferenceTablePair(&prefsA, &prefsB)
+		poset := newRotationPoset(&tables[0], &tables[1])
+
+		assert.Equal(t, map[string]string{"A": "D", "B": "C"}, poset.minimumRegretMatching())
+	})
+}
+
+func TestMatchingRegret(t *testing.T) {
+	ranks := map[string]map[string]int{
+		"A": {"C": 1, "D": 0},
+		"B": {"C": 0, "D": 1},
+		"C": {"A": 1, "B": 0},
+		"D": {"A": 0, "B": 1},
+	}
+
+	assert.Equal(t, 1, matchingRegret(map[string]string{"A": "C", "B": "D"}, ranks))
+	assert.Equal(t, 0, matchingRegret(map[string]string{"A": "D", "B": "C"}, ranks))
+}
@@ -0,0 +1,121 @@
+package smp
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// optimalMatching finds the stable matching that is best according to the
+// objective of the algorithm context.
+//
+// See `minimumCostMatching`, `minimumRegretMatching`, `balancedMatching` and
+// `medianMatching` for the algorithms used by each objective.
+func optimalMatching(algoCtx core.AlgorithmContext) (core.MatchResult, error) {
+	var res core.MatchResult
+
+	if algoCtx.Stability != "" && algoCtx.Stability != core.StabilityWeak {
+		return res, errors.New("Only weakly stable matchings can be optimised for an objective")
+	}
+
+	switch algoCtx.Objective {
+	case core.ObjectiveEgalitarian, core.ObjectiveMinimumRegret, core.ObjectiveSexEqual, core.ObjectiveBalanced, core.ObjectiveMedian:
+	case core.ObjectiveMinimumCost:
+		if algoCtx.PairCost == nil {
+			return res, errors.New("A pair cost is required for the minimum-cost objective")
+		}
+	default:
+		return res, errors.New(fmt.Sprintf("Unknown objective: %v", algoCtx.Objective))
+	}
+
+	poset := newRotationPosetFromContext(algoCtx)
+
+	var matching map[string]string
+
+	switch algoCtx.Objective {
+	case core.ObjectiveEgalitarian:
+		matching = poset.minimumCostMatching(egalitarianCost(poset.originalRanks))
+	case core.ObjectiveMinimumCost:
+		matching = poset.minimumCostMatching(poset.proposerCost(algoCtx.PairCost))
+	case core.ObjectiveMinimumRegret:
+		matching = poset.minimumRegretMatching()
+	case core.ObjectiveSexEqual:
+		matching = poset.balancedMatching(sexEqualScore)
+	case core.ObjectiveBalanced:
+		matching = poset.balancedMatching(balancedScore)
+	case core.ObjectiveMedian:
+		matching = poset.medianMatching()
+	}
+
+	return poset.result(matching), nil
+}
+
+// minimumCostMatching finds the stable matching that minimises the total cost
+// of its pairs.
+//
+// Eliminating a rotation changes the cost of a matching by a fixed amount, the
+// rotation's weight. The optimal matching therefore corresponds to the closed
+// set of rotations with minimum total weight, which is found with a minimum
+// cut rather than by enumerating every stable matching (Irving, Leather and
+// Gusfield, 1987).
+func (poset rotationPoset) minimumCostMatching(cost func(proposer, receiver string) int) map[string]string {
+	closure := minimumWeightClosure(poset.rotationWeights(cost), poset.predecessors)
+
+	return poset.closureMatching(closure)
+}
+
+// proposerCost adapts a pair cost, which always takes a member of the first
+// table first, to take a proposer first.
+func (poset rotationPoset) proposerCost(cost core.PairCost) func(proposer, receiver string) int {
+	if poset.proposerSide == core.SideB {
+		return func(proposer, receiver string) int {
+			return cost(receiver, proposer)
+		}
+	}
+
+	return cost
+}
+
+// egalitarianCost returns a pair cost equal to the sum of the ranks that both
+// members of a pair give each other.
+func egalitarianCost(ranks map[string]map[string]int) func(proposer, receiver string) int {
+	return func(a, b string) int {
+		return ranks[a][b] + ranks[b][a]
+	}
+}
+
+// originalRanks maps each member of both tables to the rank of every member on
+// their preference list. Tied members share a rank.
+func originalRanks(ptA, ptB *core.PreferenceTable) map[string]map[string]int {
+	ranks := make(map[string]map[string]int)
+
+	for _, pt := range []*core.PreferenceTable{ptA, ptB} {
+		for name, member := range *pt {
+			pl := member.PreferenceList()
+			prefs := pl.Members()
+			ranks[name] = make(map[string]int, len(prefs))
+
+			for i := range prefs {
+				ranks[name][prefs[i].Name()] = pl.RankOf(*prefs[i])
+			}
+		}
+	}
+
+	return ranks
+}
+
+// rotationWeights returns the change in the total cost of a matching caused
+// by eliminating each rotation.
+func (poset rotationPoset) rotationWeights(cost func(proposer, receiver string) int) []int {
+	weights := make([]int, len(poset.rotations))
+
+	for i, r := range poset.rotations {
+		for j := range r.proposers {
+			weights[i] += cost(r.proposers[j], r.receivers[(j+1)%len(r.receivers)])
+			weights[i] -= cost(r.proposers[j], r.receivers[j])
+		}
+	}
+
+	return weights
+}
@@ -0,0 +1,226 @@
+package smp
+
+import (
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func objectiveTestPrefs() []*[]core.MatchPreference {
+	return []*[]core.MatchPreference{
+		{
+			{Name: "A", Preferences: []string{"G", "E", "F", "H"}},
+			{Name: "B", Preferences: []string{"F", "G", "H", "E"}},
+			{Name: "C", Preferences: []string{"H", "F", "G", "E"}},
+			{Name: "D", Preferences: []string{"G", "H", "E", "F"}},
+		},
+		{
+			{Name: "E", Preferences: []string{"C", "B", "A", "D"}},
+			{Name: "F", Preferences: []string{"C", "D", "B", "A"}},
+			{Name: "G", Preferences: []string{"D", "B", "C", "A"}},
+			{Name: "H", Preferences: []string{"A", "B", "D", "C"}},
+		},
+	}
+}
+
+func TestOptimalMatching(t *testing.T) {
+	t.Run("egalitarian", func(t *testing.T) {
+		prefsSet := objectiveTestPrefs()
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA:    &tables[0],
+			TableB:    &tables[1],
+			Objective: core.ObjectiveEgalitarian,
+		}
+
+		result, err := optimalMatching(algoCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, core.MatchResult{
+			Mapping: map[string]string{
+				"A": "E", "B": "H", "C": "F", "D": "G",
+				"E": "A", "H": "B", "F": "C", "G": "D",
+			},
+			Costs: map[core.Side]int{core.SideA: 8, core.SideB: 7},
+		}, result)
+	})
+
+	t.Run("egalitarian when second table proposes", func(t *testing.T) {
+		prefsSet := objectiveTestPrefs()
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA:    &tables[0],
+			TableB:    &tables[1],
+			Proposer:  core.SideB,
+			Objective: core.ObjectiveEgalitarian,
+		}
+
+		result, err := optimalMatching(algoCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{
+			"A": "E", "B": "H", "C": "F", "D": "G",
+			"E": "A", "H": "B", "F": "C", "G": "D",
+		}, result.Mapping)
+	})
+
+	t.Run("minimum cost", func(t *testing.T) {
+		prefsSet := objectiveTestPrefs()
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		// Only the receiving side's happiness matters
+		cost := func(a, b string) int {
+			for i, name := range (*prefsSet[1])[b[0]-'E'].Preferences {
+				if name == a {
+					return i
+				}
+			}
+			return 0
+		}
+
+		algoCtx := core.AlgorithmContext{
+			TableA:    &tables[0],
+			TableB:    &tables[1],
+			Objective: core.ObjectiveMinimumCost,
+			PairCost:  cost,
+		}
+
+		result, err := optimalMatching(algoCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{
+			"A": "H", "B": "E", "C": "F", "D": "G",
+			"H": "A", "E": "B", "F": "C", "G": "D",
+		}, result.Mapping)
+	})
+
+	t.Run("sex-equal", func(t *testing.T) {
+		prefsSet := objectiveTestPrefs()
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA:    &tables[0],
+			TableB:    &tables[1],
+			Objective: core.ObjectiveSexEqual,
+		}
+
+		result, err := optimalMatching(algoCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, map[core.Side]int{core.SideA: 8, core.SideB: 7}, result.Costs)
+	})
+
+	t.Run("balanced when second table proposes", func(t *testing.T) {
+		prefsSet := objectiveTestPrefs()
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA:    &tables[0],
+			TableB:    &tables[1],
+			Proposer:  core.SideB,
+			Objective: core.ObjectiveBalanced,
+		}
+
+		result, err := optimalMatching(algoCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, map[core.Side]int{core.SideA: 8, core.SideB: 7}, result.Costs)
+	})
+
+	t.Run("median", func(t *testing.T) {
+		prefsSet := objectiveTestPrefs()
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA:    &tables[0],
+			TableB:    &tables[1],
+			Objective: core.ObjectiveMedian,
+		}
+
+		result, err := optimalMatching(algoCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{
+			"A": "E", "B": "H", "C": "F", "D": "G",
+			"E": "A", "H": "B", "F": "C", "G": "D",
+		}, result.Mapping)
+	})
+
+	t.Run("minimum cost requires a pair cost", func(t *testing.T) {
+		prefsSet := objectiveTestPrefs()
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA:    &tables[0],
+			TableB:    &tables[1],
+			Objective: core.ObjectiveMinimumCost,
+		}
+
+		_, err := optimalMatching(algoCtx)
+
+		assert.Equal(t, "A pair cost is required for the minimum-cost objective", err.Error())
+	})
+
+	t.Run("unknown objective", func(t *testing.T) {
+		prefsSet := objectiveTestPrefs()
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA:    &tables[0],
+			TableB:    &tables[1],
+			Objective: core.Objective("INVALID"),
+		}
+
+		_, err := optimalMatching(algoCtx)
+
+		assert.Equal(t, "Unknown objective: INVALID", err.Error())
+	})
+
+	t.Run("strong stability is not supported", func(t *testing.T) {
+		prefsSet := objectiveTestPrefs()
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA:    &tables[0],
+			TableB:    &tables[1],
+			Objective: core.ObjectiveEgalitarian,
+			Stability: core.StabilityStrong,
+		}
+
+		_, err := optimalMatching(algoCtx)
+
+		assert.Equal(t, "Only weakly stable matchings can be optimised for an objective", err.Error())
+	})
+}
+
+func TestEgalitarianCost(t *testing.T) {
+	prefsA := []core.MatchPreference{
+		{Name: "A", RankedPreferences: [][]string{{"C", "D"}}, Preferences: []string{"C", "D"}},
+		{Name: "B", Preferences: []string{"D", "C"}},
+	}
+	prefsB := []core.MatchPreference{
+		{Name: "C", Preferences: []string{"B", "A"}},
+		{Name: "D", Preferences: []string{"A", "B"}},
+	}
+
+	tables := core.NewPreferenceTablePair(&prefsA, &prefsB)
+	cost := egalitarianCost(originalRanks(&tables[0], &tables[1]))
+
+	assert.Equal(t, 1, cost("A", "C"))
+	assert.Equal(t, 0, cost("A", "D"))
+	assert.Equal(t, 1, cost("B", "C"))
+	assert.Equal(t, 1, cost("B", "D"))
+}
+
+func TestRotationWeights(t *testing.T) {
+	prefsSet := objectiveTestPrefs()
+	tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+	cost := egalitarianCost(originalRanks(&tables[0], &tables[1]))
+
+	poset := newRotationPoset(&tables[0], &tables[1])
+
+	assert.Equal(t, []int{-1, 1}, poset.rotationWeights(cost))
+}
@@ -9,24 +9,42 @@ import (
 //
 // Each unmatched member "proposes" to their top remaining preference and each
 // member that receives a proposal can accept or reject the incoming proposal.
+// Members that have exhausted their preference list stop proposing and remain
+// unmatched.
 //
 // See smp package documentation for more detail
 func phase1Proposal(ptA, ptB *core.PreferenceTable) {
 	for true {
-		unmatchedMembers := ptA.UnmatchedMembers()
+		proposers := unmatchedProposers(ptA)
 
-		if len(unmatchedMembers) == 0 {
+		if len(proposers) == 0 {
 			break
 		}
 
-		for i := range unmatchedMembers {
-			member := unmatchedMembers[i]
+		for i := range proposers {
+			member := proposers[i]
 			topChoice := member.FirstPreference()
 			simulateProposal(member, topChoice)
 		}
 	}
 }
 
+// unmatchedProposers returns all unmatched members that have not yet exhausted
+// their preference list, and can therefore still make a proposal.
+func unmatchedProposers(pt *core.PreferenceTable) []*core.Member {
+	var proposers []*core.Member
+
+	unmatchedMembers := pt.UnmatchedMembers()
+
+	for i := range unmatchedMembers {
+		if unmatchedMembers[i].FirstPreference() != nil {
+			proposers = append(proposers, unmatchedMembers[i])
+		}
+	}
+
+	return proposers
+}
+
 // simulateProposal simulates a proposal between two members
 func simulateProposal(proposer, proposed *core.Member) {
 	if !proposed.HasAcceptedProposal() {
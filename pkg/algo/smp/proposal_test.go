@@ -118,6 +118,62 @@ func TestPhase1Proposal(t *testing.T) {
 	})
 }
 
+func TestPhase1Proposal__IncompleteLists(t *testing.T) {
+	actualPrefs := []*[]core.MatchPreference{
+		{
+			{Name: "A", Preferences: []string{"K", "L"}},
+			{Name: "B", Preferences: []string{"K"}},
+			{Name: "C", Preferences: []string{"K"}},
+		},
+		{
+			{Name: "K", Preferences: []string{"C", "A", "B"}},
+			{Name: "L", Preferences: []string{"A", "B"}},
+		},
+	}
+
+	wantedPrefs := []*[]core.MatchPreference{
+		{
+			{Name: "A", Preferences: []string{"L"}},
+			{Name: "B", Preferences: []string{}},
+			{Name: "C", Preferences: []string{"K"}},
+		},
+		{
+			{Name: "K", Preferences: []string{"C"}},
+			{Name: "L", Preferences: []string{"A", "B"}},
+		},
+	}
+
+	actualTables := core.NewPreferenceTablePair(actualPrefs[0], actualPrefs[1])
+	wantedTables := core.NewPreferenceTablePair(wantedPrefs[0], wantedPrefs[1])
+
+	wantedTables[0]["A"].AcceptMutually(wantedTables[1]["L"])
+	wantedTables[0]["C"].AcceptMutually(wantedTables[1]["K"])
+
+	// 'B' exhausts its preference list and the algorithm terminates with 'B'
+	// left unmatched
+	phase1Proposal(&actualTables[0], &actualTables[1])
+	assert.True(t, reflect.DeepEqual(wantedTables[0], actualTables[0]))
+	assert.True(t, reflect.DeepEqual(wantedTables[1], actualTables[1]))
+}
+
+func TestUnmatchedProposers(t *testing.T) {
+	prefs := []*[]core.MatchPreference{
+		{
+			{Name: "A", Preferences: []string{"K"}},
+			{Name: "B", Preferences: []string{}},
+			{Name: "C", Preferences: []string{"K"}},
+		},
+		{
+			{Name: "K", Preferences: []string{"A", "C"}},
+		},
+	}
+
+	tables := core.NewPreferenceTablePair(prefs[0], prefs[1])
+	tables[0]["A"].AcceptMutually(tables[1]["K"])
+
+	assert.Equal(t, []*core.Member{tables[0]["C"]}, unmatchedProposers(&tables[0]))
+}
+
 func TestSimulateProposal(t *testing.T) {
 	t.Run("proposed has no accepted proposal", func(t *testing.T) {
 		actualPrefs := []*[]core.MatchPreference{
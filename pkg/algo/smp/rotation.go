@@ -0,0 +1,367 @@
+package smp
+
+import (
+	"sort"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// rotation is a cyclic sequence of pairs in a stable matching. Eliminating it
+// moves each proposer to the receiver matched with the next proposer in the
+// sequence, which yields another stable matching.
+//
+// `proposers[i]` is matched with `receivers[i]` before the rotation is
+// eliminated, and with `receivers[(i+1) % len(receivers)]` afterwards.
+type rotation struct {
+	proposers []string
+	receivers []string
+}
+
+// rotationPoset models the set of all stable matchings of an SMP instance as
+// described by Gusfield and Irving (1989).
+//
+// Every stable matching corresponds to a closed subset of rotations, that is
+// a subset that contains all predecessors of each of its rotations. The
+// matching is obtained by eliminating those rotations from the
+// proposer-optimal matching in topological order.
+type rotationPoset struct {
+	// rotations are listed in a topological order of the poset
+	rotations []rotation
+
+	// predecessors lists, for each rotation, the indices of rotations that
+	// must be eliminated before it. The transitive closure of this relation is
+	// the rotation poset.
+	predecessors [][]int
+
+	// base is the proposer-optimal matching, keyed by proposer
+	base map[string]string
+
+	// unmatched lists the members that are unmatched in every stable matching
+	unmatched []string
+
+	// ranks maps each member to the position of every other member on its
+	// (tie-free) preference list
+	ranks map[string]map[string]int
+
+	// preferences lists each proposer's preference list by name
+	preferences map[string][]string
+
+	// proposerSide is the side of the proposers
+	proposerSide core.Side
+
+	// originalRanks maps each member to the rank of every other member on
+	// their original preference list, before ties are broken. Tied members
+	// share a rank.
+	originalRanks map[string]map[string]int
+}
+
+// newRotationPoset computes the rotation poset of a pair of preference tables
+// without ties.
+//
+// The proposer-optimal matching is found with the Gale-Shapley algorithm.
+// Starting from it, exposed rotations are eliminated one at a time until the
+// receiver-optimal matching is reached. Every rotation is eliminated exactly
+// once on such a path, and the order of elimination is a topological order of
+// the poset.
+//
+// Note that the Gale-Shapley algorithm reduces the preference lists of both
+// tables.
+func newRotationPoset(proposers, receivers *core.PreferenceTable) rotationPoset {
+	poset := rotationPoset{
+		base:          make(map[string]string),
+		ranks:         make(map[string]map[string]int),
+		preferences:   make(map[string][]string),
+		proposerSide:  core.SideA,
+		originalRanks: originalRanks(proposers, receivers),
+	}
+
+	for _, pt := range []*core.PreferenceTable{proposers, receivers} {
+		for name, member := range *pt {
+			prefs := member.PreferenceList().Members()
+			poset.ranks[name] = make(map[string]int, len(prefs))
+
+			for i := range prefs {
+				poset.ranks[name][prefs[i].Name()] = i
+			}
+		}
+	}
+
+	for name, member := range *proposers {
+		prefs := member.PreferenceList().Members()
+		poset.preferences[name] = make([]string, len(prefs))
+
+		for i := range prefs {
+			poset.preferences[name][i] = prefs[i].Name()
+		}
+	}
+
+	phase1Proposal(proposers, receivers)
+
+	for _, pt := range []*core.PreferenceTable{proposers, receivers} {
+		for name, member := range *pt {
+			if member.CurrentProposer() == nil {
+				poset.unmatched = append(poset.unmatched, name)
+			}
+		}
+	}
+
+	sort.Strings(poset.unmatched)
+
+	for name, member := range *proposers {
+		if member.CurrentProposer() != nil {
+			poset.base[name] = member.CurrentProposer().Name()
+		}
+	}
+
+	poset.findRotations()
+	poset.findPredecessors()
+
+	return poset
+}
+
+// findRotations eliminates exposed rotations, starting from the
+// proposer-optimal matching, until none remain.
+func (poset *rotationPoset) findRotations() {
+	matching := poset.copyBase()
+
+	for true {
+		r, ok := poset.exposedRotation(matching)
+		if !ok {
+			break
+		}
+
+		poset.rotations = append(poset.rotations, r)
+		eliminate(matching, r)
+	}
+}
+
+// exposedRotation finds a rotation exposed in a stable matching.
+//
+// For each matched proposer `p`, let `s(p)` be the first receiver after p's
+// partner on p's preference list who prefers p to their own partner, and let
+// `next(p)` be the partner of `s(p)`. Every cycle of `next` is an exposed
+// rotation.
+func (poset rotationPoset) exposedRotation(matching map[string]string) (rotation, bool) {
+	inverse := make(map[string]string, len(matching))
+	for p, r := range matching {
+		inverse[r] = p
+	}
+
+	next := make(map[string]string)
+	for p, partner := range matching {
+		prefs := poset.preferences[p]
+
+		for i := poset.ranks[p][partner] + 1; i < len(prefs); i++ {
+			r := prefs[i]
+			current, ok := inverse[r]
+
+			if !ok {
+				break
+			}
+
+			if poset.ranks[r][p] < poset.ranks[r][current] {
+				next[p] = current
+				break
+			}
+		}
+	}
+
+	// Find a cycle by following `next` from each proposer in turn. A proposer
+	// is marked as visited by the walk it was first reached from.
+	visitedBy := make(map[string]int)
+	names := make([]string, 0, len(matching))
+	for p := range matching {
+		names = append(names, p)
+	}
+	sort.Strings(names)
+
+	for walk, start := range names {
+		p := start
+
+		for true {
+			if _, seen := visitedBy[p]; seen {
+				break
+			}
+			visitedBy[p] = walk
+
+			n, ok := next[p]
+			if !ok {
+				break
+			}
+			p = n
+		}
+
+		if visitedBy[p] != walk || next[p] == "" {
+			continue
+		}
+
+		// `p` lies on a cycle found by this walk
+		r := rotation{}
+		for q := p; ; {
+			r.proposers = append(r.proposers, q)
+			r.receivers = append(r.receivers, matching[q])
+
+			if q = next[q]; q == p {
+				break
+			}
+		}
+
+		return r, true
+	}
+
+	return rotation{}, false
+}
+
+// findPredecessors computes the direct predecessors of each rotation using
+// the two rules described by Gusfield and Irving (1989):
+//
+// 1. The rotation that last moved a proposer precedes the next rotation that
+// moves that proposer.
+//
+// 2. When a rotation moves proposer `p` past receiver `r` on p's preference
+// list, the rotation that moves `r` to a partner that r prefers to `p`
+// precedes it.
+func (poset *rotationPoset) findPredecessors() {
+	// Record each receiver's sequence of partners, along with the rotation
+	// that matched them.
+	type change struct {
+		partner  string
+		rotation int
+	}
+
+	history := make(map[string][]change)
+	for p, r := range poset.base {
+		history[r] = []change{{partner: p, rotation: -1}}
+	}
+
+	for i, rot := range poset.rotations {
+		for j := range rot.proposers {
+			r := rot.receivers[(j+1)%len(rot.receivers)]
+			history[r] = append(history[r], change{partner: rot.proposers[j], rotation: i})
+		}
+	}
+
+	poset.predecessors = make([][]int, len(poset.rotations))
+	lastMoved := make(map[string]int)
+
+	for i, rot := range poset.rotations {
+		preds := make(map[int]bool)
+
+		for j, p := range rot.proposers {
+			if last, ok := lastMoved[p]; ok {
+				preds[last] = true
+			}
+			lastMoved[p] = i
+
+			from := poset.ranks[p][rot.receivers[j]]
+			to := poset.ranks[p][rot.receivers[(j+1)%len(rot.receivers)]]
+
+			for k := from + 1; k < to; k++ {
+				r := poset.preferences[p][k]
+
+				for _, c := range history[r] {
+					if poset.ranks[r][c.partner] < poset.ranks[r][p] {
+						if c.rotation >= 0 {
+							preds[c.rotation] = true
+						}
+						break
+					}
+				}
+			}
+		}
+
+		for pred := range preds {
+			poset.predecessors[i] = append(poset.predecessors[i], pred)
+		}
+		sort.Ints(poset.predecessors[i])
+	}
+}
+
+// copyBase returns a copy of the proposer-optimal matching
+func (poset rotationPoset) copyBase() map[string]string {
+	return copyMatching(poset.base)
+}
+
+// copyMatching returns a copy of a matching
+func copyMatching(matching map[string]string) map[string]string {
+	c := make(map[string]string, len(matching))
+
+	for p, r := range matching {
+		c[p] = r
+	}
+
+	return c
+}
+
+// eliminate eliminates a rotation from a matching, keyed by proposer
+func eliminate(matching map[string]string, r rotation) {
+	for i := range r.proposers {
+		matching[r.proposers[i]] = r.receivers[(i+1)%len(r.receivers)]
+	}
+}
+
+// closureMatching returns the matching obtained by eliminating a closed set of
+// rotations from the proposer-optimal matching.
+func (poset rotationPoset) closureMatching(closure []bool) map[string]string {
+	// Rotations are listed in topological order, so each rotation's
+	// predecessors are always eliminated before it.
+	matching := poset.copyBase()
+
+	for i := range poset.rotations {
+		if closure[i] {
+			eliminate(matching, poset.rotations[i])
+		}
+	}
+
+	return matching
+}
+
+// restore reverses the elimination of a rotation from a matching
+func restore(matching map[string]string, r rotation) {
+	for i := range r.proposers {
+		matching[r.proposers[i]] = r.receivers[i]
+	}
+}
+
+// result constructs a Match Result from a matching, keyed by proposer
+func (poset rotationPoset) result(matching map[string]string) core.MatchResult {
+	res := core.MatchResult{
+		Mapping: make(map[string]string, 2*len(matching)),
+	}
+
+	for p, r := range matching {
+		res.Mapping[p] = r
+		res.Mapping[r] = p
+	}
+
+	if len(poset.unmatched) > 0 {
+		res.Unmatched = append([]string{}, poset.unmatched...)
+	}
+
+	receiverSide := core.SideB
+	if poset.proposerSide == core.SideB {
+		receiverSide = core.SideA
+	}
+
+	proposerCost, receiverCost := poset.sideCosts(matching)
+	res.Costs = map[core.Side]int{
+		poset.proposerSide: proposerCost,
+		receiverSide:       receiverCost,
+	}
+
+	return res
+}
+
+// sideCosts returns the total rank cost of the proposers and of the receivers
+// in a matching. Each matched member adds the rank of their partner on their
+// original preference list, starting at 1 for their first choice.
+func (poset rotationPoset) sideCosts(matching map[string]string) (int, int) {
+	proposerCost, receiverCost := 0, 0
+
+	for p, r := range matching {
+		proposerCost += poset.originalRanks[p][r] + 1
+		receiverCost += poset.originalRanks[r][p] + 1
+	}
+
+	return proposerCost, receiverCost
+}
@@ -0,0 +1,75 @@
+package smp
+
+import (
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRotationPoset(t *testing.T) {
+	t.Run("chain of rotations", func(t *testing.T) {
+		prefsA := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"D", "E", "F"}},
+			{Name: "B", Preferences: []string{"E", "F", "D"}},
+			{Name: "C", Preferences: []string{"F", "D", "E"}},
+		}
+		prefsB := []core.MatchPreference{
+			{Name: "D", Preferences: []string{"B", "C", "A"}},
+			{Name: "E", Preferences: []string{"C", "A", "B"}},
+			{Name: "F", Preferences: []string{"A", "B", "C"}},
+		}
+
+		tables := core.NewPreferenceTablePair(&prefsA, &prefsB)
+		poset := newRotationPoset(&tables[0], &tables[1])
+
+		assert.Equal(t, map[string]string{"A": "D", "B": "E", "C": "F"}, poset.base)
+		assert.Equal(t, []rotation{
+			{proposers: []string{"A", "B", "C"}, receivers: []string{"D", "E", "F"}},
+			{proposers: []string{"A", "B", "C"}, receivers: []string{"E", "F", "D"}},
+		}, poset.rotations)
+		assert.Equal(t, [][]int{nil, {0}}, poset.predecessors)
+		assert.Nil(t, poset.unmatched)
+	})
+
+	t.Run("independent rotations", func(t *testing.T) {
+		prefsA := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"E", "F", "G", "H"}},
+			{Name: "B", Preferences: []string{"F", "E", "G", "H"}},
+			{Name: "C", Preferences: []string{"G", "H", "E", "F"}},
+			{Name: "D", Preferences: []string{"H", "G", "E", "F"}},
+		}
+		prefsB := []core.MatchPreference{
+			{Name: "E", Preferences: []string{"B", "A", "C", "D"}},
+			{Name: "F", Preferences: []string{"A", "B", "C", "D"}},
+			{Name: "G", Preferences: []string{"D", "C", "A", "B"}},
+			{Name: "H", Preferences: []string{"C", "D", "A", "B"}},
+		}
+
+		tables := core.NewPreferenceTablePair(&prefsA, &prefsB)
+		poset := newRotationPoset(&tables[0], &tables[1])
+
+		assert.Equal(t, []rotation{
+			{proposers: []string{"A", "B"}, receivers: []string{"E", "F"}},
+			{proposers: []string{"C", "D"}, receivers: []string{"G", "H"}},
+		}, poset.rotations)
+		assert.Equal(t, [][]int{nil, nil}, poset.predecessors)
+	})
+
+	t.Run("single stable matching", func(t *testing.T) {
+		prefsA := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"C", "D"}},
+			{Name: "B", Preferences: []string{"D", "C"}},
+		}
+		prefsB := []core.MatchPreference{
+			{Name: "C", Preferences: []string{"A", "B"}},
+			{Name: "D", Preferences: []string{"B", "A"}},
+		}
+
+		tables := core.NewPreferenceTablePair(&prefsA, &prefsB)
+		poset := newRotationPoset(&tables[0], &tables[1])
+
+		assert.Equal(t, map[string]string{"A": "C", "B": "D"}, poset.base)
+		assert.Empty(t, poset.rotations)
+	})
+}
@@ -1,20 +1,121 @@
 package smp
 
 import (
+	"sort"
+
 	"github.com/abhchand/libmatch/pkg/core"
 )
 
 // Run executes the algorithm to solve the "Stable Marriage Problem" (SMP) for
 // a set of given preference inputs.
 //
+// When the algorithm context specifies incomplete preference lists, pairs of
+// members that do not both list each other are removed before running the
+// algorithm, and members left without a match are reported as unmatched.
+//
+// When preference lists contain ties, they are first broken with the tie
+// breaker specified in the algorithm context, which yields a weakly stable
+// matching. Alternatively the algorithm context may require a strongly stable
+// or super-stable matching, in which case ties are kept and an error is
+// returned if no such matching exists.
+//
+// Members of `TableA` propose by default. Setting the algorithm context's
+// `Proposer` to `core.SideB` lets members of `TableB` propose instead. The
+// resulting matching is optimal for the proposing side, which is recorded in
+// the result.
+//
+// By default the matching that is optimal for the proposing side is returned.
+// The algorithm context may instead specify an objective, such as the
+// egalitarian cost, in which case the stable matching with minimum cost is
+// returned.
+//
+// The result records the total rank cost of each side of the matching, so that
+// the outcome for both sides can be compared.
+//
 // See smp package documentation for more detail
 func Run(algoCtx core.AlgorithmContext) (core.MatchResult, error) {
+	var res core.MatchResult
+	var err error
+
+	switch algoCtx.Objective {
+	case "", core.ObjectiveProposerOptimal:
+	case core.ObjectiveMaximumCardinality:
+		return maximumCardinalityMatching(algoCtx)
+	default:
+		return optimalMatching(algoCtx)
+	}
+
 	ptA := algoCtx.TableA
 	ptB := algoCtx.TableB
 
-	phase1Proposal(ptA, ptB)
+	// Order the tables so that the proposing side is first
+	proposers, receivers := ptA, ptB
+	optimalSide := core.SideA
+
+	if algoCtx.Proposer == core.SideB {
+		proposers, receivers = ptB, ptA
+		optimalSide = core.SideB
+	}
+
+	// Ranks are recorded before preference lists are reduced
+	ranks := originalRanks(ptA, ptB)
+
+	if algoCtx.IncompleteLists {
+		ptA.RemoveUnacceptable()
+		ptB.RemoveUnacceptable()
+	}
+
+	switch algoCtx.Stability {
+	case core.StabilityStrong:
+		var mapping map[string]string
+
+		if mapping, err = stronglyStableMatching(proposers, receivers, algoCtx.IncompleteLists); err != nil {
+			return res, err
+		}
+
+		res = buildResultFromMapping(ptA, ptB, mapping)
+	case core.StabilitySuper:
+		var mapping map[string]string
+
+		if mapping, err = superStableMatching(proposers, receivers, algoCtx.IncompleteLists); err != nil {
+			return res, err
+		}
+
+		res = buildResultFromMapping(ptA, ptB, mapping)
+	default:
+		tieBreaker := algoCtx.TieBreaker
+		if tieBreaker == nil {
+			tieBreaker = core.TieBreakByOrder
+		}
+
+		ptA.BreakTies(tieBreaker)
+		ptB.BreakTies(tieBreaker)
 
-	return buildResult(ptA, ptB), nil
+		phase1Proposal(proposers, receivers)
+
+		res = buildResult(ptA, ptB)
+	}
+
+	res.OptimalSide = optimalSide
+	res.Costs = sideCosts(ptA, res.Mapping, ranks)
+
+	return res, nil
+}
+
+// sideCosts returns the total rank cost of each side of a matching. Each
+// matched member adds the rank of their partner on their original preference
+// list, starting at 1 for their first choice.
+func sideCosts(ptA *core.PreferenceTable, mapping map[string]string, ranks map[string]map[string]int) map[core.Side]int {
+	costs := map[core.Side]int{core.SideA: 0, core.SideB: 0}
+
+	for a := range *ptA {
+		if b, ok := mapping[a]; ok {
+			costs[core.SideA] += ranks[a][b] + 1
+			costs[core.SideB] += ranks[b][a] + 1
+		}
+	}
+
+	return costs
 }
 
 // buildResult constructs a Match Result from a Preference Table that has
@@ -26,9 +127,37 @@ func buildResult(ptA, ptB *core.PreferenceTable) core.MatchResult {
 
 	for _, pt := range []*core.PreferenceTable{ptA, ptB} {
 		for name, member := range *pt {
+			if member.CurrentProposer() == nil {
+				res.Unmatched = append(res.Unmatched, name)
+				continue
+			}
+
 			res.Mapping[name] = member.CurrentProposer().Name()
 		}
 	}
 
+	sort.Strings(res.Unmatched)
+
+	return res
+}
+
+// buildResultFromMapping constructs a Match Result from a mapping between
+// members of both tables. Members missing from the mapping are reported as
+// unmatched.
+func buildResultFromMapping(ptA, ptB *core.PreferenceTable, mapping map[string]string) core.MatchResult {
+	res := core.MatchResult{}
+
+	res.Mapping = mapping
+
+	for _, pt := range []*core.PreferenceTable{ptA, ptB} {
+		for name := range *pt {
+			if _, ok := mapping[name]; !ok {
+				res.Unmatched = append(res.Unmatched, name)
+			}
+		}
+	}
+
+	sort.Strings(res.Unmatched)
+
 	return res
 }
@@ -51,6 +51,63 @@ func TestRun(t *testing.T) {
 				"M": "F",
 				"H": "E",
 			},
+			OptimalSide: core.SideA,
+			Costs:       map[core.Side]int{core.SideA: 16, core.SideB: 11},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("second table proposes", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"L", "J", "H", "K", "I", "M"}},
+				{Name: "B", Preferences: []string{"L", "J", "K", "M", "I", "H"}},
+				{Name: "C", Preferences: []string{"L", "J", "M", "I", "K", "H"}},
+				{Name: "D", Preferences: []string{"L", "K", "J", "I", "M", "H"}},
+				{Name: "E", Preferences: []string{"H", "I", "L", "K", "M", "J"}},
+				{Name: "F", Preferences: []string{"J", "K", "L", "M", "H", "I"}},
+			},
+			{
+				{Name: "H", Preferences: []string{"F", "E", "C", "A", "D", "B"}},
+				{Name: "I", Preferences: []string{"B", "D", "A", "E", "C", "F"}},
+				{Name: "J", Preferences: []string{"B", "A", "F", "E", "D", "C"}},
+				{Name: "K", Preferences: []string{"A", "E", "C", "F", "D", "B"}},
+				{Name: "L", Preferences: []string{"C", "F", "E", "B", "D", "A"}},
+				{Name: "M", Preferences: []string{"B", "E", "D", "F", "C", "A"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA:   &tables[0],
+			TableB:   &tables[1],
+			Proposer: core.SideB,
+		}
+
+		// "E" and "F" each receive a less preferred match than when the first
+		// table proposes, while "H" and "M" each receive a more preferred match
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "K",
+				"B": "J",
+				"C": "L",
+				"D": "I",
+				"E": "M",
+				"F": "H",
+				"K": "A",
+				"J": "B",
+				"L": "C",
+				"I": "D",
+				"M": "E",
+				"H": "F",
+			},
+			OptimalSide: core.SideB,
+			Costs:       map[core.Side]int{core.SideA: 21, core.SideB: 8},
 		}
 
 		result, err := Run(algoCtx)
@@ -101,6 +158,236 @@ func TestRun(t *testing.T) {
 				"M": "E",
 				"H": "F",
 			},
+			OptimalSide: core.SideA,
+			Costs:       map[core.Side]int{core.SideA: 8, core.SideB: 21},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("incomplete lists", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "L"}},
+				{Name: "B", Preferences: []string{"K"}},
+				{Name: "C", Preferences: []string{"L", "K"}},
+				{Name: "D", Preferences: []string{}},
+			},
+			{
+				{Name: "K", Preferences: []string{"C", "A", "B"}},
+				{Name: "L", Preferences: []string{"A"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA:          &tables[0],
+			TableB:          &tables[1],
+			IncompleteLists: true,
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "L",
+				"C": "K",
+				"K": "C",
+				"L": "A",
+			},
+			Unmatched:   []string{"B", "D"},
+			OptimalSide: core.SideA,
+			Costs:       map[core.Side]int{core.SideA: 4, core.SideB: 2},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("ties", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "L"}},
+				{Name: "B", Preferences: []string{"K", "L"}},
+			},
+			{
+				{Name: "K", RankedPreferences: [][]string{{"B", "A"}}},
+				{Name: "L", Preferences: []string{"A", "B"}},
+			},
+		}
+
+		testCases := []struct {
+			title      string
+			tieBreaker core.TieBreaker
+			wanted     map[string]string
+		}{
+			{
+				title:      "by order",
+				tieBreaker: nil,
+				wanted:     map[string]string{"A": "L", "B": "K", "K": "B", "L": "A"},
+			},
+			{
+				title:      "by name",
+				tieBreaker: core.TieBreakByName,
+				wanted:     map[string]string{"A": "K", "B": "L", "K": "A", "L": "B"},
+			},
+		}
+
+		for tc := range testCases {
+			t.Run(testCases[tc].title, func(t *testing.T) {
+				tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+				algoCtx := core.AlgorithmContext{
+					TableA:     &tables[0],
+					TableB:     &tables[1],
+					TieBreaker: testCases[tc].tieBreaker,
+				}
+
+				result, err := Run(algoCtx)
+
+				assert.Nil(t, err)
+				assert.Equal(t, testCases[tc].wanted, result.Mapping)
+			})
+		}
+	})
+
+	t.Run("strong stability", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", RankedPreferences: [][]string{{"K", "L"}}},
+				{Name: "B", Preferences: []string{"K", "L"}},
+				{Name: "C", Preferences: []string{}},
+			},
+			{
+				{Name: "K", Preferences: []string{"A", "B"}},
+				{Name: "L", Preferences: []string{"B", "A"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA:          &tables[0],
+			TableB:          &tables[1],
+			IncompleteLists: true,
+			Stability:       core.StabilityStrong,
+		}
+
+		wanted := core.MatchResult{
+			Mapping:     map[string]string{"A": "K", "B": "L", "K": "A", "L": "B"},
+			Unmatched:   []string{"C"},
+			OptimalSide: core.SideA,
+			Costs:       map[core.Side]int{core.SideA: 3, core.SideB: 2},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("no strongly stable solution exists", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", RankedPreferences: [][]string{{"K", "L"}}},
+				{Name: "B", RankedPreferences: [][]string{{"K", "L"}}},
+			},
+			{
+				{Name: "K", Preferences: []string{"A", "B"}},
+				{Name: "L", Preferences: []string{"A", "B"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA:    &tables[0],
+			TableB:    &tables[1],
+			Stability: core.StabilityStrong,
+		}
+
+		_, err := Run(algoCtx)
+
+		assert.Equal(t, "No strongly stable solution exists", err.Error())
+	})
+
+	t.Run("super stability", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "L"}},
+				{Name: "B", Preferences: []string{"L", "K"}},
+			},
+			{
+				{Name: "K", Preferences: []string{"A", "B"}},
+				{Name: "L", RankedPreferences: [][]string{{"A", "B"}}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA:    &tables[0],
+			TableB:    &tables[1],
+			Stability: core.StabilitySuper,
+		}
+
+		wanted := core.MatchResult{
+			Mapping:     map[string]string{"A": "K", "B": "L", "K": "A", "L": "B"},
+			OptimalSide: core.SideA,
+			Costs:       map[core.Side]int{core.SideA: 2, core.SideB: 2},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("no super-stable solution exists", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", RankedPreferences: [][]string{{"K", "L"}}},
+				{Name: "B", RankedPreferences: [][]string{{"K", "L"}}},
+			},
+			{
+				{Name: "K", RankedPreferences: [][]string{{"A", "B"}}},
+				{Name: "L", RankedPreferences: [][]string{{"A", "B"}}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA:    &tables[0],
+			TableB:    &tables[1],
+			Stability: core.StabilitySuper,
+		}
+
+		_, err := Run(algoCtx)
+
+		assert.Equal(t, "No super-stable solution exists", err.Error())
+	})
+
+	t.Run("egalitarian objective", func(t *testing.T) {
+		prefsSet := objectiveTestPrefs()
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA:    &tables[0],
+			TableB:    &tables[1],
+			Objective: core.ObjectiveEgalitarian,
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "E", "B": "H", "C": "F", "D": "G",
+				"E": "A", "H": "B", "F": "C", "G": "D",
+			},
+			Costs: map[core.Side]int{core.SideA: 8, core.SideB: 7},
 		}
 
 		result, err := Run(algoCtx)
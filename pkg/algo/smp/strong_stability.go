@@ -0,0 +1,156 @@
+package smp
+
+import (
+	"errors"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// ErrNoStronglyStableSolution is returned when no strongly stable matching
+// exists
+var ErrNoStronglyStableSolution = errors.New("No strongly stable solution exists")
+
+// stronglyStableMatching implements Irving's (1994) algorithm to find a
+// strongly stable matching, as extended by Manlove (1999) for incomplete
+// lists.
+//
+// Each free member of the first table proposes to every member at the head of
+// their preference list at once. The engagements then form a bipartite graph.
+// Recipients who are over-demanded, that is the neighbours of the "critical
+// set" of proposers who can not all be matched simultaneously, delete every
+// member at the tail of their list. This repeats until the critical set is
+// empty, at which point a maximum matching of the engagement graph is
+// strongly stable if it matches every member who was engaged at some point.
+//
+// See smp package documentation for more detail
+func stronglyStableMatching(ptA, ptB *core.PreferenceTable, incompleteLists bool) (map[string]string, error) {
+	e := make(engagements)
+	everEngaged := make(map[string]bool)
+
+	for true {
+		for proposers := freeProposers(ptA, e); len(proposers) > 0; proposers = freeProposers(ptA, e) {
+			proposeToHead(e, proposers[0], everEngaged)
+		}
+
+		matching := maximumMatching(ptA, e)
+		neighbours := criticalNeighbours(ptA, ptB, e, matching)
+
+		if len(neighbours) == 0 {
+			break
+		}
+
+		for _, member := range neighbours {
+			for _, last := range tail(member) {
+				deletePair(e, member, last)
+			}
+		}
+	}
+
+	// Reduce the engagement relation to a maximum matching of the engagement
+	// graph, which must be perfect for the result to be strongly stable.
+	matching := maximumMatching(ptA, e)
+
+	for name, partners := range e {
+		if len(partners) > 0 && matching[name] == nil {
+			return nil, ErrNoStronglyStableSolution
+		}
+	}
+
+	final := make(engagements)
+	for name, member := range *ptA {
+		if matching[name] != nil {
+			final.engage(member, matching[name])
+		}
+	}
+
+	return engagementsAsMatching(ptA, ptB, final, everEngaged, incompleteLists, ErrNoStronglyStableSolution)
+}
+
+// maximumMatching finds a maximum matching in the bipartite engagement graph
+// between members of a table and the members they are engaged to, using
+// augmenting paths. The result maps the name of each matched member (of
+// either side) to their partner.
+func maximumMatching(pt *core.PreferenceTable, e engagements) map[string]*core.Member {
+	matching := make(map[string]*core.Member)
+
+	for _, name := range pt.SortedNames() {
+		visited := make(map[string]bool)
+		augment((*pt)[name], e, matching, visited)
+	}
+
+	return matching
+}
+
+// augment searches for an augmenting path starting at the specified member,
+// and flips the matching along it when found.
+func augment(m *core.Member, e engagements, matching map[string]*core.Member, visited map[string]bool) bool {
+	for _, partner := range e.partners(m) {
+		if visited[partner.Name()] {
+			continue
+		}
+
+		visited[partner.Name()] = true
+
+		current := matching[partner.Name()]
+
+		if current == nil || augment(current, e, matching, visited) {
+			matching[m.Name()] = partner
+			matching[partner.Name()] = m
+			return true
+		}
+	}
+
+	return false
+}
+
+// criticalNeighbours returns the members of the second table who are engaged
+// to at least one member of the critical set, in order of name.
+//
+// The critical set is the set of engaged members of the first table that are
+// left unmatched by some maximum matching of the engagement graph. It consists
+// of every engaged member unmatched by the given maximum matching, along with
+// every member reachable from them by an alternating path.
+func criticalNeighbours(
+	ptA, ptB *core.PreferenceTable,
+	e engagements,
+	matching map[string]*core.Member) []*core.Member {
+
+	var queue []*core.Member
+
+	critical := make(map[string]bool)
+	neighbours := make(map[string]bool)
+
+	for _, name := range ptA.SortedNames() {
+		member := (*ptA)[name]
+
+		if e.isEngaged(member) && matching[name] == nil {
+			critical[name] = true
+			queue = append(queue, member)
+		}
+	}
+
+	for len(queue) > 0 {
+		member := queue[0]
+		queue = queue[1:]
+
+		for _, partner := range e.partners(member) {
+			neighbours[partner.Name()] = true
+
+			next := matching[partner.Name()]
+			if next != nil && !critical[next.Name()] {
+				critical[next.Name()] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	var result []*core.Member
+
+	for _, name := range ptB.SortedNames() {
+		if neighbours[name] {
+			result = append(result, (*ptB)[name])
+		}
+	}
+
+	return result
+}
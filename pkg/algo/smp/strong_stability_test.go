@@ -0,0 +1,175 @@
+package smp
+
+import (
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStronglyStableMatching(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(
+			&[]core.MatchPreference{
+				{Name: "A", RankedPreferences: [][]string{{"K", "L"}}},
+				{Name: "B", Preferences: []string{"K", "L"}},
+			},
+			&[]core.MatchPreference{
+				{Name: "K", Preferences: []string{"A", "B"}},
+				{Name: "L", Preferences: []string{"B", "A"}},
+			},
+		)
+
+		wanted := map[string]string{"A": "K", "B": "L", "K": "A", "L": "B"}
+
+		mapping, err := stronglyStableMatching(&tables[0], &tables[1], false)
+
+		assert.Nil(t, err)
+		assert.Equal(t, wanted, mapping)
+	})
+
+	t.Run("indifferent members", func(t *testing.T) {
+		// No super-stable matching exists, but any perfect matching is
+		// strongly stable
+		tables := core.NewPreferenceTablePair(
+			&[]core.MatchPreference{
+				{Name: "A", RankedPreferences: [][]string{{"K", "L"}}},
+				{Name: "B", RankedPreferences: [][]string{{"K", "L"}}},
+			},
+			&[]core.MatchPreference{
+				{Name: "K", RankedPreferences: [][]string{{"A", "B"}}},
+				{Name: "L", RankedPreferences: [][]string{{"A", "B"}}},
+			},
+		)
+
+		mapping, err := stronglyStableMatching(&tables[0], &tables[1], false)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 4, len(mapping))
+		assert.Equal(t, "A", mapping[mapping["A"]])
+		assert.Equal(t, "B", mapping[mapping["B"]])
+	})
+
+	t.Run("no strongly stable solution exists", func(t *testing.T) {
+		/*
+		 * "A" is indifferent between "K" and "L", who both strictly prefer
+		 * "A". Whichever of them is not matched with "A" forms a blocking pair.
+		 */
+		tables := core.NewPreferenceTablePair(
+			&[]core.MatchPreference{
+				{Name: "A", RankedPreferences: [][]string{{"K", "L"}}},
+				{Name: "B", RankedPreferences: [][]string{{"K", "L"}}},
+			},
+			&[]core.MatchPreference{
+				{Name: "K", Preferences: []string{"A", "B"}},
+				{Name: "L", Preferences: []string{"A", "B"}},
+			},
+		)
+
+		_, err := stronglyStableMatching(&tables[0], &tables[1], false)
+
+		assert.Equal(t, ErrNoStronglyStableSolution, err)
+	})
+
+	t.Run("incomplete lists", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(
+			&[]core.MatchPreference{
+				{Name: "A", Preferences: []string{"K"}},
+				{Name: "B", Preferences: []string{"K"}},
+			},
+			&[]core.MatchPreference{
+				{Name: "K", Preferences: []string{"A", "B"}},
+			},
+		)
+
+		wanted := map[string]string{"A": "K", "K": "A"}
+
+		mapping, err := stronglyStableMatching(&tables[0], &tables[1], true)
+
+		assert.Nil(t, err)
+		assert.Equal(t, wanted, mapping)
+	})
+
+	t.Run("incomplete lists with no strongly stable solution", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(
+			&[]core.MatchPreference{
+				{Name: "A", Preferences: []string{"K"}},
+				{Name: "B", Preferences: []string{"K"}},
+			},
+			&[]core.MatchPreference{
+				{Name: "K", RankedPreferences: [][]string{{"A", "B"}}},
+			},
+		)
+
+		_, err := stronglyStableMatching(&tables[0], &tables[1], true)
+
+		assert.Equal(t, ErrNoStronglyStableSolution, err)
+	})
+}
+
+func TestMaximumMatching(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "A", Preferences: []string{"K", "L"}},
+			{Name: "B", Preferences: []string{"K"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "K", Preferences: []string{"A", "B"}},
+			{Name: "L", Preferences: []string{"A"}},
+		},
+	)
+
+	a, b := tables[0]["A"], tables[0]["B"]
+	k, l := tables[1]["K"], tables[1]["L"]
+
+	e := make(engagements)
+	e.engage(a, k)
+	e.engage(a, l)
+	e.engage(b, k)
+
+	// "A" is first matched with "K", and then moved to "L" so that "B" can be
+	// matched with "K"
+	wanted := map[string]*core.Member{"A": l, "L": a, "B": k, "K": b}
+
+	assert.Equal(t, wanted, maximumMatching(&tables[0], e))
+}
+
+func TestCriticalNeighbours(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "A", Preferences: []string{"K", "L", "M"}},
+			{Name: "B", Preferences: []string{"K", "L", "M"}},
+			{Name: "C", Preferences: []string{"M", "K", "L"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "K", Preferences: []string{"A", "B", "C"}},
+			{Name: "L", Preferences: []string{"A", "B", "C"}},
+			{Name: "M", Preferences: []string{"A", "B", "C"}},
+		},
+	)
+
+	a, b, c := tables[0]["A"], tables[0]["B"], tables[0]["C"]
+	k, m := tables[1]["K"], tables[1]["M"]
+
+	t.Run("critical set", func(t *testing.T) {
+		// "A" and "B" are both only engaged to "K"
+		e := make(engagements)
+		e.engage(a, k)
+		e.engage(b, k)
+		e.engage(c, m)
+
+		matching := maximumMatching(&tables[0], e)
+
+		assert.Equal(t, []*core.Member{k}, criticalNeighbours(&tables[0], &tables[1], e, matching))
+	})
+
+	t.Run("empty critical set", func(t *testing.T) {
+		e := make(engagements)
+		e.engage(a, k)
+		e.engage(c, m)
+
+		matching := maximumMatching(&tables[0], e)
+
+		assert.Nil(t, criticalNeighbours(&tables[0], &tables[1], e, matching))
+	})
+}
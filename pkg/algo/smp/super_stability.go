@@ -0,0 +1,92 @@
+package smp
+
+import (
+	"errors"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// ErrNoSuperStableSolution is returned when no super-stable matching exists
+var ErrNoSuperStableSolution = errors.New("No super-stable solution exists")
+
+// superStableMatching implements Irving's (1994) algorithm to find a
+// super-stable matching, as extended by Manlove (1999) for incomplete lists.
+//
+// Each free member of the first table proposes to every member at the head of
+// their preference list at once. Any recipient who ends up engaged to several
+// members can not keep any of them, so all of those engagements are broken
+// and the recipient deletes every member at the tail of their list.
+//
+// See smp package documentation for more detail
+func superStableMatching(ptA, ptB *core.PreferenceTable, incompleteLists bool) (map[string]string, error) {
+	e := make(engagements)
+	everEngaged := make(map[string]bool)
+
+	for true {
+		for proposers := freeProposers(ptA, e); len(proposers) > 0; proposers = freeProposers(ptA, e) {
+			proposeToHead(e, proposers[0], everEngaged)
+		}
+
+		multiplyEngaged := make([]*core.Member, 0)
+		for _, name := range ptB.SortedNames() {
+			if len(e[name]) > 1 {
+				multiplyEngaged = append(multiplyEngaged, (*ptB)[name])
+			}
+		}
+
+		if len(multiplyEngaged) == 0 {
+			break
+		}
+
+		for _, member := range multiplyEngaged {
+			for _, partner := range e.partners(member) {
+				e.breakEngagement(member, partner)
+			}
+
+			for _, last := range tail(member) {
+				deletePair(e, member, last)
+			}
+		}
+	}
+
+	return engagementsAsMatching(ptA, ptB, e, everEngaged, incompleteLists, ErrNoSuperStableSolution)
+}
+
+// engagementsAsMatching converts the final engagement relation into a
+// matching.
+//
+// With complete lists every member must be engaged to exactly one member.
+// With incomplete lists, every member of the second table who was engaged at
+// some point during the algorithm must still be engaged. Otherwise the
+// specified error is returned.
+func engagementsAsMatching(
+	ptA, ptB *core.PreferenceTable,
+	e engagements,
+	everEngaged map[string]bool,
+	incompleteLists bool,
+	noSolutionErr error) (map[string]string, error) {
+
+	mapping := make(map[string]string)
+
+	for _, pt := range []*core.PreferenceTable{ptA, ptB} {
+		for name, member := range *pt {
+			partners := e.partners(member)
+
+			if len(partners) > 1 {
+				return nil, noSolutionErr
+			}
+
+			if len(partners) == 0 {
+				if !incompleteLists || everEngaged[name] {
+					return nil, noSolutionErr
+				}
+
+				continue
+			}
+
+			mapping[name] = partners[0].Name()
+		}
+	}
+
+	return mapping, nil
+}
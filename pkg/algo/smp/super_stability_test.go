@@ -0,0 +1,138 @@
+package smp
+
+import (
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuperStableMatching(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(
+			&[]core.MatchPreference{
+				{Name: "A", Preferences: []string{"K", "L"}},
+				{Name: "B", Preferences: []string{"L", "K"}},
+			},
+			&[]core.MatchPreference{
+				{Name: "K", Preferences: []string{"A", "B"}},
+				{Name: "L", RankedPreferences: [][]string{{"A", "B"}}},
+			},
+		)
+
+		wanted := map[string]string{"A": "K", "B": "L", "K": "A", "L": "B"}
+
+		mapping, err := superStableMatching(&tables[0], &tables[1], false)
+
+		assert.Nil(t, err)
+		assert.Equal(t, wanted, mapping)
+	})
+
+	t.Run("no super-stable solution exists", func(t *testing.T) {
+		/*
+		 * Every perfect matching is strongly stable, but "A" and "K" are
+		 * indifferent between their options so any unmatched pair blocks.
+		 */
+		tables := core.NewPreferenceTablePair(
+			&[]core.MatchPreference{
+				{Name: "A", RankedPreferences: [][]string{{"K", "L"}}},
+				{Name: "B", RankedPreferences: [][]string{{"K", "L"}}},
+			},
+			&[]core.MatchPreference{
+				{Name: "K", RankedPreferences: [][]string{{"A", "B"}}},
+				{Name: "L", RankedPreferences: [][]string{{"A", "B"}}},
+			},
+		)
+
+		_, err := superStableMatching(&tables[0], &tables[1], false)
+
+		assert.Equal(t, ErrNoSuperStableSolution, err)
+	})
+
+	t.Run("incomplete lists", func(t *testing.T) {
+		tables := core.NewPreferenceTablePair(
+			&[]core.MatchPreference{
+				{Name: "A", Preferences: []string{"K"}},
+				{Name: "B", Preferences: []string{"K"}},
+				{Name: "C", Preferences: []string{}},
+			},
+			&[]core.MatchPreference{
+				{Name: "K", Preferences: []string{"A", "B"}},
+			},
+		)
+
+		wanted := map[string]string{"A": "K", "K": "A"}
+
+		mapping, err := superStableMatching(&tables[0], &tables[1], true)
+
+		assert.Nil(t, err)
+		assert.Equal(t, wanted, mapping)
+	})
+}
+
+func TestEngagementsAsMatching(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "A", Preferences: []string{"K", "L"}},
+			{Name: "B", Preferences: []string{"K", "L"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "K", Preferences: []string{"A", "B"}},
+			{Name: "L", Preferences: []string{"A", "B"}},
+		},
+	)
+
+	a, b := tables[0]["A"], tables[0]["B"]
+	k, l := tables[1]["K"], tables[1]["L"]
+
+	t.Run("success", func(t *testing.T) {
+		e := make(engagements)
+		e.engage(a, k)
+		e.engage(b, l)
+
+		wanted := map[string]string{"A": "K", "B": "L", "K": "A", "L": "B"}
+
+		mapping, err := engagementsAsMatching(
+			&tables[0], &tables[1], e, map[string]bool{"K": true, "L": true}, false, ErrNoSuperStableSolution)
+
+		assert.Nil(t, err)
+		assert.Equal(t, wanted, mapping)
+	})
+
+	t.Run("member engaged multiple times", func(t *testing.T) {
+		e := make(engagements)
+		e.engage(a, k)
+		e.engage(a, l)
+
+		_, err := engagementsAsMatching(
+			&tables[0], &tables[1], e, map[string]bool{"K": true, "L": true}, true, ErrNoSuperStableSolution)
+
+		assert.Equal(t, ErrNoSuperStableSolution, err)
+	})
+
+	t.Run("unmatched member with complete lists", func(t *testing.T) {
+		e := make(engagements)
+		e.engage(a, k)
+
+		_, err := engagementsAsMatching(
+			&tables[0], &tables[1], e, map[string]bool{"K": true}, false, ErrNoSuperStableSolution)
+
+		assert.Equal(t, ErrNoSuperStableSolution, err)
+	})
+
+	t.Run("previously engaged member with incomplete lists", func(t *testing.T) {
+		e := make(engagements)
+		e.engage(a, k)
+
+		_, err := engagementsAsMatching(
+			&tables[0], &tables[1], e, map[string]bool{"K": true, "L": true}, true, ErrNoSuperStableSolution)
+
+		assert.Equal(t, ErrNoSuperStableSolution, err)
+
+		mapping, err := engagementsAsMatching(
+			&tables[0], &tables[1], e, map[string]bool{"K": true}, true, ErrNoSuperStableSolution)
+
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{"A": "K", "K": "A"}, mapping)
+	})
+}
@@ -0,0 +1,101 @@
+/*
+Package spa implements the solution to the "Student-Project Allocation
+Problem".
+
+It implements the student-oriented algorithm of Abraham, Irving and Manlove
+(2007) for the SPA-S model, in which students rank projects and lecturers rank
+students. Each project is offered by a single lecturer, and both projects and
+lecturers have a capacity. Each student is assigned to at most one project, no
+project is assigned more students than its capacity and no lecturer is assigned
+more students (across all of their projects) than their capacity.
+
+ALGORITHM
+
+See: Abraham, Irving and Manlove (2007) "Two algorithms for the
+Student-Project Allocation problem"
+
+Lecturers do not rank projects, and projects do not rank students. Instead,
+each project ranks students in the same order as its lecturer.
+
+Each unassigned student with a non-empty preference list "applies" to the top
+remaining project on their list, and is provisionally assigned to it. Then -
+
+1. If the project is over-subscribed, the lecturer rejects the student they
+prefer the least among those assigned to the project
+
+2. Otherwise, if the lecturer is over-subscribed, the lecturer rejects the
+student they prefer the least among those assigned to any of their projects
+
+3. If the project is now full, the project is deleted from the preference list
+of every student the lecturer prefers less than the worst student assigned to
+the project
+
+4. If the lecturer is now full, every one of their projects is deleted from the
+preference list of every student the lecturer prefers less than the worst
+student assigned to them
+
+A rejected student's assignment is broken and the project is deleted from their
+preference list.
+
+This cycle continues until every student is either assigned or has exhausted
+their preference list.
+
+STABILITY AND DETERMINISM
+
+A stable solution is always guaranteed. A student and a project they prefer to
+their assignment (if any) block a matching when the project has room for the
+student and either the lecturer has room for the student, the student is
+already assigned to another project of the same lecturer, or the lecturer
+prefers the student to their worst assigned student. A full project also blocks
+a matching when the lecturer prefers the student to the worst student assigned
+to the project.
+
+The algorithm returns the stable matching that is best for every student. Since
+this matching is unique, the result is deterministic even though students are
+processed in no particular order. Every stable matching assigns the same
+students, so the students left unmatched can not be assigned in any stable
+matching.
+
+ALGORITHM EXAMPLE
+
+Take the following preference tables
+
+	// students
+	S1 => [P1, P3]
+	S2 => [P1, P2]
+	S3 => [P2, P3]
+	S4 => [P3]
+	S5 => [P2, P1]
+
+	// projects (capacity, lecturer)
+	P1 (1, L1)
+	P2 (1, L1)
+	P3 (2, L2)
+
+	// lecturers (capacity)
+	L1 (2) => [S3, S1, S5, S2]
+	L2 (1) => [S4, S3, S1]
+
+One possible sequence of events is -
+
+	'S1' applies to 'P1'
+	'P1' is full, 'P1' is deleted from the lists of 'S5' and 'S2'
+	'S2' applies to 'P2'
+	'L1' is full
+	'S3' applies to 'P2'
+	'P2' is over-subscribed, 'L1' rejects 'S2'
+	'P2' is full, 'P2' is deleted from the list of 'S5'
+	'L1' is full, 'P1' and 'P2' are deleted from the lists of 'S5' and 'S2'
+	'S4' applies to 'P3'
+	'L2' is full, 'P3' is deleted from the lists of 'S3' and 'S1'
+
+The preference lists of 'S2' and 'S5' are now exhausted. The matching result
+is:
+
+	L1 => P1 => [S1]
+	      P2 => [S3]
+	L2 => P3 => [S4]
+
+	Unmatched: [S2, S5]
+*/
+package spa
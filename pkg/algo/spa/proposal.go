@@ -0,0 +1,139 @@
+package spa
+
+import (
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// assignments tracks the students currently assigned to each project, keyed by
+// project name.
+type assignments map[string][]*core.Member
+
+// studentProposal implements the student-oriented algorithm of Abraham, Irving
+// and Manlove (2007).
+//
+// Each unassigned student "applies" to their top remaining project and is
+// provisionally assigned to it. Projects and lecturers that become
+// over-subscribed reject their least preferred student, and projects and
+// lecturers that become full are deleted from the preference lists of every
+// student their lecturer prefers less than the worst student assigned to them.
+//
+// See spa package documentation for more detail
+func studentProposal(ptS, ptP *core.PreferenceTable, held assignments) {
+	projects := projectsByLecturer(ptP)
+
+	for true {
+		unassigned := unassignedStudents(ptS)
+
+		if len(unassigned) == 0 {
+			break
+		}
+
+		student := unassigned[0]
+		topChoice := student.FirstPreference()
+		simulateStudentProposal(student, topChoice, projects, held)
+	}
+}
+
+// projectsByLecturer returns the projects offered by each lecturer, keyed by
+// lecturer name.
+func projectsByLecturer(ptP *core.PreferenceTable) map[string][]*core.Member {
+	projects := make(map[string][]*core.Member)
+
+	for _, project := range *ptP {
+		name := project.Owner().Name()
+		projects[name] = append(projects[name], project)
+	}
+
+	return projects
+}
+
+// unassignedStudents returns all students that are not currently assigned to a
+// project and that still have projects left to apply to.
+func unassignedStudents(ptS *core.PreferenceTable) []*core.Member {
+	var unassigned []*core.Member
+
+	for _, student := range *ptS {
+		if student.HasAcceptedProposal() {
+			continue
+		}
+
+		if len(student.PreferenceList().Members()) == 0 {
+			continue
+		}
+
+		unassigned = append(unassigned, student)
+	}
+
+	return unassigned
+}
+
+// simulateStudentProposal simulates an application from a student to a
+// project, offered by a lecturer who offers all of `projects`.
+func simulateStudentProposal(student, project *core.Member, projects map[string][]*core.Member, held assignments) {
+	lecturer := project.Owner()
+	offered := projects[lecturer.Name()]
+
+	held[project.Name()] = append(held[project.Name()], student)
+	student.Accept(project)
+
+	if len(held[project.Name()]) > project.Capacity() {
+		// Project is over-subscribed. Reject the student its lecturer prefers
+		// the least among those assigned to it.
+		worst := lecturer.PreferenceList().LeastPreferred(held[project.Name()])
+		unassign(worst, project, held)
+	} else if students := studentsOf(offered, held); len(students) > lecturer.Capacity() {
+		// Lecturer is over-subscribed. Reject the student they prefer the least
+		// among those assigned to any of their projects.
+		worst := lecturer.PreferenceList().LeastPreferred(students)
+		unassign(worst, worst.CurrentProposer(), held)
+	}
+
+	if len(held[project.Name()]) == project.Capacity() {
+		// Project is full. No student the lecturer prefers less than the worst
+		// student assigned to it can be assigned to it in a stable matching.
+		worst := lecturer.PreferenceList().LeastPreferred(held[project.Name()])
+
+		for _, successor := range successors(lecturer, worst) {
+			successor.PreferenceList().Remove(*project)
+		}
+	}
+
+	if students := studentsOf(offered, held); len(students) == lecturer.Capacity() {
+		// Lecturer is full. No student the lecturer prefers less than the worst
+		// student assigned to them can be assigned to any of their projects in a
+		// stable matching.
+		worst := lecturer.PreferenceList().LeastPreferred(students)
+
+		for _, successor := range successors(lecturer, worst) {
+			for i := range offered {
+				successor.PreferenceList().Remove(*offered[i])
+			}
+		}
+	}
+}
+
+// unassign breaks the assignment of a student to a project
+func unassign(student, project *core.Member, held assignments) {
+	held[project.Name()] = core.RemoveMember(held[project.Name()], student)
+	student.Reject(project)
+}
+
+// studentsOf returns all students assigned to any of the specified projects
+func studentsOf(projects []*core.Member, held assignments) []*core.Member {
+	var students []*core.Member
+
+	for i := range projects {
+		students = append(students, held[projects[i].Name()]...)
+	}
+
+	return students
+}
+
+// successors returns the members that appear after `member` on a lecturer's
+// preference list
+func successors(lecturer, member *core.Member) []*core.Member {
+	prefs := lecturer.PreferenceList().Members()
+	idx := lecturer.PreferenceList().IndexOf(*member)
+
+	return prefs[(idx + 1):]
+}
@@ -0,0 +1,182 @@
+package spa
+
+import (
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStudentProposal(t *testing.T) {
+	prefsSet := []*[]core.MatchPreference{
+		{
+			{Name: "S1", Preferences: []string{"P1", "P2"}},
+			{Name: "S2", Preferences: []string{"P1", "P2"}},
+			{Name: "S3", Preferences: []string{"P1", "P2"}},
+		},
+		{
+			{Name: "P1", Owner: "L1", Capacity: 2},
+			{Name: "P2", Owner: "L2"},
+		},
+		{
+			{Name: "L1", Preferences: []string{"S3", "S2", "S1"}, Capacity: 2},
+			{Name: "L2", Preferences: []string{"S1", "S2", "S3"}},
+		},
+	}
+
+	tables := core.NewPreferenceTableTriple(prefsSet[0], prefsSet[1], prefsSet[2])
+	held := make(assignments)
+
+	studentProposal(&tables[0], &tables[1], held)
+
+	assert.ElementsMatch(t, []*core.Member{tables[0]["S2"], tables[0]["S3"]}, held["P1"])
+	assert.Equal(t, []*core.Member{tables[0]["S1"]}, held["P2"])
+
+	assert.Equal(t, tables[1]["P2"], tables[0]["S1"].CurrentProposer())
+	assert.Equal(t, tables[1]["P1"], tables[0]["S2"].CurrentProposer())
+	assert.Equal(t, tables[1]["P1"], tables[0]["S3"].CurrentProposer())
+
+	// 'P1' is full, so it is deleted from the list of 'S1'
+	assert.Equal(t, []*core.Member{tables[1]["P2"]}, tables[0]["S1"].PreferenceList().Members())
+}
+
+func TestSimulateStudentProposal(t *testing.T) {
+	t.Run("project and lecturer have spare capacity", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "S1", Preferences: []string{"P1"}},
+				{Name: "S2", Preferences: []string{"P1"}},
+			},
+			{
+				{Name: "P1", Owner: "L1", Capacity: 2},
+			},
+			{
+				{Name: "L1", Preferences: []string{"S1", "S2"}, Capacity: 2},
+			},
+		}
+
+		tables := core.NewPreferenceTableTriple(prefsSet[0], prefsSet[1], prefsSet[2])
+		projects := projectsByLecturer(&tables[1])
+		held := make(assignments)
+
+		simulateStudentProposal(tables[0]["S2"], tables[1]["P1"], projects, held)
+
+		assert.Equal(t, []*core.Member{tables[0]["S2"]}, held["P1"])
+		assert.Equal(t, tables[1]["P1"], tables[0]["S2"].CurrentProposer())
+
+		// Nothing is full, so no project is deleted
+		assert.Equal(t, []*core.Member{tables[1]["P1"]}, tables[0]["S1"].PreferenceList().Members())
+	})
+
+	t.Run("project is over-subscribed", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "S1", Preferences: []string{"P1", "P2"}},
+				{Name: "S2", Preferences: []string{"P1", "P2"}},
+				{Name: "S3", Preferences: []string{"P1"}},
+			},
+			{
+				{Name: "P1", Owner: "L1"},
+				{Name: "P2", Owner: "L1"},
+			},
+			{
+				{Name: "L1", Preferences: []string{"S2", "S1", "S3"}, Capacity: 2},
+			},
+		}
+
+		tables := core.NewPreferenceTableTriple(prefsSet[0], prefsSet[1], prefsSet[2])
+		projects := projectsByLecturer(&tables[1])
+		held := make(assignments)
+
+		simulateStudentProposal(tables[0]["S1"], tables[1]["P1"], projects, held)
+		simulateStudentProposal(tables[0]["S2"], tables[1]["P1"], projects, held)
+
+		assert.Equal(t, []*core.Member{tables[0]["S2"]}, held["P1"])
+		assert.Nil(t, tables[0]["S1"].CurrentProposer())
+
+		// 'S1' was rejected, and 'P1' is deleted from the lists of students the
+		// lecturer prefers less than 'S2'
+		assert.Equal(t, []*core.Member{tables[1]["P2"]}, tables[0]["S1"].PreferenceList().Members())
+		assert.Equal(t, []*core.Member{}, tables[0]["S3"].PreferenceList().Members())
+	})
+
+	t.Run("lecturer is over-subscribed", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "S1", Preferences: []string{"P1", "P3"}},
+				{Name: "S2", Preferences: []string{"P2", "P3"}},
+				{Name: "S3", Preferences: []string{"P2", "P1"}},
+			},
+			{
+				{Name: "P1", Owner: "L1"},
+				{Name: "P2", Owner: "L1"},
+				{Name: "P3", Owner: "L2"},
+			},
+			{
+				{Name: "L1", Preferences: []string{"S2", "S1", "S3"}},
+				{Name: "L2", Preferences: []string{"S1", "S2"}},
+			},
+		}
+
+		tables := core.NewPreferenceTableTriple(prefsSet[0], prefsSet[1], prefsSet[2])
+		projects := projectsByLecturer(&tables[1])
+		held := make(assignments)
+
+		simulateStudentProposal(tables[0]["S1"], tables[1]["P1"], projects, held)
+		simulateStudentProposal(tables[0]["S2"], tables[1]["P2"], projects, held)
+
+		assert.Equal(t, []*core.Member{}, held["P1"])
+		assert.Equal(t, []*core.Member{tables[0]["S2"]}, held["P2"])
+		assert.Nil(t, tables[0]["S1"].CurrentProposer())
+
+		// The lecturer is full, so all of their projects are deleted from the
+		// lists of students they prefer less than 'S2'
+		assert.Equal(t, []*core.Member{tables[1]["P3"]}, tables[0]["S1"].PreferenceList().Members())
+		assert.Equal(t, []*core.Member{}, tables[0]["S3"].PreferenceList().Members())
+	})
+}
+
+func TestProjectsByLecturer(t *testing.T) {
+	prefsSet := []*[]core.MatchPreference{
+		{
+			{Name: "S1", Preferences: []string{"P1"}},
+		},
+		{
+			{Name: "P1", Owner: "L1"},
+			{Name: "P2", Owner: "L2"},
+			{Name: "P3", Owner: "L1"},
+		},
+		{
+			{Name: "L1", Preferences: []string{"S1"}},
+			{Name: "L2", Preferences: []string{}},
+		},
+	}
+
+	tables := core.NewPreferenceTableTriple(prefsSet[0], prefsSet[1], prefsSet[2])
+	projects := projectsByLecturer(&tables[1])
+
+	assert.ElementsMatch(t, []*core.Member{tables[1]["P1"], tables[1]["P3"]}, projects["L1"])
+	assert.Equal(t, []*core.Member{tables[1]["P2"]}, projects["L2"])
+}
+
+func TestSuccessors(t *testing.T) {
+	prefsSet := []*[]core.MatchPreference{
+		{
+			{Name: "S1", Preferences: []string{"P1"}},
+			{Name: "S2", Preferences: []string{"P1"}},
+			{Name: "S3", Preferences: []string{"P1"}},
+		},
+		{
+			{Name: "P1", Owner: "L1"},
+		},
+		{
+			{Name: "L1", Preferences: []string{"S2", "S3", "S1"}},
+		},
+	}
+
+	tables := core.NewPreferenceTableTriple(prefsSet[0], prefsSet[1], prefsSet[2])
+	lecturer := tables[2]["L1"]
+
+	assert.Equal(t, []*core.Member{tables[0]["S3"], tables[0]["S1"]}, successors(lecturer, tables[0]["S2"]))
+	assert.Equal(t, []*core.Member{}, successors(lecturer, tables[0]["S1"]))
+}
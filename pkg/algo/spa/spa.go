@@ -0,0 +1,73 @@
+package spa
+
+import (
+	"sort"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// Run executes the algorithm to solve the "Student-Project Allocation
+// Problem" (SPA-S) for a set of given preference inputs. `TableA` holds the
+// students, `TableB` holds the projects and `TableC` holds the lecturers.
+//
+// The student-optimal stable matching is returned. Students who can not be
+// assigned to any project in a stable matching are reported as unmatched.
+//
+// See spa package documentation for more detail
+func Run(algoCtx core.AlgorithmContext) (core.MatchResult, error) {
+	ptS := algoCtx.TableA
+	ptP := algoCtx.TableB
+	ptL := algoCtx.TableC
+
+	held := make(assignments, len(*ptP))
+
+	studentProposal(ptS, ptP, held)
+
+	res := buildResult(ptS, ptP, ptL, held)
+	res.OptimalSide = core.SideA
+
+	return res, nil
+}
+
+// buildResult constructs a Match Result from the students assigned to each
+// project at the end of the algorithm run.
+func buildResult(ptS, ptP, ptL *core.PreferenceTable, held assignments) core.MatchResult {
+	res := core.MatchResult{}
+
+	res.Mapping = make(map[string]string)
+	res.Assignments = make(map[string][]string, len(*ptP))
+	res.Allocations = make(map[string]map[string][]string, len(*ptL))
+
+	for name := range *ptL {
+		res.Allocations[name] = make(map[string][]string)
+	}
+
+	for name, project := range *ptP {
+		lecturer := project.Owner()
+		students := held[name]
+
+		// List students in the lecturer's order of preference
+		sort.Slice(students, func(i, j int) bool {
+			pl := lecturer.PreferenceList()
+			return pl.IndexOf(*students[i]) < pl.IndexOf(*students[j])
+		})
+
+		res.Assignments[name] = make([]string, len(students))
+		for i := range students {
+			res.Assignments[name][i] = students[i].Name()
+			res.Mapping[students[i].Name()] = name
+		}
+
+		res.Allocations[lecturer.Name()][name] = res.Assignments[name]
+	}
+
+	for name := range *ptS {
+		if _, ok := res.Mapping[name]; !ok {
+			res.Unmatched = append(res.Unmatched, name)
+		}
+	}
+
+	sort.Strings(res.Unmatched)
+
+	return res
+}
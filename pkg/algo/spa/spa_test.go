@@ -0,0 +1,112 @@
+package spa
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "S1", Preferences: []string{"P1", "P3"}},
+				{Name: "S2", Preferences: []string{"P1", "P2"}},
+				{Name: "S3", Preferences: []string{"P2", "P3"}},
+				{Name: "S4", Preferences: []string{"P3"}},
+				{Name: "S5", Preferences: []string{"P2", "P1"}},
+			},
+			{
+				{Name: "P1", Owner: "L1"},
+				{Name: "P2", Owner: "L1"},
+				{Name: "P3", Owner: "L2", Capacity: 2},
+			},
+			{
+				{Name: "L1", Preferences: []string{"S3", "S1", "S5", "S2"}, Capacity: 2},
+				{Name: "L2", Preferences: []string{"S4", "S3", "S1"}},
+			},
+		}
+
+		tables := core.NewPreferenceTableTriple(prefsSet[0], prefsSet[1], prefsSet[2])
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+			TableC: &tables[2],
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"S1": "P1",
+				"S3": "P2",
+				"S4": "P3",
+			},
+			Assignments: map[string][]string{
+				"P1": {"S1"},
+				"P2": {"S3"},
+				"P3": {"S4"},
+			},
+			Allocations: map[string]map[string][]string{
+				"L1": {"P1": {"S1"}, "P2": {"S3"}},
+				"L2": {"P3": {"S4"}},
+			},
+			Unmatched:   []string{"S2", "S5"},
+			OptimalSide: core.SideA,
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("students are listed in the lecturer's order of preference", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "S1", Preferences: []string{"P1"}},
+				{Name: "S2", Preferences: []string{"P1"}},
+				{Name: "S3", Preferences: []string{"P2"}},
+			},
+			{
+				{Name: "P1", Owner: "L1", Capacity: 2},
+				{Name: "P2", Owner: "L1"},
+			},
+			{
+				{Name: "L1", Preferences: []string{"S3", "S2", "S1"}, Capacity: 3},
+				{Name: "L2", Preferences: []string{}},
+			},
+		}
+
+		tables := core.NewPreferenceTableTriple(prefsSet[0], prefsSet[1], prefsSet[2])
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+			TableC: &tables[2],
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"S1": "P1",
+				"S2": "P1",
+				"S3": "P2",
+			},
+			Assignments: map[string][]string{
+				"P1": {"S2", "S1"},
+				"P2": {"S3"},
+			},
+			Allocations: map[string]map[string][]string{
+				"L1": {"P1": {"S2", "S1"}, "P2": {"S3"}},
+				"L2": {},
+			},
+			OptimalSide: core.SideA,
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+}
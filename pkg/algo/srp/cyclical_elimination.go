@@ -58,6 +58,8 @@ func detectCycle(pt *core.PreferenceTable, startingMember *core.Member) []cycleP
 		{x: startingMember},
 	}
 
+	// Track the position (offset by one) at which each member was last seen, so
+	// that a zero value means the member has not been seen yet
 	lastSeenAt := make(map[string]int, 0)
 	lastSeenAt[startingMember.Name()] = 1
 	currentMemberIdx := 0
@@ -77,16 +79,33 @@ func detectCycle(pt *core.PreferenceTable, startingMember *core.Member) []cycleP
 			break
 		}
 
-		lastSeenAt[newPair.x.Name()] = currentMemberIdx + 1
 		currentMemberIdx = currentMemberIdx + 1
+		lastSeenAt[newPair.x.Name()] = currentMemberIdx + 1
 	}
 
 	return pairs
 }
 
 // eliminateCycle removes an identified preference cycle in a preference table
+//
+// Each pair (Xi, Yi) is mutually rejected. Since Yi+1 is now the top
+// preference of Xi, Yi+1 must also reject every member it prefers less than
+// Xi so that Xi becomes the last preference of Yi+1.
 func eliminateCycle(pt *core.PreferenceTable, pairs []cyclePair) {
 	for p := range pairs {
 		(pairs[p].x).Reject(pairs[p].y)
 	}
+
+	for p := range pairs {
+		x := pairs[(p+len(pairs)-1)%len(pairs)].x
+		y := pairs[p].y
+
+		prefs := y.PreferenceList().Members()
+		idx := y.PreferenceList().IndexOf(*x)
+
+		membersToReject := prefs[(idx + 1):]
+		for i := range membersToReject {
+			y.Reject(membersToReject[i])
+		}
+	}
 }
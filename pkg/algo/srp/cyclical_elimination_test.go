@@ -55,3 +55,42 @@ func TestPhase3CyclicalElimnation(t *testing.T) {
 		assert.True(t, reflect.DeepEqual(wanted, pt))
 	})
 }
+
+func TestPhase3CyclicalElimnationWithMultipleRotations(t *testing.T) {
+	// Eliminating a rotation must leave each member's new first preference
+	// holding them as their last preference, otherwise later rotations can not
+	// be detected correctly. This table has two stable matchings, so depending
+	// on the seed either one may be found.
+	testCases := []string{"P0", "P1", "P3", "P4", "P5", "P6", ""}
+
+	for tc := range testCases {
+		title := fmt.Sprintf("With seed: %v", testCases[tc])
+
+		t.Run(title, func(t *testing.T) {
+			pt := core.NewPreferenceTable(&[]core.MatchPreference{
+				{Name: "P0", Preferences: []string{"P3", "P1", "P4"}},
+				{Name: "P1", Preferences: []string{"P4", "P6", "P3", "P0", "P5"}},
+				{Name: "P2", Preferences: []string{"P7"}},
+				{Name: "P3", Preferences: []string{"P6", "P1", "P0"}},
+				{Name: "P4", Preferences: []string{"P0", "P1"}},
+				{Name: "P5", Preferences: []string{"P1", "P6"}},
+				{Name: "P6", Preferences: []string{"P5", "P1", "P3"}},
+				{Name: "P7", Preferences: []string{"P2"}},
+			})
+
+			phase3CyclicalElimnationWithSeed(&pt, testCases[tc])
+
+			assert.True(t, pt.IsComplete())
+
+			for name, member := range pt {
+				partner := member.FirstPreference()
+				assert.Equal(t, name, partner.FirstPreference().Name())
+			}
+
+			first := pt["P0"].FirstPreference().Name()
+			assert.Contains(t, []string{"P3", "P4"}, first)
+			assert.Equal(t, "P2", pt["P7"].FirstPreference().Name())
+			assert.Equal(t, "P5", pt["P6"].FirstPreference().Name())
+		})
+	}
+}
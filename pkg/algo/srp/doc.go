@@ -76,6 +76,21 @@ eliminate further cycles)
 
 C. All members have one preference remaining (Solution has been found)
 
+INCOMPLETE LISTS
+
+A variant of the problem, the "Stable Roommates Problem with Incomplete Lists"
+(SRI), allows members to omit unacceptable members from their preference lists.
+The set may also be of an odd size.
+
+A pair of members is only acceptable when both list each other, so any
+one-sided preferences are removed before the algorithm runs. In Phase 1 a
+member who exhausts their preference list no longer indicates that no solution
+exists. Instead that member stops proposing and remains unmatched. Phases 2 and
+3 then run on the remaining members.
+
+A stable solution is still NOT guaranteed, but when one exists every stable
+matching leaves the same set of members unmatched.
+
 STABILITY AND DETERMINISM
 
 A stable solution is NOT guranteed. If no further possible proposal exists in
@@ -86,6 +101,86 @@ However, if a solution does exist it is guranteed to be deterministic. That is,
 a solution will always converge on one and only one optimial mapping between
 members.
 
+MAXIMUM STABLE MATCHINGS
+
+See: Tan (1991) "A maximum stable matching for the roommates problem"
+
+When no stable solution exists, a maximum stable matching can be returned
+instead. It leaves as few members unmatched as possible, such that no two
+matched members prefer each other to their partners.
+
+It is built from a "stable partition" of the members, which always exists. A
+stable partition arranges the members in cycles, where each member prefers
+their successor in the cycle to their predecessor, and no two members prefer
+each other to their predecessors. A stable solution exists exactly when no
+cycle has an odd length greater than one. Such cycles are called "odd
+parties".
+
+The stable partition is found by running Phase 1 and Phase 2 as for incomplete
+lists. Members who exhaust their preference lists form cycles of length one.
+Phase 3 then runs as before, except that a preference cycle of odd length,
+whose members all appear as both an `x` and a `y`, is an odd party. Rejecting
+its pairs would exhaust a preference list, so its members are set aside
+instead. For example, with the preference table
+
+	A => [B, C, D]
+	B => [C, A, D]
+	C => [A, B, D]
+	D => [A, B, C]
+
+"D" is rejected by every member in Phase 1, and "A", "B" and "C" form an odd
+party in which each member's first preference is the next member of the cycle
+A -> B -> C -> A.
+
+The first member (by name) of each odd party is left unmatched, and the rest
+are matched in consecutive pairs along the cycle. Above, "B" is matched with
+"C" while "A" and "D" remain unmatched.
+
+STABLE FIXTURES
+
+See: Irving and Scott (2007) "The stable fixtures problem - A many-to-many
+extension of stable roommates"
+
+Another variant, the "Stable Fixtures Problem" (SF), allows each member to be
+matched with as many other members as their capacity, rather than just one.
+A matching is stable when no two unmatched members would both rather be
+matched with each other, because each has spare capacity or prefers the other
+to their worst match. As with incomplete lists, one-sided preferences are
+removed and the set may be of any size.
+
+Each phase generalizes to capacities as follows.
+
+In Phase 1 each member proposes in order of preference until as many of their
+proposals are held as their capacity allows, or their preference list is
+exhausted. A member holding more proposals than their capacity rejects the
+one they prefer the least.
+
+In Phase 2 each member holding as many proposals as their capacity allows
+removes those they prefer less than the worst proposal they hold.
+
+In Phase 3 preference cycles are found as before while any member has more
+preferences remaining than their capacity. The preference immediately after
+those holding the member's proposals takes the place of the 2nd preference.
+Each Yi then rejects its last preference Xi in favor of Xi-1, and removes
+those it prefers less than the worst proposal it now holds. When a member is
+left holding fewer proposals than they have made, no solution exists.
+
+Once every member has at most as many preferences remaining as their
+capacity, each member is matched with all of them. For example, with the
+following preference table where every member has a capacity of 2
+
+	A => [B, C, D, E]
+	B => [C, D, A, E]
+	C => [D, A, B, E]
+	D => [A, B, C, E]
+	E => [A, B, C, D]
+
+"E" is rejected by every member in Phase 1, and Phase 3 eliminates the cycle
+(C, D), (A, B). "A" and "B" are each matched with "C" and "D", while "E"
+remains unmatched.
+
+A stable solution is NOT guaranteed, just as for one-to-one matchings.
+
 ALGORITHM EXAMPLE
 
 See: https://www.youtube.com/watch?v=9Lo7TFAkohE
@@ -0,0 +1,287 @@
+package srp
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// heldProposals tracks the proposals currently held by each member, keyed by
+// member name.
+type heldProposals map[string][]*core.Member
+
+// runFixtures solves the "Stable Fixtures Problem" (SF), where each member may
+// be matched with as many other members as their capacity allows.
+//
+// Implements the algorithm of Irving and Scott (2007), which generalizes each
+// phase of the Irving (1985) algorithm to members with capacities. The result
+// assigns each member the list of members they are matched with, in their
+// order of preference.
+//
+// See srp package documentation for more detail
+func runFixtures(algoCtx core.AlgorithmContext) (core.MatchResult, error) {
+	var res core.MatchResult
+
+	pt := algoCtx.TableA
+	held := make(heldProposals, len(*pt))
+
+	pt.RemoveUnacceptable()
+
+	fixturesPhase1Proposal(pt, held)
+	fixturesPhase2Rejection(pt, held)
+
+	if !fixturesPhase3CyclicalElimination(pt, held) {
+		return res, errors.New("No stable solution exists")
+	}
+
+	res.Mapping = make(map[string]string)
+	res.Assignments = make(map[string][]string, len(*pt))
+
+	for name, member := range *pt {
+		prefs := member.PreferenceList().Members()
+
+		res.Assignments[name] = make([]string, len(prefs))
+		for i := range prefs {
+			res.Assignments[name][i] = prefs[i].Name()
+		}
+
+		if len(prefs) == 0 {
+			res.Unmatched = append(res.Unmatched, name)
+		}
+	}
+
+	sort.Strings(res.Unmatched)
+
+	return res, nil
+}
+
+// fixturesPhase1Proposal implements the 1st phase of the Irving and Scott
+// (2007) algorithm to solve the "Stable Fixtures Problem".
+//
+// Each member "proposes" to members in order of their preference list until
+// as many proposals as their capacity are held, or their preference list is
+// exhausted. A member holding more proposals than their capacity rejects the
+// proposal they prefer the least.
+func fixturesPhase1Proposal(pt *core.PreferenceTable, held heldProposals) {
+	for true {
+		proposers := fixturesProposers(pt, held)
+
+		if len(proposers) == 0 {
+			break
+		}
+
+		member := proposers[0]
+		simulateFixturesProposal(member, nextProposal(member, held), held)
+	}
+}
+
+// fixturesProposers returns all members, by name, that have fewer proposals
+// held than their capacity and can still make a proposal.
+func fixturesProposers(pt *core.PreferenceTable, held heldProposals) []*core.Member {
+	var proposers []*core.Member
+
+	for _, name := range pt.SortedNames() {
+		member := (*pt)[name]
+
+		if len(proposalsOf(member, held)) < member.Capacity() && nextProposal(member, held) != nil {
+			proposers = append(proposers, member)
+		}
+	}
+
+	return proposers
+}
+
+// simulateFixturesProposal simulates a proposal between two members
+func simulateFixturesProposal(proposer, proposed *core.Member, held heldProposals) {
+	held[proposed.Name()] = append(held[proposed.Name()], proposer)
+
+	if len(held[proposed.Name()]) > proposed.Capacity() {
+		// Proposed member holds too many proposals. Reject the one it prefers
+		// the least, which may be this new proposal.
+		worst := proposed.PreferenceList().LeastPreferred(held[proposed.Name()])
+		held[proposed.Name()] = core.RemoveMember(held[proposed.Name()], worst)
+		proposed.Reject(worst)
+	}
+}
+
+// fixturesPhase2Rejection implements the 2nd phase of the Irving and Scott
+// (2007) algorithm to solve the "Stable Fixtures Problem".
+//
+// Each member holding as many proposals as their capacity will remove those
+// they prefer less than the worst proposal they hold.
+func fixturesPhase2Rejection(pt *core.PreferenceTable, held heldProposals) {
+	for name, member := range *pt {
+		if len(held[name]) == member.Capacity() {
+			rejectSuccessors(member, held)
+		}
+	}
+}
+
+// fixturesPhase3CyclicalElimination implements the 3rd phase of the Irving
+// and Scott (2007) algorithm to solve the "Stable Fixtures Problem".
+//
+// While any member has more preferences remaining than their capacity,
+// preference cycles are found and eliminated as in `detectCycle` and
+// `eliminateCycle`, with each member's preference following their proposals
+// taking the place of their second preference.
+//
+// Returns false if no stable solution exists.
+func fixturesPhase3CyclicalElimination(pt *core.PreferenceTable, held heldProposals) bool {
+	for true {
+		var startingMember *core.Member
+
+		for _, name := range pt.SortedNames() {
+			member := (*pt)[name]
+
+			if len(member.PreferenceList().Members()) > member.Capacity() {
+				startingMember = member
+				break
+			}
+		}
+
+		if startingMember == nil {
+			break
+		}
+
+		pairs := detectFixturesCycle(startingMember)
+		eliminateFixturesCycle(pairs, held)
+
+		if !isFixturesTableStable(pt, held) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// detectFixturesCycle detects preference cycles in a preference table, given a
+// starting member with more preferences remaining than their capacity.
+//
+// Each `y` is the first member following the proposals of the previous `x`,
+// and each `x` is the last preference of their `y`.
+func detectFixturesCycle(startingMember *core.Member) []cyclePair {
+	pairs := []cyclePair{
+		{x: startingMember},
+	}
+
+	// Track the position (offset by one) at which each member was last seen, so
+	// that a zero value means the member has not been seen yet
+	lastSeenAt := make(map[string]int, 0)
+	lastSeenAt[startingMember.Name()] = 1
+
+	for true {
+		currentMember := pairs[len(pairs)-1].x
+		next := currentMember.PreferenceList().Members()[currentMember.Capacity()]
+
+		newPair := cyclePair{
+			x: next.LastPreference(),
+			y: next,
+		}
+
+		pairs = append(pairs, newPair)
+
+		if idx := lastSeenAt[newPair.x.Name()]; idx > 0 {
+			pairs = pairs[idx:]
+			break
+		}
+
+		lastSeenAt[newPair.x.Name()] = len(pairs)
+	}
+
+	return pairs
+}
+
+// eliminateFixturesCycle removes an identified preference cycle in a
+// preference table
+//
+// Each Yi rejects Xi, the worst proposal it holds, in favor of a proposal
+// from Xi-1. Each Yi then removes every member it prefers less than the worst
+// proposal it now holds.
+func eliminateFixturesCycle(pairs []cyclePair, held heldProposals) {
+	for p := range pairs {
+		x := pairs[(p+len(pairs)-1)%len(pairs)].x
+		y := pairs[p].y
+
+		held[y.Name()] = append(core.RemoveMember(held[y.Name()], pairs[p].x), x)
+		y.Reject(pairs[p].x)
+	}
+
+	for p := range pairs {
+		rejectSuccessors(pairs[p].y, held)
+	}
+}
+
+// isFixturesTableStable evaluates whether each member still holds as many
+// proposals as they have made, and whether every held proposal comes from a
+// member that remains on their preference list. Otherwise eliminating a cycle
+// has left a member with fewer matches than every stable matching requires.
+func isFixturesTableStable(pt *core.PreferenceTable, held heldProposals) bool {
+	for name, member := range *pt {
+		pl := member.PreferenceList()
+
+		for i := range held[name] {
+			if pl.IndexOf(*held[name][i]) == -1 {
+				return false
+			}
+		}
+
+		wanted := member.Capacity()
+		if len(pl.Members()) < wanted {
+			wanted = len(pl.Members())
+		}
+
+		if len(held[name]) != wanted || len(proposalsOf(member, held)) != wanted {
+			return false
+		}
+	}
+
+	return true
+}
+
+// rejectSuccessors removes every member that a member prefers less than the
+// worst proposal it holds.
+func rejectSuccessors(member *core.Member, held heldProposals) {
+	worst := member.PreferenceList().LeastPreferred(held[member.Name()])
+	if worst == nil {
+		return
+	}
+
+	prefs := member.PreferenceList().Members()
+	idx := member.PreferenceList().IndexOf(*worst)
+
+	membersToReject := prefs[(idx + 1):]
+	for i := range membersToReject {
+		member.Reject(membersToReject[i])
+	}
+}
+
+// nextProposal returns the first member on a member's preference list that
+// does not hold a proposal from them, or nil if there is none.
+func nextProposal(member *core.Member, held heldProposals) *core.Member {
+	prefs := member.PreferenceList().Members()
+
+	for i := range prefs {
+		if core.IndexOfMember(held[prefs[i].Name()], member) == -1 {
+			return prefs[i]
+		}
+	}
+
+	return nil
+}
+
+// proposalsOf returns the members on a member's preference list that hold a
+// proposal from them
+func proposalsOf(member *core.Member, held heldProposals) []*core.Member {
+	var members []*core.Member
+
+	prefs := member.PreferenceList().Members()
+
+	for i := range prefs {
+		if core.IndexOfMember(held[prefs[i].Name()], member) != -1 {
+			members = append(members, prefs[i])
+		}
+	}
+
+	return members
+}
@@ -0,0 +1,257 @@
+package srp
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+// heldNames returns the names of the members whose proposals are held by each
+// member
+func heldNames(held heldProposals) map[string][]string {
+	names := make(map[string][]string, len(held))
+
+	for name := range held {
+		names[name] = make([]string, len(held[name]))
+		for i := range held[name] {
+			names[name][i] = held[name][i].Name()
+		}
+	}
+
+	return names
+}
+
+func TestRunFixtures(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		pt := core.NewPreferenceTable(&[]core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "C", "D", "E"}, Capacity: 2},
+			{Name: "B", Preferences: []string{"C", "D", "A", "E"}, Capacity: 2},
+			{Name: "C", Preferences: []string{"D", "A", "B", "E"}, Capacity: 2},
+			{Name: "D", Preferences: []string{"A", "B", "C", "E"}, Capacity: 2},
+			{Name: "E", Preferences: []string{"A", "B", "C", "D"}, Capacity: 2},
+		})
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &pt,
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{},
+			Assignments: map[string][]string{
+				"A": {"C", "D"},
+				"B": {"C", "D"},
+				"C": {"A", "B"},
+				"D": {"A", "B"},
+				"E": {},
+			},
+			Unmatched: []string{"E"},
+		}
+
+		result, err := runFixtures(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("no stable solution exists", func(t *testing.T) {
+		pt := core.NewPreferenceTable(&[]core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "C", "D"}, Capacity: 2},
+			{Name: "B", Preferences: []string{"A", "D", "C"}},
+			{Name: "C", Preferences: []string{"B", "D", "A"}},
+			{Name: "D", Preferences: []string{"A", "C", "B"}},
+		})
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &pt,
+		}
+
+		_, err := runFixtures(algoCtx)
+
+		assert.Equal(t, "No stable solution exists", err.Error())
+	})
+
+	t.Run("incomplete lists", func(t *testing.T) {
+		pt := core.NewPreferenceTable(&[]core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "C", "D"}, Capacity: 3},
+			{Name: "B", Preferences: []string{"A", "C"}, Capacity: 2},
+			{Name: "C", Preferences: []string{"B"}},
+			{Name: "D", Preferences: []string{}},
+		})
+
+		algoCtx := core.AlgorithmContext{
+			TableA:          &pt,
+			IncompleteLists: true,
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{},
+			Assignments: map[string][]string{
+				"A": {"B"},
+				"B": {"A", "C"},
+				"C": {"B"},
+				"D": {},
+			},
+			Unmatched: []string{"D"},
+		}
+
+		result, err := runFixtures(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+}
+
+func TestFixturesPhase1Proposal(t *testing.T) {
+	pt := core.NewPreferenceTable(&[]core.MatchPreference{
+		{Name: "A", Preferences: []string{"B", "C", "D", "E"}, Capacity: 2},
+		{Name: "B", Preferences: []string{"C", "D", "A", "E"}, Capacity: 2},
+		{Name: "C", Preferences: []string{"D", "A", "B", "E"}, Capacity: 2},
+		{Name: "D", Preferences: []string{"A", "B", "C", "E"}, Capacity: 2},
+		{Name: "E", Preferences: []string{"A", "B", "C", "D"}, Capacity: 2},
+	})
+
+	wanted := core.NewPreferenceTable(&[]core.MatchPreference{
+		{Name: "A", Preferences: []string{"B", "C", "D"}, Capacity: 2},
+		{Name: "B", Preferences: []string{"C", "D", "A"}, Capacity: 2},
+		{Name: "C", Preferences: []string{"D", "A", "B"}, Capacity: 2},
+		{Name: "D", Preferences: []string{"A", "B", "C"}, Capacity: 2},
+		{Name: "E", Preferences: []string{}, Capacity: 2},
+	})
+
+	held := make(heldProposals)
+	fixturesPhase1Proposal(&pt, held)
+
+	assert.True(t, reflect.DeepEqual(wanted, pt))
+	assert.Equal(t, map[string][]string{
+		"A": {"C", "D"},
+		"B": {"A", "D"},
+		"C": {"A", "B"},
+		"D": {"B", "C"},
+	}, heldNames(held))
+}
+
+func TestFixturesPhase2Rejection(t *testing.T) {
+	pt := core.NewPreferenceTable(&[]core.MatchPreference{
+		{Name: "A", Preferences: []string{"B", "C", "D", "E", "F"}, Capacity: 2},
+		{Name: "B", Preferences: []string{"C", "A", "E", "F", "D"}, Capacity: 2},
+		{Name: "C", Preferences: []string{"A", "B", "F", "D", "E"}, Capacity: 2},
+		{Name: "D", Preferences: []string{"E", "F", "A", "B", "C"}, Capacity: 3},
+		{Name: "E", Preferences: []string{"F", "D", "B", "C", "A"}, Capacity: 2},
+		{Name: "F", Preferences: []string{"D", "E", "C", "A", "B"}},
+	})
+
+	held := make(heldProposals)
+	fixturesPhase1Proposal(&pt, held)
+
+	// "F" holds as many proposals as its capacity allows, and rejects everyone
+	// it prefers less than "D". "D" holds fewer and rejects no one.
+	wanted := core.NewPreferenceTable(&[]core.MatchPreference{
+		{Name: "A", Preferences: []string{"B", "C"}, Capacity: 2},
+		{Name: "B", Preferences: []string{"C", "A"}, Capacity: 2},
+		{Name: "C", Preferences: []string{"A", "B"}, Capacity: 2},
+		{Name: "D", Preferences: []string{"E", "F"}, Capacity: 3},
+		{Name: "E", Preferences: []string{"D"}, Capacity: 2},
+		{Name: "F", Preferences: []string{"D"}},
+	})
+
+	fixturesPhase2Rejection(&pt, held)
+
+	assert.True(t, reflect.DeepEqual(wanted, pt))
+}
+
+func TestDetectFixturesCycle(t *testing.T) {
+	pt := core.NewPreferenceTable(&[]core.MatchPreference{
+		{Name: "A", Preferences: []string{"B", "C", "D"}, Capacity: 2},
+		{Name: "B", Preferences: []string{"C", "D", "A"}, Capacity: 2},
+		{Name: "C", Preferences: []string{"D", "A", "B"}, Capacity: 2},
+		{Name: "D", Preferences: []string{"A", "B", "C"}, Capacity: 2},
+	})
+
+	pairs := detectFixturesCycle(pt["A"])
+
+	wanted := []cyclePair{
+		{x: pt["C"], y: pt["D"]},
+		{x: pt["A"], y: pt["B"]},
+	}
+
+	assert.Equal(t, wanted, pairs)
+}
+
+func TestEliminateFixturesCycle(t *testing.T) {
+	pt := core.NewPreferenceTable(&[]core.MatchPreference{
+		{Name: "A", Preferences: []string{"B", "C", "D"}, Capacity: 2},
+		{Name: "B", Preferences: []string{"C", "D", "A"}, Capacity: 2},
+		{Name: "C", Preferences: []string{"D", "A", "B"}, Capacity: 2},
+		{Name: "D", Preferences: []string{"A", "B", "C"}, Capacity: 2},
+	})
+
+	held := heldProposals{
+		"A": {pt["C"], pt["D"]},
+		"B": {pt["A"], pt["D"]},
+		"C": {pt["A"], pt["B"]},
+		"D": {pt["B"], pt["C"]},
+	}
+
+	pairs := []cyclePair{
+		{x: pt["C"], y: pt["D"]},
+		{x: pt["A"], y: pt["B"]},
+	}
+
+	eliminateFixturesCycle(pairs, held)
+
+	wanted := core.NewPreferenceTable(&[]core.MatchPreference{
+		{Name: "A", Preferences: []string{"C", "D"}, Capacity: 2},
+		{Name: "B", Preferences: []string{"C", "D"}, Capacity: 2},
+		{Name: "C", Preferences: []string{"A", "B"}, Capacity: 2},
+		{Name: "D", Preferences: []string{"A", "B"}, Capacity: 2},
+	})
+
+	assert.True(t, reflect.DeepEqual(wanted, pt))
+	assert.Equal(t, map[string][]string{
+		"A": {"C", "D"},
+		"B": {"D", "C"},
+		"C": {"A", "B"},
+		"D": {"B", "A"},
+	}, heldNames(held))
+	assert.True(t, isFixturesTableStable(&pt, held))
+}
+
+func TestIsFixturesTableStable(t *testing.T) {
+	pt := core.NewPreferenceTable(&[]core.MatchPreference{
+		{Name: "A", Preferences: []string{"B", "C"}, Capacity: 2},
+		{Name: "B", Preferences: []string{"A"}},
+		{Name: "C", Preferences: []string{"A"}},
+	})
+
+	t.Run("returns true", func(t *testing.T) {
+		held := heldProposals{
+			"A": {pt["B"], pt["C"]},
+			"B": {pt["A"]},
+			"C": {pt["A"]},
+		}
+
+		assert.True(t, isFixturesTableStable(&pt, held))
+	})
+
+	t.Run("returns false when a proposal is missing", func(t *testing.T) {
+		held := heldProposals{
+			"A": {pt["B"]},
+			"B": {pt["A"]},
+			"C": {pt["A"]},
+		}
+
+		assert.False(t, isFixturesTableStable(&pt, held))
+	})
+
+	t.Run("returns false when a proposer was rejected", func(t *testing.T) {
+		held := heldProposals{
+			"A": {pt["B"], pt["C"]},
+			"B": {pt["C"]},
+			"C": {pt["A"]},
+		}
+
+		assert.False(t, isFixturesTableStable(&pt, held))
+	})
+}
@@ -37,6 +37,42 @@ func phase1Proposal(pt *core.PreferenceTable) bool {
 	return true
 }
 
+// phase1ProposalWithIncompleteLists implements the 1st phase of the Irving
+// (1985) algorithm, adapted for preference lists that are incomplete.
+//
+// Proposals are made exactly as in `phase1Proposal`, except that a member who
+// exhausts their preference list stops proposing and remains unmatched. This
+// does not indicate that no stable solution exists.
+func phase1ProposalWithIncompleteLists(pt *core.PreferenceTable) {
+	for true {
+		proposers := unmatchedProposers(pt)
+
+		if len(proposers) == 0 {
+			break
+		}
+
+		member := proposers[0]
+		topChoice := member.FirstPreference()
+		simulateProposal(member, topChoice)
+	}
+}
+
+// unmatchedProposers returns all unmatched members that have not yet exhausted
+// their preference list, and can therefore still make a proposal.
+func unmatchedProposers(pt *core.PreferenceTable) []*core.Member {
+	var proposers []*core.Member
+
+	unmatchedMembers := pt.UnmatchedMembers()
+
+	for i := range unmatchedMembers {
+		if unmatchedMembers[i].FirstPreference() != nil {
+			proposers = append(proposers, unmatchedMembers[i])
+		}
+	}
+
+	return proposers
+}
+
 // isStable evaluates the preference table and determines whether it is
 // "stable". A table is stable when all members' preference lists are non-empty.
 func isStable(pt *core.PreferenceTable) bool {
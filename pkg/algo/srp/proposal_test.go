@@ -60,6 +60,25 @@ func TestPhase1Proposal(t *testing.T) {
 	})
 }
 
+func TestPhase1ProposalWithIncompleteLists(t *testing.T) {
+	pt := core.NewPreferenceTable(&[]core.MatchPreference{
+		{Name: "A", Preferences: []string{"B", "C", "D"}},
+		{Name: "B", Preferences: []string{"A", "C"}},
+		{Name: "C", Preferences: []string{"A", "B"}},
+		{Name: "D", Preferences: []string{"A"}},
+	})
+
+	phase1ProposalWithIncompleteLists(&pt)
+
+	// "C" and "D" are rejected by everyone on their lists and remain unmatched
+	assert.Equal(t, pt["B"], pt["A"].CurrentProposer())
+	assert.Equal(t, pt["A"], pt["B"].CurrentProposer())
+	assert.Nil(t, pt["C"].CurrentProposer())
+	assert.Nil(t, pt["D"].CurrentProposer())
+	assert.Equal(t, 0, len(pt["C"].PreferenceList().Members()))
+	assert.Equal(t, 0, len(pt["D"].PreferenceList().Members()))
+}
+
 func TestIsStable(t *testing.T) {
 	pt := core.NewPreferenceTable(&[]core.MatchPreference{
 		{Name: "A", Preferences: []string{"B", "C", "D"}},
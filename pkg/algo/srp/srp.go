@@ -2,27 +2,107 @@ package srp
 
 import (
 	"errors"
+	"sort"
+
 	"github.com/abhchand/libmatch/pkg/core"
 )
 
 // Run solves the "Stable Roommates Problem" (SRP) for a set of given inputs.
 //
+// When the algorithm context specifies incomplete preference lists, members
+// who exhaust their preference lists in the first phase are reported as
+// unmatched, and the remaining phases run on the other members.
+//
+// When the algorithm context specifies a maximum stable matching, the stable
+// partition of Tan (1991) is computed instead, and the members left out of
+// the resulting matching are reported as unmatched. No error is returned in
+// this case, even when no stable matching exists.
+//
+// When any member has a capacity greater than 1, the "Stable Fixtures
+// Problem" (SF) is solved instead, and the result assigns each member the list
+// of members they are matched with. Maximum stable matchings are not supported
+// in this case.
+//
 // See srp package documentation for an end-to-end example
 func Run(algoCtx core.AlgorithmContext) (core.MatchResult, error) {
 	var res core.MatchResult
+	var unmatched []string
+
 	pt := algoCtx.TableA
 
-	if !phase1Proposal(pt) {
+	if hasCapacities(pt) {
+		if algoCtx.MaximumStableMatching {
+			return res, errors.New("Maximum stable matchings are not supported for members with a capacity")
+		}
+
+		return runFixtures(algoCtx)
+	}
+
+	if algoCtx.MaximumStableMatching {
+		if algoCtx.IncompleteLists {
+			pt.RemoveUnacceptable()
+		}
+
+		res.Mapping, res.Unmatched = findStablePartition(pt).maximumStableMatching()
+
+		return res, nil
+	}
+
+	if algoCtx.IncompleteLists {
+		pt.RemoveUnacceptable()
+		phase1ProposalWithIncompleteLists(pt)
+		pt, unmatched = splitUnmatched(pt)
+	} else if !phase1Proposal(pt) {
 		return res, errors.New("No stable solution exists")
 	}
 
 	phase2Rejection(pt)
 	phase3CyclicalElimnation(pt)
 
+	if !pt.IsStable() {
+		return res, errors.New("No stable solution exists")
+	}
+
 	res.Mapping = make(map[string]string)
 	for name, member := range *pt {
 		res.Mapping[name] = member.PreferenceList().Members()[0].Name()
 	}
 
+	res.Unmatched = unmatched
+
 	return res, nil
 }
+
+// hasCapacities indicates whether any member of a preference table may be
+// matched with more than one other member.
+func hasCapacities(pt *core.PreferenceTable) bool {
+	for _, member := range *pt {
+		if member.Capacity() > 1 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// splitUnmatched separates members who have exhausted their preference lists
+// from the rest of the preference table. It returns a new table containing
+// only the members who can still be matched, along with the sorted names of
+// the unmatched members.
+func splitUnmatched(pt *core.PreferenceTable) (*core.PreferenceTable, []string) {
+	var unmatched []string
+
+	remaining := make(core.PreferenceTable, len(*pt))
+
+	for name, member := range *pt {
+		if len(member.PreferenceList().Members()) == 0 {
+			unmatched = append(unmatched, name)
+		} else {
+			remaining[name] = member
+		}
+	}
+
+	sort.Strings(unmatched)
+
+	return &remaining, unmatched
+}
@@ -57,4 +57,183 @@ func TestRun(t *testing.T) {
 
 		assert.Equal(t, "No stable solution exists", err.Error())
 	})
+
+	t.Run("incomplete lists", func(t *testing.T) {
+		pt := core.NewPreferenceTable(&[]core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "C", "D"}},
+			{Name: "B", Preferences: []string{"A", "C"}},
+			{Name: "C", Preferences: []string{"A", "B"}},
+			{Name: "D", Preferences: []string{"A"}},
+			{Name: "E", Preferences: []string{}},
+		})
+
+		algoCtx := core.AlgorithmContext{
+			TableA:          &pt,
+			IncompleteLists: true,
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "B",
+				"B": "A",
+			},
+			Unmatched: []string{"C", "D", "E"},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("maximum stable matching", func(t *testing.T) {
+		pt := core.NewPreferenceTable(&[]core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "C", "D"}},
+			{Name: "B", Preferences: []string{"C", "A", "D"}},
+			{Name: "C", Preferences: []string{"A", "B", "D"}},
+			{Name: "D", Preferences: []string{"A", "B", "C"}},
+		})
+
+		algoCtx := core.AlgorithmContext{
+			TableA:                &pt,
+			MaximumStableMatching: true,
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"B": "C",
+				"C": "B",
+			},
+			Unmatched: []string{"A", "D"},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("maximum stable matching when a stable matching exists", func(t *testing.T) {
+		pt := core.NewPreferenceTable(&[]core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "C", "D"}},
+			{Name: "B", Preferences: []string{"A", "C", "D"}},
+			{Name: "C", Preferences: []string{"A", "B", "D"}},
+			{Name: "D", Preferences: []string{"A", "B", "C"}},
+		})
+
+		algoCtx := core.AlgorithmContext{
+			TableA:                &pt,
+			MaximumStableMatching: true,
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "B",
+				"B": "A",
+				"C": "D",
+				"D": "C",
+			},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("maximum stable matching with incomplete lists", func(t *testing.T) {
+		pt := core.NewPreferenceTable(&[]core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "C"}},
+			{Name: "B", Preferences: []string{"C", "A"}},
+			{Name: "C", Preferences: []string{"A", "B", "E"}},
+			{Name: "D", Preferences: []string{"E"}},
+			{Name: "E", Preferences: []string{"D"}},
+		})
+
+		algoCtx := core.AlgorithmContext{
+			TableA:                &pt,
+			IncompleteLists:       true,
+			MaximumStableMatching: true,
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"B": "C",
+				"C": "B",
+				"D": "E",
+				"E": "D",
+			},
+			Unmatched: []string{"A"},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("capacities", func(t *testing.T) {
+		pt := core.NewPreferenceTable(&[]core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "C", "D"}, Capacity: 2},
+			{Name: "B", Preferences: []string{"C", "D", "A"}, Capacity: 2},
+			{Name: "C", Preferences: []string{"D", "A", "B"}, Capacity: 2},
+			{Name: "D", Preferences: []string{"A", "B", "C"}, Capacity: 2},
+		})
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &pt,
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{},
+			Assignments: map[string][]string{
+				"A": {"C", "D"},
+				"B": {"C", "D"},
+				"C": {"A", "B"},
+				"D": {"A", "B"},
+			},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("capacities with maximum stable matching", func(t *testing.T) {
+		pt := core.NewPreferenceTable(&[]core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "C", "D"}, Capacity: 2},
+			{Name: "B", Preferences: []string{"C", "D", "A"}, Capacity: 2},
+			{Name: "C", Preferences: []string{"D", "A", "B"}, Capacity: 2},
+			{Name: "D", Preferences: []string{"A", "B", "C"}, Capacity: 2},
+		})
+
+		algoCtx := core.AlgorithmContext{
+			TableA:                &pt,
+			MaximumStableMatching: true,
+		}
+
+		_, err := Run(algoCtx)
+
+		assert.Equal(t, "Maximum stable matchings are not supported for members with a capacity", err.Error())
+	})
+}
+
+func TestSplitUnmatched(t *testing.T) {
+	pt := core.NewPreferenceTable(&[]core.MatchPreference{
+		{Name: "A", Preferences: []string{"B"}},
+		{Name: "B", Preferences: []string{"A"}},
+		{Name: "C", Preferences: []string{"A"}},
+		{Name: "D", Preferences: []string{"B"}},
+	})
+
+	pt["C"].Reject(pt["A"])
+	pt["D"].Reject(pt["B"])
+
+	remaining, unmatched := splitUnmatched(&pt)
+
+	assert.Equal(t, []string{"C", "D"}, unmatched)
+	assert.Equal(t, 2, len(*remaining))
+	assert.Equal(t, pt["A"], (*remaining)["A"])
+	assert.Equal(t, pt["B"], (*remaining)["B"])
 }
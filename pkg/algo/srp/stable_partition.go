@@ -0,0 +1,163 @@
+package srp
+
+import (
+	"sort"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// stablePartition is a stable partition of the members of an SRP instance as
+// described by Tan (1991). Every member belongs to exactly one cycle, and each
+// member of a cycle prefers their successor in the cycle to their
+// predecessor.
+//
+// A stable matching exists exactly when no cycle has an odd length greater
+// than one. Those cycles are called "odd parties", and are the same in every
+// stable partition of an instance.
+type stablePartition struct {
+	// pairs maps each member of a cycle of length two to the other member
+	pairs map[string]string
+
+	// singles lists the members of cycles of length one, who are unmatched in
+	// every stable partition
+	singles []string
+
+	// oddParties lists the members of each odd party in cycle order, so that
+	// each member's successor is their first choice among the party
+	oddParties [][]string
+}
+
+// findStablePartition computes a stable partition of a preference table.
+//
+// The first two phases run exactly as for incomplete lists, and members who
+// exhaust their preference list form cycles of length one. The third phase
+// eliminates preference cycles as before, except that a cycle whose members
+// appear as both the `x` and the `y` of its pairs is an odd party. Eliminating
+// it would exhaust a preference list, so its members are set aside instead.
+// Tan (1991) shows that the members of an odd party have no other members
+// remaining on their preference lists at that point.
+func findStablePartition(pt *core.PreferenceTable) stablePartition {
+	var partition stablePartition
+
+	phase1ProposalWithIncompleteLists(pt)
+	pt, partition.singles = splitUnmatched(pt)
+	phase2Rejection(pt)
+
+	for !pt.IsComplete() {
+		var startingMember *core.Member
+
+		// Find the first member with at least two preferences, by name so that
+		// odd parties are found deterministically
+		for _, name := range pt.SortedNames() {
+			if len((*pt)[name].PreferenceList().Members()) >= 2 {
+				startingMember = (*pt)[name]
+				break
+			}
+		}
+
+		pairs := detectCycle(pt, startingMember)
+
+		if isOddParty(pairs) {
+			partition.oddParties = append(partition.oddParties, setAsideOddParty(pt, pairs))
+			continue
+		}
+
+		eliminateCycle(pt, pairs)
+	}
+
+	partition.pairs = make(map[string]string, len(*pt))
+	for name, member := range *pt {
+		partition.pairs[name] = member.FirstPreference().Name()
+	}
+
+	return partition
+}
+
+// isOddParty indicates whether a preference cycle is an odd party, that is
+// every member of the cycle appears both as an `x` and as a `y`.
+func isOddParty(pairs []cyclePair) bool {
+	if len(pairs)%2 == 0 {
+		return false
+	}
+
+	xs := make(map[string]bool, len(pairs))
+	for p := range pairs {
+		xs[pairs[p].x.Name()] = true
+	}
+
+	for p := range pairs {
+		if !xs[pairs[p].y.Name()] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// setAsideOddParty removes the members of an odd party from a preference
+// table. It returns the members in cycle order, starting with the first by
+// name and following each member's first preference.
+func setAsideOddParty(pt *core.PreferenceTable, pairs []cyclePair) []string {
+	var party []string
+
+	start := pairs[0].x
+	for p := range pairs {
+		if pairs[p].x.Name() < start.Name() {
+			start = pairs[p].x
+		}
+	}
+
+	for m := start; ; {
+		party = append(party, m.Name())
+
+		if m = m.FirstPreference(); m.Name() == start.Name() {
+			break
+		}
+	}
+
+	for i := range party {
+		member := (*pt)[party[i]]
+		prefs := member.PreferenceList().Members()
+
+		for j := range prefs {
+			member.Reject(prefs[j])
+		}
+
+		delete(*pt, party[i])
+	}
+
+	return party
+}
+
+// maximumStableMatching constructs a maximum stable matching from a stable
+// partition, as described by Tan (1991).
+//
+// Members of cycles of length two are matched with each other. The first
+// member of each odd party is left unmatched, and the remaining members are
+// matched in consecutive pairs along the cycle. Every member is then matched
+// with either their successor or their predecessor, so no two matched members
+// prefer each other to their partners. Exactly one member per odd party is
+// unmatched, which is the fewest possible.
+func (partition stablePartition) maximumStableMatching() (map[string]string, []string) {
+	var unmatched []string
+
+	mapping := make(map[string]string, len(partition.pairs))
+	unmatched = append(unmatched, partition.singles...)
+
+	for a, b := range partition.pairs {
+		mapping[a] = b
+	}
+
+	for _, party := range partition.oddParties {
+		unmatched = append(unmatched, party[0])
+
+		for i := 1; i+1 < len(party); i += 2 {
+			mapping[party[i]] = party[i+1]
+			mapping[party[i+1]] = party[i]
+		}
+	}
+
+	sort.Strings(unmatched)
+
+	return mapping, unmatched
+}
@@ -0,0 +1,167 @@
+package srp
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindStablePartition(t *testing.T) {
+	t.Run("stable matching exists", func(t *testing.T) {
+		pt := core.NewPreferenceTable(&[]core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "C", "D"}},
+			{Name: "B", Preferences: []string{"A", "C", "D"}},
+			{Name: "C", Preferences: []string{"A", "B", "D"}},
+			{Name: "D", Preferences: []string{"A", "B", "C"}},
+		})
+
+		wanted := stablePartition{
+			pairs: map[string]string{
+				"A": "B",
+				"B": "A",
+				"C": "D",
+				"D": "C",
+			},
+		}
+
+		assert.True(t, reflect.DeepEqual(wanted, findStablePartition(&pt)))
+	})
+
+	t.Run("odd party", func(t *testing.T) {
+		/*
+		 * A, B and C each prefer the next member of the cycle A -> B -> C -> A,
+		 * and everyone prefers D the least. D is rejected by everyone, and the
+		 * other three form an odd party.
+		 */
+		pt := core.NewPreferenceTable(&[]core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "C", "D"}},
+			{Name: "B", Preferences: []string{"C", "A", "D"}},
+			{Name: "C", Preferences: []string{"A", "B", "D"}},
+			{Name: "D", Preferences: []string{"A", "B", "C"}},
+		})
+
+		wanted := stablePartition{
+			pairs:      map[string]string{},
+			singles:    []string{"D"},
+			oddParties: [][]string{{"A", "B", "C"}},
+		}
+
+		assert.True(t, reflect.DeepEqual(wanted, findStablePartition(&pt)))
+	})
+
+	t.Run("odd party found in phase 3", func(t *testing.T) {
+		pt := core.NewPreferenceTable(&[]core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "E", "C", "F", "D"}},
+			{Name: "B", Preferences: []string{"C", "F", "E", "A", "D"}},
+			{Name: "C", Preferences: []string{"E", "A", "F", "D", "B"}},
+			{Name: "D", Preferences: []string{"B", "A", "C", "F", "E"}},
+			{Name: "E", Preferences: []string{"A", "C", "D", "B", "F"}},
+			{Name: "F", Preferences: []string{"C", "A", "E", "B", "D"}},
+		})
+
+		wanted := stablePartition{
+			pairs:      map[string]string{},
+			singles:    []string{"D"},
+			oddParties: [][]string{{"A", "B", "F", "C", "E"}},
+		}
+
+		assert.True(t, reflect.DeepEqual(wanted, findStablePartition(&pt)))
+	})
+
+	t.Run("incomplete lists", func(t *testing.T) {
+		pt := core.NewPreferenceTable(&[]core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "C"}},
+			{Name: "B", Preferences: []string{"C", "A"}},
+			{Name: "C", Preferences: []string{"A", "B"}},
+			{Name: "D", Preferences: []string{"E"}},
+			{Name: "E", Preferences: []string{"D"}},
+		})
+
+		wanted := stablePartition{
+			pairs: map[string]string{
+				"D": "E",
+				"E": "D",
+			},
+			oddParties: [][]string{{"A", "B", "C"}},
+		}
+
+		assert.True(t, reflect.DeepEqual(wanted, findStablePartition(&pt)))
+	})
+}
+
+func TestIsOddParty(t *testing.T) {
+	pt := core.NewPreferenceTable(&[]core.MatchPreference{
+		{Name: "A", Preferences: []string{"B", "C", "D"}},
+		{Name: "B", Preferences: []string{"A", "C", "D"}},
+		{Name: "C", Preferences: []string{"A", "B", "D"}},
+		{Name: "D", Preferences: []string{"A", "B", "C"}},
+	})
+
+	memberA := pt["A"]
+	memberB := pt["B"]
+	memberC := pt["C"]
+	memberD := pt["D"]
+
+	t.Run("odd cycle of the same members", func(t *testing.T) {
+		pairs := []cyclePair{
+			{x: memberA, y: memberB},
+			{x: memberB, y: memberC},
+			{x: memberC, y: memberA},
+		}
+
+		assert.True(t, isOddParty(pairs))
+	})
+
+	t.Run("even cycle of the same members", func(t *testing.T) {
+		pairs := []cyclePair{
+			{x: memberA, y: memberB},
+			{x: memberB, y: memberC},
+			{x: memberC, y: memberD},
+			{x: memberD, y: memberA},
+		}
+
+		assert.False(t, isOddParty(pairs))
+	})
+
+	t.Run("cycle of different members", func(t *testing.T) {
+		pairs := []cyclePair{
+			{x: memberA, y: memberB},
+			{x: memberC, y: memberD},
+			{x: memberB, y: memberA},
+		}
+
+		assert.False(t, isOddParty(pairs))
+	})
+}
+
+func TestMaximumStableMatching(t *testing.T) {
+	partition := stablePartition{
+		pairs: map[string]string{
+			"G": "H",
+			"H": "G",
+		},
+		singles: []string{"I"},
+		oddParties: [][]string{
+			{"B", "D", "A", "E", "C"},
+			{"F", "J", "K"},
+		},
+	}
+
+	mapping, unmatched := partition.maximumStableMatching()
+
+	wantedMapping := map[string]string{
+		"A": "D",
+		"C": "E",
+		"D": "A",
+		"E": "C",
+		"G": "H",
+		"H": "G",
+		"J": "K",
+		"K": "J",
+	}
+
+	assert.Equal(t, wantedMapping, mapping)
+	assert.Equal(t, []string{"B", "F", "I"}, unmatched)
+}
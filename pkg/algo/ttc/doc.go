@@ -0,0 +1,94 @@
+/*
+Package ttc implements the "Top Trading Cycles" algorithm (TTC).
+
+It implements the algorithm of Gale, as described by Shapley and Scarf (1974)
+for housing markets, where each student owns a house and students trade houses
+among themselves. It also implements the generalization to school choice by
+Abdulkadiroğlu and Sönmez (2003), where each school has a capacity and ranks
+students by priority.
+
+Unlike the deferred acceptance algorithms, the matching is not stable. Instead
+it is Pareto efficient: no student can be given a school they prefer without
+giving another student a school they like less.
+
+ALGORITHM
+
+See: https://en.wikipedia.org/wiki/Top_trading_cycle
+
+The algorithm runs in rounds until no student can be assigned a school.
+
+In each round every remaining student "points" to the top remaining school on
+their list, and every school points to a student -
+
+1. The school's owner, while the owner has not been assigned a school
+
+2. Otherwise, the remaining student the school gives the highest priority
+
+3. Otherwise, the first remaining student by name, since the school does not
+rank any of the remaining students
+
+Since every student and every school points somewhere, at least one cycle of
+students and schools must exist. Each student in the cycle is assigned the
+school they point to and leaves. A school with no seats left is removed from
+every preference list.
+
+Students who exhaust their preference list are left unmatched.
+
+HOUSING MARKETS
+
+In a housing market, every school is a house with a capacity of 1 that is
+owned by a student and ranks no one. A house points to its owner until they
+leave, so a student who ranks the house they own is never assigned a house
+they like less.
+
+When every student owns exactly one house and ranks it, the result is the
+unique allocation in the "core" of the market: no group of students can trade
+the houses they own among themselves to make each of them better off.
+
+DETERMINISM
+
+Cycles never share a student or a school, so the order in which they are found
+does not change the result. Priorities are strict, so the result is always
+deterministic.
+
+ALGORITHM EXAMPLE
+
+Take the following preference tables
+
+	// students
+	S1 => [X, Y, Z]
+	S2 => [X, Z, Y]
+	S3 => [Y, X, Z]
+	S4 => [X, Y]
+	S5 => [Z, X]
+
+	// schools (capacity)
+	X (2) => [S3, S5, S1, S2, S4]
+	Y (1) => [S1, S2, S3, S4, S5]
+	Z (2) => [S4, S2, S1, S3, S5]
+
+In the first round, starting from "S1", the pointers form the cycle
+
+	S1 -> X -> S3 -> Y -> S1
+
+"S1" is assigned "X" and "S3" is assigned "Y". Since "Y" has no seats left, it
+is removed from every preference list.
+
+In the second round, starting from "S2", the pointers form the cycle
+
+	S5 -> Z -> S4 -> X -> S5
+
+"S5" is assigned "Z" and "S4" is assigned "X", which now has no seats left.
+
+In the third round "S2" points to "Z", which points back to "S2" as its
+highest priority remaining student. "S2" is assigned "Z".
+
+This gives us the following assignments
+
+	S1 => X
+	S2 => Z
+	S3 => Y
+	S4 => X
+	S5 => Z
+*/
+package ttc
@@ -0,0 +1,128 @@
+package ttc
+
+import (
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// assignments tracks the school each student has been assigned to, keyed by
+// student name.
+type assignments map[string]*core.Member
+
+// tradingPair is a student in a trading cycle, along with the school they
+// point to.
+type tradingPair struct {
+	student, school *core.Member
+}
+
+// topTradingCycles implements the Top Trading Cycles algorithm of Shapley and
+// Scarf (1974), as generalized to school choice by Abdulkadiroğlu and Sönmez
+// (2003).
+//
+// Each remaining student points to their top remaining school, and each
+// remaining school points to its owner or, failing that, to the remaining
+// student it gives the highest priority. At least one cycle must exist, and
+// every student in it is assigned the school they point to. Schools with no
+// seats left are removed from every preference list.
+//
+// See ttc package documentation for more detail
+func topTradingCycles(ptS, ptC *core.PreferenceTable) assignments {
+	assigned := make(assignments, len(*ptS))
+
+	seats := make(map[string]int, len(*ptC))
+	for name, school := range *ptC {
+		seats[name] = school.Capacity()
+	}
+
+	for true {
+		students := remainingStudents(ptS, assigned)
+
+		if len(students) == 0 {
+			break
+		}
+
+		for _, pair := range detectCycle(students[0], students, assigned) {
+			assigned[pair.student.Name()] = pair.school
+			seats[pair.school.Name()]--
+
+			if seats[pair.school.Name()] == 0 {
+				closeSchool(ptS, pair.school)
+			}
+		}
+	}
+
+	return assigned
+}
+
+// closeSchool removes a school that has no seats left from the preference
+// list of every student.
+func closeSchool(ptS *core.PreferenceTable, school *core.Member) {
+	for _, student := range *ptS {
+		student.PreferenceList().Remove(*school)
+	}
+}
+
+// remainingStudents returns all students, by name, that have not been
+// assigned a school and still have schools left to point to.
+func remainingStudents(ptS *core.PreferenceTable, assigned assignments) []*core.Member {
+	var students []*core.Member
+
+	for _, name := range ptS.SortedNames() {
+		student := (*ptS)[name]
+
+		if isRemaining(student, assigned) {
+			students = append(students, student)
+		}
+	}
+
+	return students
+}
+
+// isRemaining indicates whether a student has not been assigned a school and
+// still has schools left to point to.
+func isRemaining(student *core.Member, assigned assignments) bool {
+	return assigned[student.Name()] == nil && student.FirstPreference() != nil
+}
+
+// pointee returns the student a school points to, which is its owner while
+// they remain and otherwise the remaining student it gives the highest
+// priority. Remaining students that the school does not rank come after
+// those it does, in the order given (by name).
+func pointee(school *core.Member, students []*core.Member, assigned assignments) *core.Member {
+	if owner := school.Owner(); owner != nil && isRemaining(owner, assigned) {
+		return owner
+	}
+
+	priorities := school.PreferenceList().Members()
+
+	for i := range priorities {
+		if isRemaining(priorities[i], assigned) {
+			return priorities[i]
+		}
+	}
+
+	return students[0]
+}
+
+// detectCycle follows the pointers from a starting student until a student
+// repeats, and returns the cycle of students and the schools they point to.
+// `students` lists every remaining student, by name.
+func detectCycle(startingStudent *core.Member, students []*core.Member, assigned assignments) []tradingPair {
+	var pairs []tradingPair
+
+	// Track the position (offset by one) at which each student was seen, so
+	// that a zero value means the student has not been seen yet
+	seenAt := make(map[string]int, 0)
+
+	for student := startingStudent; seenAt[student.Name()] == 0; {
+		school := student.FirstPreference()
+
+		pairs = append(pairs, tradingPair{student: student, school: school})
+		seenAt[student.Name()] = len(pairs)
+
+		student = pointee(school, students, assigned)
+	}
+
+	last := pointee(pairs[len(pairs)-1].school, students, assigned)
+
+	return pairs[(seenAt[last.Name()] - 1):]
+}
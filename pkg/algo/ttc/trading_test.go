@@ -0,0 +1,145 @@
+package ttc
+
+import (
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopTradingCycles(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "S1", Preferences: []string{"X", "Y", "Z"}},
+			{Name: "S2", Preferences: []string{"X", "Z", "Y"}},
+			{Name: "S3", Preferences: []string{"Y", "X", "Z"}},
+			{Name: "S4", Preferences: []string{"X", "Y"}},
+			{Name: "S5", Preferences: []string{"Z", "X"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "X", Preferences: []string{"S3", "S5", "S1", "S2", "S4"}, Capacity: 2},
+			{Name: "Y", Preferences: []string{"S1", "S2", "S3", "S4", "S5"}},
+			{Name: "Z", Preferences: []string{"S4", "S2", "S1", "S3", "S5"}, Capacity: 2},
+		},
+	)
+
+	ptS, ptC := tables[0], tables[1]
+
+	assigned := topTradingCycles(&ptS, &ptC)
+
+	wanted := assignments{
+		"S1": ptC["X"],
+		"S2": ptC["Z"],
+		"S3": ptC["Y"],
+		"S4": ptC["X"],
+		"S5": ptC["Z"],
+	}
+
+	assert.Equal(t, wanted, assigned)
+}
+
+func TestCloseSchool(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "S1", Preferences: []string{"X", "Y"}},
+			{Name: "S2", Preferences: []string{"Y"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "X", Preferences: []string{"S1", "S2"}},
+			{Name: "Y", Preferences: []string{"S2", "S1"}},
+		},
+	)
+
+	ptS, ptC := tables[0], tables[1]
+
+	closeSchool(&ptS, ptC["Y"])
+
+	assert.Equal(t, []*core.Member{ptC["X"]}, ptS["S1"].PreferenceList().Members())
+	assert.Equal(t, []*core.Member{}, ptS["S2"].PreferenceList().Members())
+
+	// Schools keep their priorities
+	assert.Equal(t, []*core.Member{ptS["S2"], ptS["S1"]}, ptC["Y"].PreferenceList().Members())
+}
+
+func TestRemainingStudents(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "S3", Preferences: []string{"X"}},
+			{Name: "S1", Preferences: []string{"X"}},
+			{Name: "S2", Preferences: []string{}},
+			{Name: "S4", Preferences: []string{"X"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "X", Preferences: []string{"S1", "S2", "S3", "S4"}},
+		},
+	)
+
+	ptS, ptC := tables[0], tables[1]
+
+	assigned := assignments{"S4": ptC["X"]}
+
+	assert.Equal(t, []*core.Member{ptS["S1"], ptS["S3"]}, remainingStudents(&ptS, assigned))
+}
+
+func TestPointee(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "S1", Preferences: []string{"X", "Y"}},
+			{Name: "S2", Preferences: []string{"X", "Y"}},
+			{Name: "S3", Preferences: []string{"X", "Y"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "X", Preferences: []string{"S2", "S1"}, Owner: "S3"},
+			{Name: "Y", Preferences: []string{"S2"}},
+		},
+	)
+
+	ptS, ptC := tables[0], tables[1]
+
+	t.Run("points to the owner", func(t *testing.T) {
+		students := remainingStudents(&ptS, assignments{})
+
+		assert.Equal(t, ptS["S3"], pointee(ptC["X"], students, assignments{}))
+	})
+
+	t.Run("points to the highest priority student", func(t *testing.T) {
+		assigned := assignments{"S3": ptC["Y"]}
+		students := remainingStudents(&ptS, assigned)
+
+		assert.Equal(t, ptS["S2"], pointee(ptC["X"], students, assigned))
+	})
+
+	t.Run("points to the first unranked student", func(t *testing.T) {
+		assigned := assignments{"S2": ptC["X"]}
+		students := remainingStudents(&ptS, assigned)
+
+		assert.Equal(t, ptS["S1"], pointee(ptC["Y"], students, assigned))
+	})
+}
+
+func TestDetectCycle(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "A", Preferences: []string{"H2", "H1"}},
+			{Name: "B", Preferences: []string{"H3", "H2"}},
+			{Name: "C", Preferences: []string{"H2", "H3"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "H1", Owner: "A"},
+			{Name: "H2", Owner: "B"},
+			{Name: "H3", Owner: "C"},
+		},
+	)
+
+	ptS, ptC := tables[0], tables[1]
+
+	students := remainingStudents(&ptS, assignments{})
+
+	// "A" points to "H2", which points to "B", who starts the cycle
+	wanted := []tradingPair{
+		{student: ptS["B"], school: ptC["H3"]},
+		{student: ptS["C"], school: ptC["H2"]},
+	}
+
+	assert.Equal(t, wanted, detectCycle(ptS["A"], students, assignments{}))
+}
@@ -0,0 +1,89 @@
+package ttc
+
+import (
+	"sort"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// Run executes the Top Trading Cycles algorithm (TTC) for a set of given
+// preference inputs. `TableA` holds the students and `TableB` holds the
+// schools.
+//
+// Schools rank students by priority, and may each be owned by a student who
+// takes priority over everyone else. In a housing market, every school is a
+// house with a capacity of 1 that is owned by a student and ranks no one.
+//
+// See ttc package documentation for more detail
+func Run(algoCtx core.AlgorithmContext) (core.MatchResult, error) {
+	ptS := algoCtx.TableA
+	ptC := algoCtx.TableB
+
+	assigned := topTradingCycles(ptS, ptC)
+
+	return buildResult(ptS, ptC, assigned), nil
+}
+
+// buildResult constructs a Match Result from the school assigned to each
+// student at the end of the algorithm run.
+func buildResult(ptS, ptC *core.PreferenceTable, assigned assignments) core.MatchResult {
+	res := core.MatchResult{}
+
+	res.Mapping = make(map[string]string)
+	res.Assignments = make(map[string][]string, len(*ptC))
+
+	students := make(map[string][]*core.Member, len(*ptC))
+
+	for name, student := range *ptS {
+		school := assigned[name]
+
+		if school == nil {
+			res.Unmatched = append(res.Unmatched, name)
+			continue
+		}
+
+		res.Mapping[name] = school.Name()
+		students[school.Name()] = append(students[school.Name()], student)
+	}
+
+	for name, school := range *ptC {
+		members := students[name]
+
+		// List students in the school's order of priority
+		sort.Slice(members, func(i, j int) bool {
+			pi, pj := priorityOf(school, members[i]), priorityOf(school, members[j])
+
+			if pi != pj {
+				return pi < pj
+			}
+
+			return members[i].Name() < members[j].Name()
+		})
+
+		res.Assignments[name] = make([]string, len(members))
+		for i := range members {
+			res.Assignments[name][i] = members[i].Name()
+		}
+	}
+
+	sort.Strings(res.Unmatched)
+
+	return res
+}
+
+// priorityOf returns the priority a school gives a student, where a lower
+// value is a higher priority. The school's owner comes first, and students the
+// school does not rank come last.
+func priorityOf(school, student *core.Member) int {
+	if owner := school.Owner(); owner != nil && owner.Name() == student.Name() {
+		return -1
+	}
+
+	pl := school.PreferenceList()
+
+	if idx := pl.IndexOf(*student); idx != -1 {
+		return idx
+	}
+
+	return len(pl.Members())
+}
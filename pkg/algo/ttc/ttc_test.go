@@ -0,0 +1,155 @@
+package ttc
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	t.Run("school choice", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "S1", Preferences: []string{"X", "Y", "Z"}},
+				{Name: "S2", Preferences: []string{"X", "Z", "Y"}},
+				{Name: "S3", Preferences: []string{"Y", "X", "Z"}},
+				{Name: "S4", Preferences: []string{"X", "Y"}},
+				{Name: "S5", Preferences: []string{"Z", "X"}},
+			},
+			{
+				{Name: "X", Preferences: []string{"S3", "S5", "S1", "S2", "S4"}, Capacity: 2},
+				{Name: "Y", Preferences: []string{"S1", "S2", "S3", "S4", "S5"}},
+				{Name: "Z", Preferences: []string{"S4", "S2", "S1", "S3", "S5"}, Capacity: 2},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"S1": "X",
+				"S2": "Z",
+				"S3": "Y",
+				"S4": "X",
+				"S5": "Z",
+			},
+			Assignments: map[string][]string{
+				"X": {"S1", "S4"},
+				"Y": {"S3"},
+				"Z": {"S2", "S5"},
+			},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("housing market", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"H3", "H2", "H1"}},
+				{Name: "B", Preferences: []string{"H1", "H4", "H2"}},
+				{Name: "C", Preferences: []string{"H1", "H3"}},
+				{Name: "D", Preferences: []string{"H3", "H2", "H4"}},
+			},
+			{
+				{Name: "H1", Owner: "A"},
+				{Name: "H2", Owner: "B"},
+				{Name: "H3", Owner: "C"},
+				{Name: "H4", Owner: "D"},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "H3",
+				"B": "H4",
+				"C": "H1",
+				"D": "H2",
+			},
+			Assignments: map[string][]string{
+				"H1": {"C"},
+				"H2": {"D"},
+				"H3": {"A"},
+				"H4": {"B"},
+			},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+
+	t.Run("unmatched students", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"H1"}},
+				{Name: "B", Preferences: []string{"H1"}},
+				{Name: "C", Preferences: []string{}},
+			},
+			{
+				{Name: "H1", Owner: "A"},
+				{Name: "H2", Owner: "B"},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		algoCtx := core.AlgorithmContext{
+			TableA: &tables[0],
+			TableB: &tables[1],
+		}
+
+		wanted := core.MatchResult{
+			Mapping: map[string]string{
+				"A": "H1",
+			},
+			Assignments: map[string][]string{
+				"H1": {"A"},
+				"H2": {},
+			},
+			Unmatched: []string{"B", "C"},
+		}
+
+		result, err := Run(algoCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, reflect.DeepEqual(wanted, result))
+	})
+}
+
+func TestPriorityOf(t *testing.T) {
+	tables := core.NewPreferenceTablePair(
+		&[]core.MatchPreference{
+			{Name: "A", Preferences: []string{"X"}},
+			{Name: "B", Preferences: []string{"X"}},
+			{Name: "C", Preferences: []string{"X"}},
+		},
+		&[]core.MatchPreference{
+			{Name: "X", Preferences: []string{"B", "C"}, Owner: "C"},
+		},
+	)
+
+	school := tables[1]["X"]
+
+	assert.Equal(t, -1, priorityOf(school, tables[0]["C"]))
+	assert.Equal(t, 0, priorityOf(school, tables[0]["B"]))
+	assert.Equal(t, 2, priorityOf(school, tables[0]["A"]))
+}
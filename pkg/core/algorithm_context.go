@@ -7,4 +7,79 @@ package core
 type AlgorithmContext struct {
 	TableA *PreferenceTable
 	TableB *PreferenceTable
+
+	// TableC is the third preference table of three-level problems. For
+	// Student-Project Allocation, TableA holds the students, TableB the
+	// projects and TableC the lecturers.
+	TableC *PreferenceTable
+
+	// Proposer is the side whose members make proposals in two-sided
+	// algorithms. Defaults to `SideA` when unspecified.
+	Proposer Side
+
+	// IncompleteLists indicates that members may omit unacceptable members from
+	// their preference lists, and therefore some members may remain unmatched.
+	IncompleteLists bool
+
+	// TieBreaker orders members that are tied in rank for algorithms that
+	// require strictly ordered preference lists. Defaults to `TieBreakByOrder`
+	// when unspecified.
+	TieBreaker TieBreaker
+
+	// Stability is the notion of stability that a matching must satisfy when
+	// preference lists contain ties. Defaults to `StabilityWeak` when
+	// unspecified.
+	Stability Stability
+
+	// Objective is the criterion used to choose among stable matchings.
+	// Defaults to `ObjectiveProposerOptimal` when unspecified.
+	Objective Objective
+
+	// PairCost is the cost of each matched pair, minimised by the
+	// `ObjectiveMinimumCost` objective.
+	PairCost PairCost
+
+	// MaximumStableMatching indicates that a roommates problem without a
+	// stable matching should return a maximum stable matching instead of an
+	// error, leaving some members unmatched.
+	MaximumStableMatching bool
+
+	// PriorityOrder lists the members of TableA in the order in which they
+	// choose in serial dictatorship algorithms.
+	PriorityOrder []string
+
+	// Seed seeds the random priority order or lottery of randomized
+	// algorithms, so that the same seed always produces the same result.
+	Seed int64
+
+	// Lottery is how ties between members of the same priority class are
+	// broken in school choice. Defaults to `LotterySingle` when unspecified.
+	Lottery Lottery
+
+	// Draws is the number of random priority orders that randomized algorithms
+	// average over. A single order is drawn when unspecified.
+	Draws int
+
+	// Sample indicates that algorithms producing a lottery over matchings
+	// should also draw a single matching from it, using `Seed`.
+	Sample bool
+
+	// Couples lists the couples of members of TableA who apply together with a
+	// joint preference list (e.g. residents in the Hospitals/Residents Problem
+	// with Couples).
+	Couples *[]CouplePreference
+
+	// SearchLimit bounds the number of proposals made by heuristic algorithms
+	// that may not find a stable matching. A default limit is used when
+	// unspecified.
+	SearchLimit int
+
+	// CloseUnderfilled indicates that members of TableB who can not meet their
+	// lower quota may be closed and left unmatched, rather than the problem
+	// having no solution.
+	CloseUnderfilled bool
+
+	// ScoresAsCosts indicates that the scores members give their preferences
+	// are costs to be minimised, rather than utilities to be maximised.
+	ScoresAsCosts bool
 }
@@ -0,0 +1,21 @@
+package core
+
+// CouplePreference stores information about a couple of members who apply
+// together, and their joint preference list over pairs of other members.
+//
+// Like `MatchPreference`, it is designed to be a container for information
+// read directly from a stream of JSON data (e.g. a file on disk).
+//
+// Members names the two members of the couple, who must both be members of the
+// first table of a two-sided problem (e.g. two residents). Each element of
+// `Preferences` is a pair of members of the second table, in order of
+// preference, where the first member of the couple is matched with the first
+// member of the pair and the second member of the couple with the second (e.g.
+// a pair of hospitals). Both members of a pair may be the same member.
+//
+//    { "name": "C1", "members": ["R1", "R2"], "preferences": [["H1", "H1"], ["H1", "H2"]] }
+type CouplePreference struct {
+	Name        string     `json:"name"`
+	Members     []string   `json:"members"`
+	Preferences [][]string `json:"preferences"`
+}
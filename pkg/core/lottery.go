@@ -0,0 +1,13 @@
+package core
+
+// Lottery identifies how ties between members of the same priority class are
+// broken by lottery in school choice.
+//
+//   - Single: each student draws one lottery number, which every school uses
+//   - Multiple: each school draws its own lottery number for every student
+type Lottery string
+
+const (
+	LotterySingle   Lottery = "single"
+	LotteryMultiple Lottery = "multiple"
+)
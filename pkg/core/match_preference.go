@@ -1,11 +1,113 @@
 package core
 
+import (
+	"encoding/json"
+)
+
 // MatchPreference stores information about a member and their preference list
 // of other members.
 //
 // This data model is designed to be a container for information read directly
 // from a stream of JSON data (e.g. a file on disk).
+//
+// Capacity is optional and is only used by algorithms where a member may be
+// matched with more than one other member (e.g. a hospital that accepts
+// multiple residents). When omitted, a member has a capacity of 1.
+//
+// LowerQuota is optional and is the minimum number of members that a member
+// must be matched with, if it is matched at all (e.g. the minimum staffing
+// level of a hospital). When omitted, a member has no lower quota.
+//
+// Owner is optional and names the member that a member belongs to. It is used
+// by three-level algorithms, where the owner is a member of the third table
+// (e.g. the lecturer who offers a project), and by trading algorithms, where
+// the owner is a member of the first table (e.g. the student who owns a house).
+//
+// Scores is optional and gives a numeric score to each member of
+// `Preferences`, in the same order (e.g. a satisfaction rating). It is only
+// used by algorithms that optimise over cardinal utilities, such as the
+// assignment problem.
+//
+// RankedPreferences is optional and allows a member to be indifferent between
+// other members. Each element is a group of members that are ranked equally
+// (tied), in order of preference. When specified, it takes precedence over
+// `Preferences`.
 type MatchPreference struct {
-	Name        string   `json:"name"`
-	Preferences []string `json:"preferences"`
+	Name              string     `json:"name"`
+	Preferences       []string   `json:"preferences"`
+	Capacity          int        `json:"capacity,omitempty"`
+	LowerQuota        int        `json:"lower_quota,omitempty"`
+	Owner             string     `json:"owner,omitempty"`
+	Scores            []float64  `json:"scores,omitempty"`
+	RankedPreferences [][]string `json:"-"`
+}
+
+// UnmarshalJSON reads a match preference from JSON data.
+//
+// Each element of "preferences" may either be a single member name or a list
+// of member names that are tied in rank. For example, the following member
+// prefers "A" the most, is indifferent between "B" and "C", and prefers "D"
+// the least.
+//
+//    { "name": "M", "preferences": ["A", ["B", "C"], "D"] }
+//
+// When any ties are present, `RankedPreferences` is populated with the ranked
+// groups. `Preferences` always contains the flattened list of member names.
+func (mp *MatchPreference) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Name        string            `json:"name"`
+		Preferences []json.RawMessage `json:"preferences"`
+		Capacity    int               `json:"capacity"`
+		LowerQuota  int               `json:"lower_quota"`
+		Owner       string            `json:"owner"`
+		Scores      []float64         `json:"scores"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	mp.Name = raw.Name
+	mp.Capacity = raw.Capacity
+	mp.LowerQuota = raw.LowerQuota
+	mp.Owner = raw.Owner
+	mp.Scores = raw.Scores
+	mp.Preferences = nil
+	mp.RankedPreferences = nil
+
+	if raw.Preferences == nil {
+		return nil
+	}
+
+	hasTies := false
+	preferences := make([]string, 0, len(raw.Preferences))
+	groups := make([][]string, len(raw.Preferences))
+
+	for i := range raw.Preferences {
+		var name string
+
+		if err := json.Unmarshal(raw.Preferences[i], &name); err == nil {
+			groups[i] = []string{name}
+			preferences = append(preferences, name)
+			continue
+		}
+
+		var group []string
+
+		if err := json.Unmarshal(raw.Preferences[i], &group); err != nil {
+			return err
+		}
+
+		hasTies = true
+		groups[i] = group
+		preferences = append(preferences, group...)
+	}
+
+	mp.Preferences = preferences
+
+	if hasTies {
+		mp.RankedPreferences = groups
+	}
+
+	return nil
 }
@@ -0,0 +1,99 @@
+package core
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalJSON__MatchPreference(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var mp MatchPreference
+
+		err := json.Unmarshal([]byte(`{ "name":"A", "preferences": ["B", "C"] }`), &mp)
+
+		wanted := MatchPreference{Name: "A", Preferences: []string{"B", "C"}}
+
+		assert.Nil(t, err)
+		assert.Equal(t, wanted, mp)
+	})
+
+	t.Run("with ties", func(t *testing.T) {
+		var mp MatchPreference
+
+		err := json.Unmarshal([]byte(`{ "name":"A", "preferences": ["B", ["C", "D"], "E"] }`), &mp)
+
+		wanted := MatchPreference{
+			Name:              "A",
+			Preferences:       []string{"B", "C", "D", "E"},
+			RankedPreferences: [][]string{{"B"}, {"C", "D"}, {"E"}},
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, wanted, mp)
+	})
+
+	t.Run("with capacity", func(t *testing.T) {
+		var mp MatchPreference
+
+		err := json.Unmarshal([]byte(`{ "name":"A", "preferences": [], "capacity": 2 }`), &mp)
+
+		wanted := MatchPreference{Name: "A", Preferences: []string{}, Capacity: 2}
+
+		assert.Nil(t, err)
+		assert.Equal(t, wanted, mp)
+	})
+
+	t.Run("with lower quota", func(t *testing.T) {
+		var mp MatchPreference
+
+		err := json.Unmarshal([]byte(`{ "name":"H", "preferences": [], "capacity": 3, "lower_quota": 2 }`), &mp)
+
+		wanted := MatchPreference{Name: "H", Preferences: []string{}, Capacity: 3, LowerQuota: 2}
+
+		assert.Nil(t, err)
+		assert.Equal(t, wanted, mp)
+	})
+
+	t.Run("with owner", func(t *testing.T) {
+		var mp MatchPreference
+
+		err := json.Unmarshal([]byte(`{ "name":"P", "capacity": 2, "owner": "L" }`), &mp)
+
+		wanted := MatchPreference{Name: "P", Capacity: 2, Owner: "L"}
+
+		assert.Nil(t, err)
+		assert.Equal(t, wanted, mp)
+	})
+
+	t.Run("with scores", func(t *testing.T) {
+		var mp MatchPreference
+
+		err := json.Unmarshal([]byte(`{ "name":"A", "preferences": ["B", "C"], "scores": [4.5, 2] }`), &mp)
+
+		wanted := MatchPreference{Name: "A", Preferences: []string{"B", "C"}, Scores: []float64{4.5, 2}}
+
+		assert.Nil(t, err)
+		assert.Equal(t, wanted, mp)
+	})
+
+	t.Run("missing preferences", func(t *testing.T) {
+		var mp MatchPreference
+
+		err := json.Unmarshal([]byte(`{ "name":"A" }`), &mp)
+
+		assert.Nil(t, err)
+		assert.Equal(t, MatchPreference{Name: "A"}, mp)
+	})
+
+	t.Run("invalid preference", func(t *testing.T) {
+		var mp MatchPreference
+
+		err := json.Unmarshal([]byte(`{ "name":"A", "preferences": ["B", 3] }`), &mp)
+
+		if assert.NotNil(t, err) {
+			assert.Equal(t, "json: cannot unmarshal number into Go value of type []string", err.Error())
+		}
+	})
+}
@@ -4,29 +4,169 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 )
 
 // MatchResult stores the result of executing a mapping algorithm
+//
+// `Mapping` maps each matched member to the member it was matched with. For
+// many-to-one algorithms (e.g. Hospitals/Residents), `Mapping` only contains
+// the members of the single-capacity side, and `Assignments` maps each member
+// of the multi-capacity side to the list of members assigned to it. When every
+// member may be matched with more than one other member (e.g. Stable
+// Fixtures), `Mapping` is empty and `Assignments` maps each member to the list
+// of members they are matched with.
+//
+// For three-level algorithms (e.g. Student-Project Allocation), `Allocations`
+// further groups `Assignments` by owner, mapping each lecturer to each of
+// their projects and the list of students assigned to it.
+//
+// `Unmatched` lists the members that could not be matched with anyone, which
+// is possible when preference lists are incomplete.
+//
+// `OptimalSide` records which side of a two-sided matching problem the
+// matching is optimal for, which is the side whose members proposed. It is
+// empty when the matching was chosen by another objective.
+//
+// `Probabilities` maps each member to the probability of being matched with
+// each other member, for randomized algorithms that average over many
+// matchings (e.g. Random Serial Dictatorship), or that produce a fractional
+// assignment (e.g. Probabilistic Serial). In that case `Mapping` is empty,
+// unless a single matching was also drawn.
+//
+// `Decomposition` expresses `Probabilities` as a lottery over deterministic
+// matchings, whose probabilities add up to 1. Drawing a matching from the
+// lottery matches each pair of members with exactly the probability given in
+// `Probabilities`.
+//
+// `Signature` counts the members matched with their first choice, second
+// choice, and so on, for algorithms that optimise over ranks (e.g. Rank-Maximal
+// Matching).
+//
+// `Lottery` records the lottery number each school drew for each student, for
+// algorithms that break ties by lottery (e.g. school choice), so that the
+// result can be audited. A lower number breaks a tie in the student's favour.
+// With a single lottery, every school lists the same numbers.
+//
+// `BlockingPairs` reports why a matching is not stable, for heuristic
+// algorithms that may fail to find a stable matching (e.g. Hospitals/Residents
+// with Couples). It maps each resident or couple that blocks the matching to
+// the hospital, or pair of hospitals, that they would both rather be matched
+// with.
+//
+// `Closed` lists the members that were closed, in the order they were closed,
+// for algorithms that may close a member rather than match it with fewer
+// members than its lower quota (e.g. Hospitals/Residents with Lower Quotas).
+//
+// `Size` counts the matched pairs, and `SizeUpperBound` the pairs of a maximum
+// matching of acceptable pairs that ignores preferences, for algorithms that
+// approximate the largest stable matching (e.g. maximum cardinality weakly
+// stable matchings). No stable matching can be larger than the upper bound.
+//
+// `Costs` records the total rank cost of each side of a two-sided matching
+// problem (e.g. Stable Marriage Problem). Each matched member adds the rank of
+// their partner, starting at 1 for their first choice, so that a lower cost is
+// better.
 type MatchResult struct {
-	Mapping map[string]string `json:"mapping"`
+	Mapping        map[string]string              `json:"mapping"`
+	Assignments    map[string][]string            `json:"assignments,omitempty"`
+	Allocations    map[string]map[string][]string `json:"allocations,omitempty"`
+	Unmatched      []string                       `json:"unmatched,omitempty"`
+	OptimalSide    Side                           `json:"optimal_side,omitempty"`
+	Costs          map[Side]int                   `json:"costs,omitempty"`
+	Probabilities  map[string]map[string]float64  `json:"probabilities,omitempty"`
+	Signature      []int                          `json:"signature,omitempty"`
+	Lottery        map[string]map[string]int      `json:"lottery,omitempty"`
+	BlockingPairs  map[string][]string            `json:"blocking_pairs,omitempty"`
+	Closed         []string                       `json:"closed,omitempty"`
+	Size           int                            `json:"size,omitempty"`
+	SizeUpperBound int                            `json:"size_upper_bound,omitempty"`
+	Decomposition  []WeightedMatching             `json:"decomposition,omitempty"`
 }
 
 // Print prints formatted match results in a sepcified format. The `format` can
 // be specified as one of the following:
 // 		* csv
 //    * json
+//    * matrix
+//
+// In the csv format, unmatched members are printed with an empty match. When
+// the result has no mapping, each assignment is printed as a match instead,
+// and each probability is printed as a match followed by its probability.
+//
+// The matrix format prints `Probabilities` as a table in csv form, with a row
+// for each member and a column for each member they may be matched with, both
+// in alphabetical order.
 func (mr MatchResult) Print(format string) error {
 	switch format {
 	case "csv":
 		for a, b := range mr.Mapping {
 			fmt.Printf("%v,%v\n", a, b)
 		}
+		if len(mr.Mapping) == 0 {
+			for a, members := range mr.Assignments {
+				for i := range members {
+					fmt.Printf("%v,%v\n", a, members[i])
+				}
+			}
+			for a, probabilities := range mr.Probabilities {
+				for b, p := range probabilities {
+					fmt.Printf("%v,%v,%v\n", a, b, p)
+				}
+			}
+		}
+		for i := range mr.Unmatched {
+			fmt.Printf("%v,\n", mr.Unmatched[i])
+		}
 	case "json":
 		json, _ := json.Marshal(mr)
 		fmt.Println(string(json))
+	case "matrix":
+		if len(mr.Probabilities) == 0 {
+			return errors.New("The matrix format requires a result with probabilities")
+		}
+
+		mr.printMatrix()
 	default:
 		return errors.New(fmt.Sprintf("Unknown format '%v'", format))
 	}
 
 	return nil
 }
+
+// printMatrix prints `Probabilities` as a table in csv form. The header row
+// lists the columns, and each following row starts with the member it
+// belongs to.
+func (mr MatchResult) printMatrix() {
+	var rows []string
+	columnSet := make(map[string]bool)
+
+	for a, probabilities := range mr.Probabilities {
+		rows = append(rows, a)
+
+		for b := range probabilities {
+			columnSet[b] = true
+		}
+	}
+
+	columns := make([]string, 0, len(columnSet))
+	for b := range columnSet {
+		columns = append(columns, b)
+	}
+
+	sort.Strings(rows)
+	sort.Strings(columns)
+
+	fmt.Printf(",%v\n", strings.Join(columns, ","))
+
+	for _, a := range rows {
+		cells := make([]string, len(columns))
+
+		for i, b := range columns {
+			cells[i] = fmt.Sprintf("%v", mr.Probabilities[a][b])
+		}
+
+		fmt.Printf("%v,%v\n", a, strings.Join(cells, ","))
+	}
+}
@@ -26,3 +26,103 @@ func ExamplePrint__format_json() {
 	// Unordered output:
 	// {"mapping":{"A":"B","B":"A"}}
 }
+
+func ExampleMatchResult_Print_withAssignments() {
+	res := MatchResult{
+		Mapping: map[string]string{
+			"A": "X",
+			"B": "X",
+		},
+		Assignments: map[string][]string{
+			"X": {"A", "B"},
+		},
+	}
+
+	res.Print("json")
+	// Output:
+	// {"mapping":{"A":"X","B":"X"},"assignments":{"X":["A","B"]}}
+}
+
+func ExampleMatchResult_Print_withAssignmentsOnly() {
+	res := MatchResult{
+		Mapping: map[string]string{},
+		Assignments: map[string][]string{
+			"A": {"B", "C"},
+			"B": {"A"},
+			"C": {"A"},
+		},
+	}
+
+	res.Print("csv")
+	// Unordered output:
+	// A,B
+	// A,C
+	// B,A
+	// C,A
+}
+
+func ExampleMatchResult_Print_withProbabilities() {
+	res := MatchResult{
+		Mapping: map[string]string{},
+		Probabilities: map[string]map[string]float64{
+			"A": {"X": 0.5, "Y": 0.5},
+			"B": {"X": 0.5},
+		},
+	}
+
+	res.Print("csv")
+	// Unordered output:
+	// A,X,0.5
+	// A,Y,0.5
+	// B,X,0.5
+}
+
+func ExampleMatchResult_Print_withUnmatched() {
+	res := MatchResult{
+		Mapping: map[string]string{
+			"A": "B",
+			"B": "A",
+		},
+		Unmatched: []string{"C"},
+	}
+
+	res.Print("csv")
+	// Unordered output:
+	// A,B
+	// B,A
+	// C,
+}
+
+func ExampleMatchResult_Print_withDecomposition() {
+	res := MatchResult{
+		Mapping: map[string]string{},
+		Probabilities: map[string]map[string]float64{
+			"A": {"X": 0.5, "Y": 0.5},
+			"B": {"X": 0.5, "Y": 0.5},
+		},
+		Decomposition: []WeightedMatching{
+			{Probability: 0.5, Mapping: map[string]string{"A": "X", "B": "Y"}},
+			{Probability: 0.5, Mapping: map[string]string{"A": "Y", "B": "X"}},
+		},
+	}
+
+	res.Print("json")
+	// Output:
+	// {"mapping":{},"probabilities":{"A":{"X":0.5,"Y":0.5},"B":{"X":0.5,"Y":0.5}},"decomposition":[{"probability":0.5,"mapping":{"A":"X","B":"Y"}},{"probability":0.5,"mapping":{"A":"Y","B":"X"}}]}
+}
+
+func ExampleMatchResult_Print_formatMatrix() {
+	res := MatchResult{
+		Mapping: map[string]string{},
+		Probabilities: map[string]map[string]float64{
+			"B": {"X": 0.25, "Z": 0.75},
+			"A": {"Y": 1},
+		},
+	}
+
+	res.Print("matrix")
+	// Output:
+	// ,X,Y,Z
+	// A,0,1,0
+	// B,0.25,0,0.75
+}
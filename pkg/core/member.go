@@ -11,6 +11,9 @@ type Member struct {
 	name                 string
 	preferenceList       *PreferenceList
 	acceptedProposalFrom *Member
+	capacity             int
+	lowerQuota           int
+	owner                *Member
 }
 
 // NewMember builds a new member from a unique name
@@ -28,6 +31,39 @@ func (m Member) Name() string {
 	return m.name
 }
 
+// Capacity returns the maximum number of members this member can be matched
+// with. Members without an explicitly specified capacity have a capacity of 1.
+func (m Member) Capacity() int {
+	if m.capacity == 0 {
+		return 1
+	}
+
+	return m.capacity
+}
+
+// LowerQuota returns the minimum number of members this member must be matched
+// with, unless it is not matched at all. Members without an explicitly
+// specified lower quota have a lower quota of 0.
+func (m Member) LowerQuota() int {
+	return m.lowerQuota
+}
+
+// Owner returns the member that this member belongs to (e.g. the lecturer who
+// offers a project, or the student who owns a house), or nil if it has none.
+func (m Member) Owner() *Member {
+	return m.owner
+}
+
+// Score returns the score this member gives another member, or 0 if it does not
+// score them.
+func (m Member) Score(other Member) float64 {
+	if m.preferenceList == nil {
+		return 0
+	}
+
+	return m.preferenceList.scores[other.name]
+}
+
 // PreferenceList returns the current list of other members in order of
 // preference. It may change over time as the algorithm runs and eliminates
 // certain elements of the list.
@@ -123,24 +159,13 @@ func (m Member) WouldPreferProposalFrom(newProposer Member) bool {
 		return true
 	}
 
-	idxNew := -1
-	idxCurrent := -1
-
-	for i := range m.preferenceList.members {
-		if m.preferenceList.members[i].Name() == newProposer.Name() {
-			idxNew = i
-		}
-		if m.preferenceList.members[i].Name() == m.CurrentProposer().Name() {
-			idxCurrent = i
-		}
-		if idxNew > -1 && idxCurrent > -1 {
-			break
-		}
-	}
+	rankNew := m.preferenceList.RankOf(newProposer)
+	rankCurrent := m.preferenceList.RankOf(*m.CurrentProposer())
 
-	// A lower index means a higher preference. The new proposal is more
-	// attractive if it's index is less than the current.
-	return idxNew < idxCurrent
+	// A lower rank means a higher preference. The new proposal is more
+	// attractive if it's rank is less than the current. Members that are tied
+	// in rank are not preferred over each other.
+	return rankNew < rankCurrent
 }
 
 // FirstPreference returns the first preferred member on this member's
@@ -172,3 +197,30 @@ func (m Member) LastPreference() *Member {
 
 	return m.preferenceList.members[len(m.preferenceList.members)-1]
 }
+
+// IndexOfMember returns the index of a member in a list of members, or -1 if
+// the member is not present.
+func IndexOfMember(members []*Member, member *Member) int {
+	for i := range members {
+		if members[i].Name() == member.Name() {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// RemoveMember returns a copy of a list of members with the specified member
+// removed
+func RemoveMember(members []*Member, member *Member) []*Member {
+	idx := IndexOfMember(members, member)
+	if idx == -1 {
+		return members
+	}
+
+	newMembers := make([]*Member, len(members)-1)
+	copy(newMembers[:idx], members[:idx])
+	copy(newMembers[idx:], members[idx+1:])
+
+	return newMembers
+}
@@ -31,6 +31,60 @@ func TestName(t *testing.T) {
 	assert.Equal(t, "A", memA.Name())
 }
 
+func TestCapacity(t *testing.T) {
+	t.Run("returns capacity", func(t *testing.T) {
+		memA = Member{name: "A", capacity: 3}
+		assert.Equal(t, 3, memA.Capacity())
+	})
+
+	t.Run("defaults to 1", func(t *testing.T) {
+		memA = Member{name: "A"}
+		assert.Equal(t, 1, memA.Capacity())
+	})
+}
+
+func TestLowerQuota(t *testing.T) {
+	t.Run("returns lower quota", func(t *testing.T) {
+		memA = Member{name: "A", capacity: 3, lowerQuota: 2}
+		assert.Equal(t, 2, memA.LowerQuota())
+	})
+
+	t.Run("defaults to 0", func(t *testing.T) {
+		memA = Member{name: "A"}
+		assert.Equal(t, 0, memA.LowerQuota())
+	})
+}
+
+func TestOwner(t *testing.T) {
+	t.Run("returns owner", func(t *testing.T) {
+		memB = Member{name: "B"}
+		memA = Member{name: "A", owner: &memB}
+		assert.Equal(t, "B", memA.Owner().Name())
+	})
+
+	t.Run("defaults to nil", func(t *testing.T) {
+		memA = Member{name: "A"}
+		assert.Nil(t, memA.Owner())
+	})
+}
+
+func TestScore(t *testing.T) {
+	t.Run("returns score", func(t *testing.T) {
+		memB = Member{name: "B"}
+		memA = Member{name: "A", preferenceList: &PreferenceList{
+			members: []*Member{&memB},
+			scores:  map[string]float64{"B": 2.5},
+		}}
+		assert.Equal(t, 2.5, memA.Score(memB))
+	})
+
+	t.Run("defaults to 0", func(t *testing.T) {
+		memB = Member{name: "B"}
+		memA = Member{name: "A"}
+		assert.Equal(t, 0.0, memA.Score(memB))
+	})
+}
+
 func TestCurrentProposer(t *testing.T) {
 
 	t.Run("returns current proposer", func(t *testing.T) {
@@ -255,6 +309,18 @@ func TestWouldPreferProposalFrom(t *testing.T) {
 		assert.True(t, memA.WouldPreferProposalFrom(memB))
 		assert.True(t, memA.WouldPreferProposalFrom(memD))
 	})
+
+	t.Run("with ties", func(t *testing.T) {
+		setupSingleTable()
+
+		plA = NewRankedPreferenceList([]*Member{&memB, &memC, &memD}, []int{0, 0, 1})
+		memA.SetPreferenceList(&plA)
+		memA.acceptedProposalFrom = &memC
+
+		// Tied members are not preferred over each other
+		assert.False(t, memA.WouldPreferProposalFrom(memB))
+		assert.False(t, memA.WouldPreferProposalFrom(memD))
+	})
 }
 
 func TestFirstPreference(t *testing.T) {
@@ -323,3 +389,28 @@ func TestLastPreference(t *testing.T) {
 		assert.Nil(t, memA.LastPreference())
 	})
 }
+
+func TestIndexOfMember(t *testing.T) {
+	memA = Member{name: "A"}
+	memB = Member{name: "B"}
+	memC = Member{name: "C"}
+
+	members := []*Member{&memA, &memB}
+
+	assert.Equal(t, 1, IndexOfMember(members, &memB))
+	assert.Equal(t, -1, IndexOfMember(members, &memC))
+}
+
+func TestRemoveMember(t *testing.T) {
+	memA = Member{name: "A"}
+	memB = Member{name: "B"}
+	memC = Member{name: "C"}
+
+	members := []*Member{&memA, &memB, &memC}
+
+	assert.Equal(t, []*Member{&memA, &memC}, RemoveMember(members, &memB))
+	assert.Equal(t, []*Member{&memA, &memB, &memC}, members)
+
+	// Handles missing member
+	assert.Equal(t, []*Member{&memA}, RemoveMember([]*Member{&memA}, &memB))
+}
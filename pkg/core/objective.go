@@ -0,0 +1,38 @@
+package core
+
+// Objective identifies the criterion used to choose among the stable
+// matchings of a two-sided matching problem.
+//
+//   - ProposerOptimal: the matching that is best for the proposing side
+//   - Egalitarian: the matching that minimises the sum of the ranks that all
+//     members give their partners
+//   - MinimumRegret: the matching that minimises the worst rank that any
+//     member gives their partner
+//   - SexEqual: the matching that minimises the difference between the total
+//     rank costs of both sides
+//   - Balanced: the matching that minimises the larger of the total rank
+//     costs of both sides
+//   - Median: the matching in which each member is matched with their median
+//     partner across all stable matchings
+//   - MinimumCost: the matching that minimises the sum of a `PairCost` over
+//     all matched pairs
+//   - MaximumCardinality: a weakly stable matching that matches as many
+//     members as possible, when preference lists contain ties and are
+//     incomplete. It is found with an approximation algorithm that aims to
+//     match at least 2/3 as many pairs as the largest weakly stable matching
+type Objective string
+
+const (
+	ObjectiveProposerOptimal    Objective = "proposer-optimal"
+	ObjectiveEgalitarian        Objective = "egalitarian"
+	ObjectiveMinimumRegret      Objective = "minimum-regret"
+	ObjectiveSexEqual           Objective = "sex-equal"
+	ObjectiveBalanced           Objective = "balanced"
+	ObjectiveMedian             Objective = "median"
+	ObjectiveMinimumCost        Objective = "minimum-cost"
+	ObjectiveMaximumCardinality Objective = "maximum-cardinality"
+)
+
+// PairCost returns the cost of matching member `a` of the first preference
+// table with member `b` of the second preference table.
+type PairCost func(a, b string) int
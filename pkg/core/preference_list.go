@@ -6,8 +6,17 @@ import (
 
 // PreferenceList models an ordered list of preferences for other members for
 // any given Member.
+//
+// A preference list may optionally contain ties, where several members share
+// the same rank. Ranks are only tracked when ties are present, otherwise each
+// member's rank is its position in the list.
+//
+// Each member may also be given a numeric score, keyed by name so that scores
+// are kept when members are removed from the list.
 type PreferenceList struct {
 	members []*Member
+	ranks   []int
+	scores  map[string]float64
 }
 
 // NewPreferenceList returns a new preference list given an array of initial
@@ -16,12 +25,31 @@ func NewPreferenceList(members []*Member) PreferenceList {
 	return PreferenceList{members: members}
 }
 
-// String returns a human readable representation of this preference list
+// NewRankedPreferenceList returns a new preference list given an array of
+// initial ordered members and the rank of each member. Members with equal rank
+// are tied, and a lower rank indicates a higher preference.
+func NewRankedPreferenceList(members []*Member, ranks []int) PreferenceList {
+	return PreferenceList{members: members, ranks: ranks}
+}
+
+// String returns a human readable representation of this preference list.
+// Members that are tied in rank are grouped in brackets.
 func (pl PreferenceList) String() string {
-	names := make([]string, len(pl.members))
+	groups := pl.Groups()
+	names := make([]string, len(groups))
 
-	for i := range pl.members {
-		names[i] = pl.members[i].String()
+	for g := range groups {
+		groupNames := make([]string, len(groups[g]))
+
+		for i := range groups[g] {
+			groupNames[i] = groups[g][i].String()
+		}
+
+		names[g] = strings.Join(groupNames, ", ")
+
+		if len(groups[g]) > 1 {
+			names[g] = "[" + names[g] + "]"
+		}
 	}
 
 	return strings.Join(names, ", ")
@@ -32,6 +60,98 @@ func (pl PreferenceList) Members() []*Member {
 	return pl.members
 }
 
+// IndexOf returns the index of a specific member in the preference list, or -1
+// if the member is not present. A lower index indicates a higher preference.
+func (pl PreferenceList) IndexOf(member Member) int {
+	for i := range pl.members {
+		if pl.members[i].Name() == member.Name() {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// LeastPreferred returns the member of `members` that appears last on the
+// preference list, or nil if `members` is empty.
+func (pl PreferenceList) LeastPreferred(members []*Member) *Member {
+	var worst *Member
+	worstIdx := -1
+
+	for i := range members {
+		idx := pl.IndexOf(*members[i])
+		if idx > worstIdx {
+			worst = members[i]
+			worstIdx = idx
+		}
+	}
+
+	return worst
+}
+
+// RankOf returns the rank of a specific member in the preference list, or -1
+// if the member is not present. A lower rank indicates a higher preference,
+// and members with equal rank are tied.
+func (pl PreferenceList) RankOf(member Member) int {
+	idx := pl.IndexOf(member)
+
+	if idx == -1 || pl.ranks == nil {
+		return idx
+	}
+
+	return pl.ranks[idx]
+}
+
+// HasTies indicates whether any two members of the preference list are tied
+// in rank.
+func (pl PreferenceList) HasTies() bool {
+	for i := 1; i < len(pl.ranks); i++ {
+		if pl.ranks[i] == pl.ranks[i-1] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Groups returns the members of the preference list grouped by rank, in order
+// of preference. Each group contains members that are tied with each other.
+func (pl PreferenceList) Groups() [][]*Member {
+	var groups [][]*Member
+
+	for i := range pl.members {
+		if i > 0 && pl.ranks != nil && pl.ranks[i] == pl.ranks[i-1] {
+			groups[len(groups)-1] = append(groups[len(groups)-1], pl.members[i])
+		} else {
+			groups = append(groups, []*Member{pl.members[i]})
+		}
+	}
+
+	return groups
+}
+
+// BreakTies converts the preference list into a strictly ordered list by
+// using a tie breaker to order each group of tied members.
+func (pl *PreferenceList) BreakTies(owner *Member, tieBreaker TieBreaker) {
+	if pl.ranks == nil {
+		return
+	}
+
+	groups := pl.Groups()
+	members := make([]*Member, 0, len(pl.members))
+
+	for g := range groups {
+		if len(groups[g]) > 1 {
+			groups[g] = tieBreaker(owner, groups[g])
+		}
+
+		members = append(members, groups[g]...)
+	}
+
+	pl.members = members
+	pl.ranks = nil
+}
+
 // Remove removes a specific member from the preference list
 func (pl *PreferenceList) Remove(member Member) {
 	idx := -1
@@ -54,4 +174,12 @@ func (pl *PreferenceList) Remove(member Member) {
 	copy(newMembers[idx:], pl.members[idx+1:])
 
 	pl.members = newMembers
+
+	if pl.ranks != nil {
+		newRanks := make([]int, len(pl.ranks)-1)
+		copy(newRanks[:idx], pl.ranks[:idx])
+		copy(newRanks[idx:], pl.ranks[idx+1:])
+
+		pl.ranks = newRanks
+	}
 }
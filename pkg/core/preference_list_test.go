@@ -35,6 +35,16 @@ func TestString__PreferenceList(t *testing.T) {
 
 		assert.Equal(t, "", pl.String())
 	})
+
+	t.Run("with ties", func(t *testing.T) {
+		m1 := NewMember("A")
+		m2 := NewMember("B")
+		m3 := NewMember("C")
+		members := []*Member{&m1, &m2, &m3}
+		pl := NewRankedPreferenceList(members, []int{0, 1, 1})
+
+		assert.Equal(t, "'A', ['B', 'C']", pl.String())
+	})
 }
 
 func TestMembers(t *testing.T) {
@@ -48,6 +58,114 @@ func TestMembers(t *testing.T) {
 	assert.Equal(t, []*Member{&memB, &memC}, plA.Members())
 }
 
+func TestIndexOf(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		setupSingleTable()
+
+		assert.Equal(t, 0, plA.IndexOf(memB))
+		assert.Equal(t, 2, plA.IndexOf(memD))
+	})
+
+	t.Run("handles missing member", func(t *testing.T) {
+		setupSingleTable()
+
+		assert.Equal(t, -1, plA.IndexOf(memA))
+	})
+}
+
+func TestLeastPreferred(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		setupSingleTable()
+
+		assert.Equal(t, &memD, plA.LeastPreferred([]*Member{&memD, &memB}))
+		assert.Equal(t, &memC, plA.LeastPreferred([]*Member{&memB, &memC}))
+	})
+
+	t.Run("handles empty list", func(t *testing.T) {
+		setupSingleTable()
+
+		assert.Nil(t, plA.LeastPreferred([]*Member{}))
+	})
+}
+
+func TestRankOf(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		setupSingleTable()
+
+		assert.Equal(t, 0, plA.RankOf(memB))
+		assert.Equal(t, 2, plA.RankOf(memD))
+	})
+
+	t.Run("with ties", func(t *testing.T) {
+		setupSingleTable()
+
+		plA = NewRankedPreferenceList([]*Member{&memB, &memC, &memD}, []int{0, 0, 1})
+
+		assert.Equal(t, 0, plA.RankOf(memB))
+		assert.Equal(t, 0, plA.RankOf(memC))
+		assert.Equal(t, 1, plA.RankOf(memD))
+	})
+
+	t.Run("handles missing member", func(t *testing.T) {
+		setupSingleTable()
+
+		assert.Equal(t, -1, plA.RankOf(memA))
+	})
+}
+
+func TestHasTies(t *testing.T) {
+	setupSingleTable()
+
+	assert.False(t, plA.HasTies())
+
+	plA = NewRankedPreferenceList([]*Member{&memB, &memC, &memD}, []int{0, 1, 2})
+	assert.False(t, plA.HasTies())
+
+	plA = NewRankedPreferenceList([]*Member{&memB, &memC, &memD}, []int{0, 1, 1})
+	assert.True(t, plA.HasTies())
+}
+
+func TestGroups(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		setupSingleTable()
+
+		wanted := [][]*Member{{&memB}, {&memC}, {&memD}}
+
+		assert.Equal(t, wanted, plA.Groups())
+	})
+
+	t.Run("with ties", func(t *testing.T) {
+		setupSingleTable()
+
+		plA = NewRankedPreferenceList([]*Member{&memB, &memC, &memD}, []int{0, 0, 1})
+
+		wanted := [][]*Member{{&memB, &memC}, {&memD}}
+
+		assert.Equal(t, wanted, plA.Groups())
+	})
+}
+
+func TestBreakTies__PreferenceList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		setupSingleTable()
+
+		plA = NewRankedPreferenceList([]*Member{&memD, &memB, &memC}, []int{0, 0, 1})
+		plA.BreakTies(&memA, TieBreakByName)
+
+		assert.Equal(t, []*Member{&memB, &memD, &memC}, plA.members)
+		assert.Nil(t, plA.ranks)
+	})
+
+	t.Run("without ties", func(t *testing.T) {
+		setupSingleTable()
+
+		plA.BreakTies(&memA, TieBreakByName)
+
+		assert.Equal(t, []*Member{&memB, &memC, &memD}, plA.members)
+		assert.Nil(t, plA.ranks)
+	})
+}
+
 func TestRemove(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		memA = Member{name: "A"}
@@ -80,4 +198,18 @@ func TestRemove(t *testing.T) {
 		plA.Remove(memC)
 		assert.Equal(t, []*Member{&memB}, plA.members)
 	})
+
+	t.Run("with ties", func(t *testing.T) {
+		memA = Member{name: "A"}
+		memB = Member{name: "B"}
+		memC = Member{name: "C"}
+		memD = Member{name: "D"}
+
+		plA = NewRankedPreferenceList([]*Member{&memB, &memC, &memD}, []int{0, 0, 1})
+		memA.SetPreferenceList(&plA)
+
+		plA.Remove(memB)
+		assert.Equal(t, []*Member{&memC, &memD}, plA.members)
+		assert.Equal(t, []int{0, 1}, plA.ranks)
+	})
 }
@@ -28,6 +28,7 @@ func NewPreferenceTable(prefs *[]MatchPreference) PreferenceTable {
 	// First pass: build a list of members as a lookup table
 	for i := range p {
 		m := NewMember(p[i].Name)
+		m.capacity = p[i].Capacity
 		table[p[i].Name] = &m
 	}
 
@@ -36,15 +37,7 @@ func NewPreferenceTable(prefs *[]MatchPreference) PreferenceTable {
 	for i := range p {
 		name := p[i].Name
 		m := table[name]
-		plMembers := make([]*Member, len(p[i].Preferences))
-
-		for j := range p[i].Preferences {
-			prefName := p[i].Preferences[j]
-			pref := table[prefName]
-			plMembers[j] = pref
-		}
-
-		m.preferenceList = &PreferenceList{members: plMembers}
+		m.preferenceList = newPreferenceListFrom(p[i], table)
 		table[name] = m
 	}
 
@@ -52,7 +45,9 @@ func NewPreferenceTable(prefs *[]MatchPreference) PreferenceTable {
 }
 
 // NewPreferenceTablePair creates a pair of preference tables where each
-// member has a preference list of members in the *other* set.
+// member has a preference list of members in the *other* set. Members may
+// also belong to a member of the other set named as their owner (e.g. the
+// member who owns a house).
 func NewPreferenceTablePair(prefsA, prefsB *[]MatchPreference) []PreferenceTable {
 	prefsSet := []*[]MatchPreference{prefsA, prefsB}
 
@@ -67,12 +62,14 @@ func NewPreferenceTablePair(prefsA, prefsB *[]MatchPreference) []PreferenceTable
 		for j := range *prefs {
 			name := (*prefs)[j].Name
 			m := NewMember(name)
+			m.capacity = (*prefs)[j].Capacity
+			m.lowerQuota = (*prefs)[j].LowerQuota
 			tables[i][name] = &m
 		}
 	}
 
-	// Second pass, build preference list for each member
-	// that contains references to the other table's members
+	// Second pass, build preference list for each member that contains
+	// references to the other table's members, and look up each owner
 	for i := range prefsSet {
 		p := *prefsSet[i]
 
@@ -82,15 +79,12 @@ func NewPreferenceTablePair(prefsA, prefsB *[]MatchPreference) []PreferenceTable
 		for j := range p {
 			name := p[j].Name
 			m := table[name]
-			plMembers := make([]*Member, len(p[j].Preferences))
+			m.preferenceList = newPreferenceListFrom(p[j], otherTable)
 
-			for k := range p[j].Preferences {
-				prefName := p[j].Preferences[k]
-				pref := otherTable[prefName]
-				plMembers[k] = pref
+			if p[j].Owner != "" {
+				m.owner = otherTable[p[j].Owner]
 			}
 
-			m.preferenceList = &PreferenceList{members: plMembers}
 			tables[i][name] = m
 		}
 	}
@@ -98,6 +92,98 @@ func NewPreferenceTablePair(prefsA, prefsB *[]MatchPreference) []PreferenceTable
 	return tables
 }
 
+// NewPreferenceTableTriple creates three preference tables for a three-level
+// problem (e.g. students, projects and lecturers). Members of the first table
+// have a preference list of members of the second table, and members of the
+// third table have a preference list of members of the first table. Members of
+// the second table have no preference list of their own, and instead belong
+// to the member of the third table named as their owner.
+func NewPreferenceTableTriple(prefsA, prefsB, prefsC *[]MatchPreference) []PreferenceTable {
+	prefsSet := []*[]MatchPreference{prefsA, prefsB, prefsC}
+
+	tables := make([]PreferenceTable, 3)
+
+	// First pass: build a list of members as a lookup table
+	for i := range prefsSet {
+		prefs := prefsSet[i]
+		tables[i] = make(PreferenceTable, len(*prefs))
+
+		for j := range *prefs {
+			name := (*prefs)[j].Name
+			m := NewMember(name)
+			m.capacity = (*prefs)[j].Capacity
+			tables[i][name] = &m
+		}
+	}
+
+	// Second pass, build preference list for each member that contains
+	// references to the next table's members, and look up each owner
+	for i := range prefsSet {
+		p := *prefsSet[i]
+
+		table := tables[i]
+		nextTable := tables[(i+1)%3]
+
+		for j := range p {
+			m := table[p[j].Name]
+			m.preferenceList = newPreferenceListFrom(p[j], nextTable)
+
+			if p[j].Owner != "" {
+				m.owner = tables[2][p[j].Owner]
+			}
+		}
+	}
+
+	return tables
+}
+
+// newScoresFrom maps the name of each preferred member to the score it is
+// given, or returns nil when the match preference specifies no scores.
+func newScoresFrom(pref MatchPreference) map[string]float64 {
+	if pref.Scores == nil {
+		return nil
+	}
+
+	scores := make(map[string]float64, len(pref.Scores))
+
+	for i := range pref.Scores {
+		if i < len(pref.Preferences) {
+			scores[pref.Preferences[i]] = pref.Scores[i]
+		}
+	}
+
+	return scores
+}
+
+// newPreferenceListFrom builds a preference list from a match preference,
+// looking up each preferred member by name. Ranks are only recorded when the
+// match preference specifies ranked groups of members.
+func newPreferenceListFrom(pref MatchPreference, lookup PreferenceTable) *PreferenceList {
+	if pref.RankedPreferences == nil {
+		plMembers := make([]*Member, len(pref.Preferences))
+
+		for i := range pref.Preferences {
+			plMembers[i] = lookup[pref.Preferences[i]]
+		}
+
+		return &PreferenceList{members: plMembers, scores: newScoresFrom(pref)}
+	}
+
+	var plMembers []*Member
+	var ranks []int
+
+	for rank := range pref.RankedPreferences {
+		group := pref.RankedPreferences[rank]
+
+		for i := range group {
+			plMembers = append(plMembers, lookup[group[i]])
+			ranks = append(ranks, rank)
+		}
+	}
+
+	return &PreferenceList{members: plMembers, ranks: ranks, scores: newScoresFrom(pref)}
+}
+
 // String returns a human readable representation of this preference table
 func (pt PreferenceTable) String() string {
 	var str string
@@ -128,6 +214,31 @@ func (pt PreferenceTable) String() string {
 	return str
 }
 
+// SortedNames returns the names of all members in this table, in alphabetical
+// order.
+func (pt PreferenceTable) SortedNames() []string {
+	names := make([]string, 0, len(pt))
+	for name := range pt {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// SortedMembers returns all members in this table, in alphabetical order of
+// name.
+func (pt PreferenceTable) SortedMembers() []*Member {
+	names := pt.SortedNames()
+
+	members := make([]*Member, len(names))
+	for i := range names {
+		members[i] = pt[names[i]]
+	}
+
+	return members
+}
+
 // UnmatchedMembers returns a list of all members in this table who are still
 // unmatched.
 func (pt PreferenceTable) UnmatchedMembers() []*Member {
@@ -142,6 +253,45 @@ func (pt PreferenceTable) UnmatchedMembers() []*Member {
 	return unmatched
 }
 
+// RemoveUnacceptable removes members from each preference list when they do
+// not list the preference list's owner in return. A pair of members is only
+// acceptable when both members list each other.
+func (pt PreferenceTable) RemoveUnacceptable() {
+	for _, member := range pt {
+		prefs := member.PreferenceList().Members()
+
+		for i := range prefs {
+			if prefs[i].PreferenceList().IndexOf(*member) == -1 {
+				member.Reject(prefs[i])
+			}
+		}
+	}
+}
+
+// HasTies indicates whether any member of this table has a preference list
+// containing members that are tied in rank.
+func (pt PreferenceTable) HasTies() bool {
+	for m := range pt {
+		if pt[m].PreferenceList().HasTies() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// BreakTies converts every preference list in this table into a strictly
+// ordered list using the specified tie breaker. Members are processed in
+// order of name so that the result is reproducible.
+func (pt PreferenceTable) BreakTies(tieBreaker TieBreaker) {
+	keys := pt.SortedNames()
+
+	for k := range keys {
+		member := pt[keys[k]]
+		member.PreferenceList().BreakTies(member, tieBreaker)
+	}
+}
+
 // IsStable indicates whether this table is considered mathematically stable.
 // That is, no member should have an empty preference list.
 func (pt PreferenceTable) IsStable() bool {
@@ -22,6 +22,22 @@ func TestNewPreferenceTable(t *testing.T) {
 		assert.True(t, reflect.DeepEqual(wanted, NewPreferenceTable(&prefs)))
 	})
 
+	t.Run("with capacities", func(t *testing.T) {
+		prefs := []MatchPreference{
+			{Name: "A", Preferences: []string{"B", "C", "D"}, Capacity: 2},
+			{Name: "B", Preferences: []string{"A", "C", "D"}},
+			{Name: "C", Preferences: []string{"A", "B", "D"}, Capacity: 3},
+			{Name: "D", Preferences: []string{"A", "B", "C"}},
+		}
+
+		setupSingleTable()
+		memA.capacity = 2
+		memC.capacity = 3
+		wanted := pt
+
+		assert.True(t, reflect.DeepEqual(wanted, NewPreferenceTable(&prefs)))
+	})
+
 	t.Run("empty table", func(t *testing.T) {
 		prefs := []MatchPreference{}
 
@@ -71,6 +87,22 @@ func TestNewPreferenceTable(t *testing.T) {
 
 		assert.Equal(t, wanted, NewPreferenceTable(&prefs))
 	})
+
+	t.Run("ranked preferences", func(t *testing.T) {
+		prefs := []MatchPreference{
+			{Name: "A", RankedPreferences: [][]string{{"B"}, {"C", "D"}}},
+			{Name: "B", Preferences: []string{"A", "C", "D"}},
+			{Name: "C", Preferences: []string{"A", "B", "D"}},
+			{Name: "D", Preferences: []string{"A", "B", "C"}},
+		}
+
+		setupSingleTable()
+
+		plA = NewRankedPreferenceList([]*Member{&memB, &memC, &memD}, []int{0, 1, 1})
+		memA.SetPreferenceList(&plA)
+
+		assert.True(t, reflect.DeepEqual(pt, NewPreferenceTable(&prefs)))
+	})
 }
 
 func TestNewPreferenceTablePair(t *testing.T) {
@@ -114,6 +146,93 @@ func TestNewPreferenceTablePair(t *testing.T) {
 		assert.True(t, reflect.DeepEqual(ptA, tables[1]))
 	})
 
+	t.Run("with capacities", func(t *testing.T) {
+		prefsA := []MatchPreference{
+			{Name: "A", Preferences: []string{"K", "L", "M"}},
+			{Name: "B", Preferences: []string{"L", "M", "K"}},
+			{Name: "C", Preferences: []string{"M", "L", "K"}},
+		}
+		prefsB := []MatchPreference{
+			{Name: "K", Preferences: []string{"B", "C", "A"}, Capacity: 2},
+			{Name: "L", Preferences: []string{"A", "C", "B"}},
+			{Name: "M", Preferences: []string{"A", "B", "C"}, Capacity: 3},
+		}
+
+		setupDoubleTable()
+		memK.capacity = 2
+		memM.capacity = 3
+
+		tables := NewPreferenceTablePair(&prefsA, &prefsB)
+
+		assert.True(t, reflect.DeepEqual(ptA, tables[0]))
+		assert.True(t, reflect.DeepEqual(ptB, tables[1]))
+	})
+
+	t.Run("with lower quotas", func(t *testing.T) {
+		prefsA := []MatchPreference{
+			{Name: "A", Preferences: []string{"K", "L", "M"}},
+			{Name: "B", Preferences: []string{"L", "M", "K"}},
+			{Name: "C", Preferences: []string{"M", "L", "K"}},
+		}
+		prefsB := []MatchPreference{
+			{Name: "K", Preferences: []string{"B", "C", "A"}, Capacity: 2, LowerQuota: 1},
+			{Name: "L", Preferences: []string{"A", "C", "B"}},
+			{Name: "M", Preferences: []string{"A", "B", "C"}, Capacity: 3, LowerQuota: 2},
+		}
+
+		setupDoubleTable()
+		memK.capacity = 2
+		memK.lowerQuota = 1
+		memM.capacity = 3
+		memM.lowerQuota = 2
+
+		tables := NewPreferenceTablePair(&prefsA, &prefsB)
+
+		assert.True(t, reflect.DeepEqual(ptA, tables[0]))
+		assert.True(t, reflect.DeepEqual(ptB, tables[1]))
+	})
+
+	t.Run("with owners", func(t *testing.T) {
+		prefsA := []MatchPreference{
+			{Name: "A", Preferences: []string{"K", "L", "M"}},
+			{Name: "B", Preferences: []string{"L", "M", "K"}},
+			{Name: "C", Preferences: []string{"M", "L", "K"}},
+		}
+		prefsB := []MatchPreference{
+			{Name: "K", Preferences: []string{"B", "C", "A"}, Owner: "A"},
+			{Name: "L", Preferences: []string{"A", "C", "B"}, Owner: "C"},
+			{Name: "M", Preferences: []string{"A", "B", "C"}, Owner: "X"},
+		}
+
+		tables := NewPreferenceTablePair(&prefsA, &prefsB)
+
+		assert.Equal(t, "A", tables[1]["K"].Owner().Name())
+		assert.Equal(t, "C", tables[1]["L"].Owner().Name())
+		assert.Nil(t, tables[1]["M"].Owner())
+		assert.Nil(t, tables[0]["A"].Owner())
+	})
+
+	t.Run("with scores", func(t *testing.T) {
+		prefsA := []MatchPreference{
+			{Name: "A", Preferences: []string{"K", "L", "M"}, Scores: []float64{3, 1.5, -2}},
+			{Name: "B", Preferences: []string{"L", "M", "K"}},
+			{Name: "C", Preferences: []string{"M", "L", "K"}},
+		}
+		prefsB := []MatchPreference{
+			{Name: "K", Preferences: []string{"B", "C", "A"}, Scores: []float64{4, 5, 6}},
+			{Name: "L", Preferences: []string{"A", "C", "B"}},
+			{Name: "M", Preferences: []string{"A", "B", "C"}},
+		}
+
+		tables := NewPreferenceTablePair(&prefsA, &prefsB)
+
+		assert.Equal(t, 3.0, tables[0]["A"].Score(*tables[1]["K"]))
+		assert.Equal(t, 1.5, tables[0]["A"].Score(*tables[1]["L"]))
+		assert.Equal(t, -2.0, tables[0]["A"].Score(*tables[1]["M"]))
+		assert.Equal(t, 6.0, tables[1]["K"].Score(*tables[0]["A"]))
+		assert.Equal(t, 0.0, tables[0]["B"].Score(*tables[1]["K"]))
+	})
+
 	t.Run("empty table", func(t *testing.T) {
 		prefsA := []MatchPreference{}
 		prefsB := []MatchPreference{}
@@ -195,6 +314,56 @@ func TestNewPreferenceTablePair(t *testing.T) {
 	})
 }
 
+func TestNewPreferenceTableTriple(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		prefsA := []MatchPreference{
+			{Name: "S1", Preferences: []string{"P1", "P2"}},
+			{Name: "S2", Preferences: []string{"P2"}},
+		}
+		prefsB := []MatchPreference{
+			{Name: "P1", Owner: "L1", Capacity: 2},
+			{Name: "P2", Owner: "L1"},
+		}
+		prefsC := []MatchPreference{
+			{Name: "L1", Preferences: []string{"S2", "S1"}, Capacity: 3},
+		}
+
+		memS1 := Member{name: "S1"}
+		memS2 := Member{name: "S2"}
+		memL1 := Member{name: "L1", capacity: 3}
+		memP1 := Member{name: "P1", capacity: 2, owner: &memL1}
+		memP2 := Member{name: "P2", owner: &memL1}
+
+		memS1.SetPreferenceList(&PreferenceList{members: []*Member{&memP1, &memP2}})
+		memS2.SetPreferenceList(&PreferenceList{members: []*Member{&memP2}})
+		memP1.SetPreferenceList(&PreferenceList{members: []*Member{}})
+		memP2.SetPreferenceList(&PreferenceList{members: []*Member{}})
+		memL1.SetPreferenceList(&PreferenceList{members: []*Member{&memS2, &memS1}})
+
+		tables := NewPreferenceTableTriple(&prefsA, &prefsB, &prefsC)
+
+		assert.True(t, reflect.DeepEqual(PreferenceTable{"S1": &memS1, "S2": &memS2}, tables[0]))
+		assert.True(t, reflect.DeepEqual(PreferenceTable{"P1": &memP1, "P2": &memP2}, tables[1]))
+		assert.True(t, reflect.DeepEqual(PreferenceTable{"L1": &memL1}, tables[2]))
+	})
+
+	t.Run("undefined owner", func(t *testing.T) {
+		prefsA := []MatchPreference{
+			{Name: "S1", Preferences: []string{"P1"}},
+		}
+		prefsB := []MatchPreference{
+			{Name: "P1", Owner: "X"},
+		}
+		prefsC := []MatchPreference{
+			{Name: "L1", Preferences: []string{"S1"}},
+		}
+
+		tables := NewPreferenceTableTriple(&prefsA, &prefsB, &prefsC)
+
+		assert.Nil(t, tables[1]["P1"].Owner())
+	})
+}
+
 func TestString__PreferenceTable(t *testing.T) {
 
 	cases := [][]string{
@@ -258,6 +427,26 @@ func TestString__PreferenceTable(t *testing.T) {
 	})
 }
 
+func TestSortedNames(t *testing.T) {
+	table := NewPreferenceTable(&[]MatchPreference{
+		{Name: "C", Preferences: []string{"A"}},
+		{Name: "A", Preferences: []string{"C"}},
+		{Name: "B", Preferences: []string{"A"}},
+	})
+
+	assert.Equal(t, []string{"A", "B", "C"}, table.SortedNames())
+}
+
+func TestSortedMembers(t *testing.T) {
+	table := NewPreferenceTable(&[]MatchPreference{
+		{Name: "C", Preferences: []string{"A"}},
+		{Name: "A", Preferences: []string{"C"}},
+		{Name: "B", Preferences: []string{"A"}},
+	})
+
+	assert.Equal(t, []*Member{table["A"], table["B"], table["C"]}, table.SortedMembers())
+}
+
 func TestUnmatchedMembers(t *testing.T) {
 	setupSingleTable()
 
@@ -269,6 +458,81 @@ func TestUnmatchedMembers(t *testing.T) {
 	assert.Equal(t, []*Member{&memC}, pt.UnmatchedMembers())
 }
 
+func TestRemoveUnacceptable(t *testing.T) {
+	t.Run("single table", func(t *testing.T) {
+		table := NewPreferenceTable(&[]MatchPreference{
+			{Name: "A", Preferences: []string{"B", "C"}},
+			{Name: "B", Preferences: []string{"C"}},
+			{Name: "C", Preferences: []string{"A", "B"}},
+		})
+
+		table.RemoveUnacceptable()
+
+		wanted := NewPreferenceTable(&[]MatchPreference{
+			{Name: "A", Preferences: []string{"C"}},
+			{Name: "B", Preferences: []string{"C"}},
+			{Name: "C", Preferences: []string{"A", "B"}},
+		})
+
+		assert.True(t, reflect.DeepEqual(wanted, table))
+	})
+
+	t.Run("table pair", func(t *testing.T) {
+		tables := NewPreferenceTablePair(
+			&[]MatchPreference{
+				{Name: "A", Preferences: []string{"K", "L"}},
+				{Name: "B", Preferences: []string{"L"}},
+			},
+			&[]MatchPreference{
+				{Name: "K", Preferences: []string{"B"}},
+				{Name: "L", Preferences: []string{"A", "B"}},
+			},
+		)
+
+		tables[0].RemoveUnacceptable()
+		tables[1].RemoveUnacceptable()
+
+		wanted := NewPreferenceTablePair(
+			&[]MatchPreference{
+				{Name: "A", Preferences: []string{"L"}},
+				{Name: "B", Preferences: []string{"L"}},
+			},
+			&[]MatchPreference{
+				{Name: "K", Preferences: []string{}},
+				{Name: "L", Preferences: []string{"A", "B"}},
+			},
+		)
+
+		assert.True(t, reflect.DeepEqual(wanted, tables))
+	})
+}
+
+func TestHasTies__PreferenceTable(t *testing.T) {
+	setupSingleTable()
+
+	assert.False(t, pt.HasTies())
+
+	plB = NewRankedPreferenceList([]*Member{&memA, &memC, &memD}, []int{0, 1, 1})
+	memB.SetPreferenceList(&plB)
+
+	assert.True(t, pt.HasTies())
+}
+
+func TestBreakTies__PreferenceTable(t *testing.T) {
+	setupSingleTable()
+
+	plA = NewRankedPreferenceList([]*Member{&memD, &memC, &memB}, []int{0, 0, 1})
+	memA.SetPreferenceList(&plA)
+	plB = NewRankedPreferenceList([]*Member{&memD, &memC, &memA}, []int{0, 1, 1})
+	memB.SetPreferenceList(&plB)
+
+	pt.BreakTies(TieBreakByName)
+
+	assert.False(t, pt.HasTies())
+	assert.Equal(t, []*Member{&memC, &memD, &memB}, plA.Members())
+	assert.Equal(t, []*Member{&memD, &memA, &memC}, plB.Members())
+}
+
 func TestIsStable(t *testing.T) {
 	t.Run("returns true", func(t *testing.T) {
 		setupSingleTable()
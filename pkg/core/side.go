@@ -0,0 +1,11 @@
+package core
+
+// Side identifies one of the two preference tables of a two-sided matching
+// problem. "A" always refers to the first table specified and "B" to the
+// second.
+type Side string
+
+const (
+	SideA Side = "a"
+	SideB Side = "b"
+)
@@ -0,0 +1,23 @@
+package core
+
+// Stability identifies the notion of stability that a matching must satisfy
+// when preference lists contain ties.
+//
+// A pair of members who are not matched with each other "blocks" a matching
+// when -
+//
+//   - Weak: both strictly prefer each other to their current partners
+//   - Strong: one strictly prefers the other, and the other strictly prefers
+//     or is indifferent between them and their current partner
+//   - Super: both strictly prefer or are indifferent between each other and
+//     their current partners
+//
+// A matching is stable under a given notion when no pair blocks it. Without
+// ties all three notions are equivalent.
+type Stability string
+
+const (
+	StabilityWeak   Stability = "weak"
+	StabilityStrong Stability = "strong"
+	StabilitySuper  Stability = "super"
+)
@@ -0,0 +1,47 @@
+package core
+
+import (
+	"math/rand"
+	"sort"
+)
+
+// TieBreaker orders a group of members that are tied in rank on the
+// preference list of the specified owner. It returns the tied members in
+// their new strict order of preference.
+type TieBreaker func(owner *Member, tied []*Member) []*Member
+
+// TieBreakByOrder breaks ties by keeping tied members in the order in which
+// they were originally listed.
+func TieBreakByOrder(owner *Member, tied []*Member) []*Member {
+	return tied
+}
+
+// TieBreakByName breaks ties by ordering tied members alphabetically by name.
+func TieBreakByName(owner *Member, tied []*Member) []*Member {
+	ordered := make([]*Member, len(tied))
+	copy(ordered, tied)
+
+	sort.Slice(ordered, func(i, j int) bool {
+		return ordered[i].Name() < ordered[j].Name()
+	})
+
+	return ordered
+}
+
+// TieBreakRandom returns a tie breaker that orders tied members randomly. The
+// same seed always produces the same order when ties are broken in the same
+// sequence.
+func TieBreakRandom(seed int64) TieBreaker {
+	r := rand.New(rand.NewSource(seed))
+
+	return func(owner *Member, tied []*Member) []*Member {
+		ordered := make([]*Member, len(tied))
+		copy(ordered, tied)
+
+		r.Shuffle(len(ordered), func(i, j int) {
+			ordered[i], ordered[j] = ordered[j], ordered[i]
+		})
+
+		return ordered
+	}
+}
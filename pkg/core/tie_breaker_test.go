@@ -0,0 +1,42 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTieBreakByOrder(t *testing.T) {
+	setupSingleTable()
+
+	tied := []*Member{&memD, &memB, &memC}
+
+	assert.Equal(t, []*Member{&memD, &memB, &memC}, TieBreakByOrder(&memA, tied))
+}
+
+func TestTieBreakByName(t *testing.T) {
+	setupSingleTable()
+
+	tied := []*Member{&memD, &memB, &memC}
+
+	assert.Equal(t, []*Member{&memB, &memC, &memD}, TieBreakByName(&memA, tied))
+
+	// Original slice is not modified
+	assert.Equal(t, []*Member{&memD, &memB, &memC}, tied)
+}
+
+func TestTieBreakRandom(t *testing.T) {
+	setupSingleTable()
+
+	tied := []*Member{&memB, &memC, &memD}
+
+	first := TieBreakRandom(42)(&memA, tied)
+	second := TieBreakRandom(42)(&memA, tied)
+
+	// The same seed produces the same order
+	assert.Equal(t, first, second)
+	assert.ElementsMatch(t, tied, first)
+
+	// Original slice is not modified
+	assert.Equal(t, []*Member{&memB, &memC, &memD}, tied)
+}
@@ -0,0 +1,13 @@
+package core
+
+// WeightedMatching stores one deterministic matching of a lottery over
+// matchings, along with the probability of drawing it.
+//
+// `Mapping` maps each matched member of the first table to the member of the
+// second table it is matched with (e.g. a member and the item they receive).
+//
+//    { "probability": 0.5, "mapping": { "A": "X", "B": "Y" } }
+type WeightedMatching struct {
+	Probability float64           `json:"probability"`
+	Mapping     map[string]string `json:"mapping"`
+}
@@ -72,6 +72,21 @@ func LoadFromFile(filename string) (*[]core.MatchPreference, error) {
 //      {Name: "E", Preferences: []string{"F", "C", "D", "B", "A"}},
 //      {Name: "F", Preferences: []string{"A", "B", "D", "C", "E"}},
 //    }
+//
+// Members that are tied in rank may be grouped into a nested list. The
+// grouped members are returned in `RankedPreferences`.
+//
+//    [
+//      { "name":"A", "preferences": ["K", ["L", "M"]] },
+//    ]
+//
+//    *[]libmatch.MatchPreference{
+//      {
+//        Name:              "A",
+//        Preferences:       []string{"K", "L", "M"},
+//        RankedPreferences: [][]string{{"K"}, {"L", "M"}},
+//      },
+//    }
 func LoadFromIO(r io.Reader) (*[]core.MatchPreference, error) {
 	var data []core.MatchPreference
 
@@ -86,3 +101,112 @@ func LoadFromIO(r io.Reader) (*[]core.MatchPreference, error) {
 
 	return &data, nil
 }
+
+// LoadOrderFromFile loads an ordered list of member names from a file
+// containing JSON data (e.g. the priority order of serial dictatorship).
+//
+// The structure of the JSON file should be of format:
+//
+//    ["D", "A", "B", "C"]
+//
+// The return value is the list of names, in the same order
+//
+//    []string{"D", "A", "B", "C"}
+func LoadOrderFromFile(filename string) ([]string, error) {
+	var data []string
+
+	file, err := os.Open(filename)
+	if err != nil {
+		return data, err
+	}
+	defer file.Close()
+
+	data, err = LoadOrderFromIO(bufio.NewReader(file))
+	return data, err
+}
+
+// LoadOrderFromIO reads an ordered list of member names from an `io.Reader`.
+//
+// The expected data is a JSON formatted list of the format:
+//
+//    ["D", "A", "B", "C"]
+//
+// The return value is the list of names, in the same order
+//
+//    []string{"D", "A", "B", "C"}
+func LoadOrderFromIO(r io.Reader) ([]string, error) {
+	var data []string
+
+	rawJson, err := io.ReadAll(r)
+	if err != nil {
+		return data, err
+	}
+
+	if err := json.Unmarshal(rawJson, &data); err != nil {
+		return data, err
+	}
+
+	return data, nil
+}
+
+// LoadCouplesFromFile loads the joint preferences of couples from a file
+// containing JSON data.
+//
+// The structure of the JSON file should be of format:
+//
+//    [
+//      { "name":"C1", "members": ["R1", "R2"], "preferences": [["H1", "H1"], ["H1", "H2"]] },
+//      { "name":"C2", "members": ["R3", "R4"], "preferences": [["H2", "H3"]] },
+//    ]
+//
+// The return value is an array of `CouplePreference` structs containing the
+// loaded JSON data
+//
+//    *[]core.CouplePreference{
+//      {Name: "C1", Members: []string{"R1", "R2"}, Preferences: [][]string{{"H1", "H1"}, {"H1", "H2"}}},
+//      {Name: "C2", Members: []string{"R3", "R4"}, Preferences: [][]string{{"H2", "H3"}}},
+//    }
+func LoadCouplesFromFile(filename string) (*[]core.CouplePreference, error) {
+	var data *[]core.CouplePreference
+
+	file, err := os.Open(filename)
+	if err != nil {
+		return data, err
+	}
+	defer file.Close()
+
+	data, err = LoadCouplesFromIO(bufio.NewReader(file))
+	return data, err
+}
+
+// LoadCouplesFromIO reads the joint preferences of couples from an
+// `io.Reader`.
+//
+// The expected data is a JSON formatted list of the format:
+//
+//    [
+//      { "name":"C1", "members": ["R1", "R2"], "preferences": [["H1", "H1"], ["H1", "H2"]] },
+//      { "name":"C2", "members": ["R3", "R4"], "preferences": [["H2", "H3"]] },
+//    ]
+//
+// The return value is an array of `CouplePreference` structs containing the
+// loaded JSON data
+//
+//    *[]core.CouplePreference{
+//      {Name: "C1", Members: []string{"R1", "R2"}, Preferences: [][]string{{"H1", "H1"}, {"H1", "H2"}}},
+//      {Name: "C2", Members: []string{"R3", "R4"}, Preferences: [][]string{{"H2", "H3"}}},
+//    }
+func LoadCouplesFromIO(r io.Reader) (*[]core.CouplePreference, error) {
+	var data []core.CouplePreference
+
+	rawJson, err := io.ReadAll(r)
+	if err != nil {
+		return &data, err
+	}
+
+	if err := json.Unmarshal(rawJson, &data); err != nil {
+		return &data, err
+	}
+
+	return &data, nil
+}
@@ -72,6 +72,29 @@ func TestLoadFromIO(t *testing.T) {
 	assert.Equal(t, wanted, got)
 }
 
+func TestLoadFromIO_WithTies(t *testing.T) {
+	body := `
+  [
+    { "name":"A", "preferences": [["K", "L"], "M"] },
+    { "name":"B", "preferences": ["K", "L", "M"] }
+  ]
+	`
+
+	got, err := LoadFromIO(strings.NewReader(body))
+
+	wanted := &[]core.MatchPreference{
+		{
+			Name:              "A",
+			Preferences:       []string{"K", "L", "M"},
+			RankedPreferences: [][]string{{"K", "L"}, {"M"}},
+		},
+		{Name: "B", Preferences: []string{"K", "L", "M"}},
+	}
+
+	assert.Nil(t, err)
+	assert.Equal(t, wanted, got)
+}
+
 func TestLoadFromIO_UnmarshallError(t *testing.T) {
 
 	// Note missing `:` on final row
@@ -91,6 +114,94 @@ func TestLoadFromIO_UnmarshallError(t *testing.T) {
 	}
 }
 
+func TestLoadOrderFromFile(t *testing.T) {
+	body := `["D", "A", "B", "C"]`
+	writeToFile(testFile, body)
+
+	got, err := LoadOrderFromFile(testFile)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"D", "A", "B", "C"}, got)
+}
+
+func TestLoadOrderFromFile_DoesNotExist(t *testing.T) {
+	badFile := "/tmp/badfile.json"
+
+	_, err := LoadOrderFromFile(badFile)
+
+	if assert.NotNil(t, err) {
+		assert.Equal(t,
+			fmt.Sprintf("open %v: no such file or directory", badFile), err.Error())
+	}
+}
+
+func TestLoadOrderFromIO(t *testing.T) {
+	got, err := LoadOrderFromIO(strings.NewReader(`["B", "A"]`))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"B", "A"}, got)
+}
+
+func TestLoadOrderFromIO_UnmarshallError(t *testing.T) {
+	_, err := LoadOrderFromIO(strings.NewReader(`["B", "A"`))
+
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "unexpected end of JSON input", err.Error())
+	}
+}
+
+func TestLoadCouplesFromFile(t *testing.T) {
+	body := `
+  [
+    { "name":"C1", "members": ["R1", "R2"], "preferences": [["H1", "H1"], ["H1", "H2"]] },
+    { "name":"C2", "members": ["R3", "R4"], "preferences": [["H2", "H3"]] }
+  ]
+	`
+	writeToFile(testFile, body)
+
+	got, err := LoadCouplesFromFile(testFile)
+
+	wanted := &[]core.CouplePreference{
+		{Name: "C1", Members: []string{"R1", "R2"}, Preferences: [][]string{{"H1", "H1"}, {"H1", "H2"}}},
+		{Name: "C2", Members: []string{"R3", "R4"}, Preferences: [][]string{{"H2", "H3"}}},
+	}
+
+	assert.Nil(t, err)
+	assert.Equal(t, wanted, got)
+}
+
+func TestLoadCouplesFromFile_DoesNotExist(t *testing.T) {
+	badFile := "/tmp/badfile.json"
+
+	_, err := LoadCouplesFromFile(badFile)
+
+	if assert.NotNil(t, err) {
+		assert.Equal(t,
+			fmt.Sprintf("open %v: no such file or directory", badFile), err.Error())
+	}
+}
+
+func TestLoadCouplesFromIO(t *testing.T) {
+	body := `[{ "name":"C1", "members": ["R1", "R2"], "preferences": [["H2", "H1"]] }]`
+
+	got, err := LoadCouplesFromIO(strings.NewReader(body))
+
+	wanted := &[]core.CouplePreference{
+		{Name: "C1", Members: []string{"R1", "R2"}, Preferences: [][]string{{"H2", "H1"}}},
+	}
+
+	assert.Nil(t, err)
+	assert.Equal(t, wanted, got)
+}
+
+func TestLoadCouplesFromIO_UnmarshallError(t *testing.T) {
+	_, err := LoadCouplesFromIO(strings.NewReader(`[{ "name":"C1" }`))
+
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "unexpected end of JSON input", err.Error())
+	}
+}
+
 func writeToFile(filename, body string) {
 	file, err := os.Create(filename)
 	if err != nil {
@@ -0,0 +1,149 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// CouplesValidator contains all information required to validate the couples
+// of a two-sided problem, such as the residents of Hospitals/Residents who
+// apply together.
+//
+// Each couple is made up of two distinct members of the first table, who
+// belong to no other couple and rank no one individually. The couple instead
+// ranks pairs of members of the second table. The tables themselves should be
+// validated separately.
+type CouplesValidator struct {
+	Couples *[]core.CouplePreference
+	Tables  []*core.PreferenceTable
+	Err     error
+}
+
+// Validate validates the couples specified in the struct.
+func (v CouplesValidator) Validate() error {
+	var err error
+
+	// This should already be verified upstream
+	if len(v.Tables) != 2 {
+		return errors.New("Internal error: expected exactly 2 Tables")
+	}
+
+	if v.Couples == nil {
+		return nil
+	}
+
+	err = v.validateNames()
+	if err != nil {
+		return err
+	}
+
+	err = v.validateMembers()
+	if err != nil {
+		return err
+	}
+
+	err = v.validatePreferences()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateNames validates that couple names are non-blank, unique, and
+// distinct from the members of both tables.
+func (v CouplesValidator) validateNames() error {
+	cache := make(map[string]bool, len(*v.Couples))
+
+	for i := range *v.Couples {
+		name := (*v.Couples)[i].Name
+
+		if name == "" {
+			return errors.New("All couple names must be non-blank")
+		}
+
+		if cache[name] {
+			msg := fmt.Sprintf("Couple names must be unique. Found duplicate entry '%v'", name)
+			return errors.New(msg)
+		}
+
+		for t := range v.Tables {
+			if (*v.Tables[t])[name] != nil {
+				msg := fmt.Sprintf("Couples and members must have distinct names. '%v' found in both", name)
+				return errors.New(msg)
+			}
+		}
+
+		cache[name] = true
+	}
+
+	return nil
+}
+
+// validateMembers validates that each couple is made up of two distinct
+// members of the first table, who belong to no other couple and have an empty
+// preference list of their own.
+func (v CouplesValidator) validateMembers() error {
+	coupleOf := make(map[string]string)
+
+	for i := range *v.Couples {
+		couple := (*v.Couples)[i]
+
+		if len(couple.Members) != 2 || couple.Members[0] == couple.Members[1] {
+			return errors.New(
+				fmt.Sprintf("Couple '%v' must have exactly 2 distinct members", couple.Name))
+		}
+
+		for _, name := range couple.Members {
+			member := (*v.Tables[0])[name]
+
+			if member == nil {
+				return errors.New(
+					fmt.Sprintf("Members of couple '%v' must be members of the first table", couple.Name))
+			}
+
+			if other, ok := coupleOf[name]; ok {
+				return errors.New(
+					fmt.Sprintf("'%v' can not belong to both couple '%v' and '%v'", name, other, couple.Name))
+			}
+
+			if len(member.PreferenceList().Members()) > 0 {
+				return errors.New(
+					fmt.Sprintf("Preference list for '%v' must be empty, since they apply as part of couple '%v'", name, couple.Name))
+			}
+
+			coupleOf[name] = couple.Name
+		}
+	}
+
+	return nil
+}
+
+// validatePreferences validates that each couple only ranks distinct pairs of
+// members of the second table.
+func (v CouplesValidator) validatePreferences() error {
+	for i := range *v.Couples {
+		couple := (*v.Couples)[i]
+		seen := make(map[[2]string]bool, len(couple.Preferences))
+
+		for _, pair := range couple.Preferences {
+			if len(pair) != 2 || (*v.Tables[1])[pair[0]] == nil || (*v.Tables[1])[pair[1]] == nil {
+				return errors.New(
+					fmt.Sprintf("Preferences for couple '%v' must be pairs of members of the second table", couple.Name))
+			}
+
+			key := [2]string{pair[0], pair[1]}
+
+			if seen[key] {
+				return errors.New(
+					fmt.Sprintf("Preferences for couple '%v' contain duplicate pairs", couple.Name))
+			}
+
+			seen[key] = true
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func couplesPrefs() ([]*[]core.MatchPreference, *[]core.CouplePreference) {
+	prefsSet := []*[]core.MatchPreference{
+		{
+			{Name: "R1", Preferences: []string{"H1", "H2"}},
+			{Name: "R2"},
+			{Name: "R3"},
+		},
+		{
+			{Name: "H1", Preferences: []string{"R2", "R1", "R3"}, Capacity: 2},
+			{Name: "H2", Preferences: []string{"R3", "R1"}},
+		},
+	}
+
+	couples := &[]core.CouplePreference{
+		{Name: "C1", Members: []string{"R2", "R3"}, Preferences: [][]string{{"H1", "H1"}, {"H1", "H2"}}},
+	}
+
+	return prefsSet, couples
+}
+
+func validateCouples(prefsSet []*[]core.MatchPreference, couples *[]core.CouplePreference) error {
+	tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+	v := CouplesValidator{
+		Couples: couples,
+		Tables:  []*core.PreferenceTable{&tables[0], &tables[1]},
+	}
+
+	return v.Validate()
+}
+
+func TestValidate__Couples(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		err := validateCouples(couplesPrefs())
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("no couples", func(t *testing.T) {
+		prefsSet, _ := couplesPrefs()
+
+		err := validateCouples(prefsSet, nil)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("bad number of tables", func(t *testing.T) {
+		prefsSet, couples := couplesPrefs()
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := CouplesValidator{
+			Couples: couples,
+			Tables:  []*core.PreferenceTable{&tables[0]},
+		}
+		err := v.Validate()
+
+		assert.Equal(t, "Internal error: expected exactly 2 Tables", err.Error())
+	})
+
+	t.Run("blank couple name", func(t *testing.T) {
+		prefsSet, couples := couplesPrefs()
+		(*couples)[0].Name = ""
+
+		err := validateCouples(prefsSet, couples)
+
+		assert.Equal(t, "All couple names must be non-blank", err.Error())
+	})
+
+	t.Run("duplicate couple names", func(t *testing.T) {
+		prefsSet, couples := couplesPrefs()
+		*couples = append(*couples, core.CouplePreference{Name: "C1"})
+
+		err := validateCouples(prefsSet, couples)
+
+		assert.Equal(t, "Couple names must be unique. Found duplicate entry 'C1'", err.Error())
+	})
+
+	t.Run("couple named after a member", func(t *testing.T) {
+		prefsSet, couples := couplesPrefs()
+		(*couples)[0].Name = "H1"
+
+		err := validateCouples(prefsSet, couples)
+
+		assert.Equal(t, "Couples and members must have distinct names. 'H1' found in both", err.Error())
+	})
+
+	t.Run("wrong number of members", func(t *testing.T) {
+		prefsSet, couples := couplesPrefs()
+		(*couples)[0].Members = []string{"R2"}
+
+		err := validateCouples(prefsSet, couples)
+
+		assert.Equal(t, "Couple 'C1' must have exactly 2 distinct members", err.Error())
+	})
+
+	t.Run("same member twice", func(t *testing.T) {
+		prefsSet, couples := couplesPrefs()
+		(*couples)[0].Members = []string{"R2", "R2"}
+
+		err := validateCouples(prefsSet, couples)
+
+		assert.Equal(t, "Couple 'C1' must have exactly 2 distinct members", err.Error())
+	})
+
+	t.Run("unknown member", func(t *testing.T) {
+		prefsSet, couples := couplesPrefs()
+		(*couples)[0].Members = []string{"R2", "H2"}
+
+		err := validateCouples(prefsSet, couples)
+
+		assert.Equal(t, "Members of couple 'C1' must be members of the first table", err.Error())
+	})
+
+	t.Run("member of two couples", func(t *testing.T) {
+		prefsSet, couples := couplesPrefs()
+		*prefsSet[0] = append(*prefsSet[0], core.MatchPreference{Name: "R4"})
+		*couples = append(*couples, core.CouplePreference{Name: "C2", Members: []string{"R4", "R3"}})
+
+		err := validateCouples(prefsSet, couples)
+
+		assert.Equal(t, "'R3' can not belong to both couple 'C1' and 'C2'", err.Error())
+	})
+
+	t.Run("member with individual preferences", func(t *testing.T) {
+		prefsSet, couples := couplesPrefs()
+		(*couples)[0].Members = []string{"R1", "R2"}
+
+		err := validateCouples(prefsSet, couples)
+
+		assert.Equal(t, "Preference list for 'R1' must be empty, since they apply as part of couple 'C1'", err.Error())
+	})
+
+	t.Run("preference that is not a pair", func(t *testing.T) {
+		prefsSet, couples := couplesPrefs()
+		(*couples)[0].Preferences = append((*couples)[0].Preferences, []string{"H2"})
+
+		err := validateCouples(prefsSet, couples)
+
+		assert.Equal(t, "Preferences for couple 'C1' must be pairs of members of the second table", err.Error())
+	})
+
+	t.Run("preference with unknown member", func(t *testing.T) {
+		prefsSet, couples := couplesPrefs()
+		(*couples)[0].Preferences = append((*couples)[0].Preferences, []string{"H2", "R1"})
+
+		err := validateCouples(prefsSet, couples)
+
+		assert.Equal(t, "Preferences for couple 'C1' must be pairs of members of the second table", err.Error())
+	})
+
+	t.Run("duplicate pairs", func(t *testing.T) {
+		prefsSet, couples := couplesPrefs()
+		(*couples)[0].Preferences = append((*couples)[0].Preferences, []string{"H1", "H1"})
+
+		err := validateCouples(prefsSet, couples)
+
+		assert.Equal(t, "Preferences for couple 'C1' contain duplicate pairs", err.Error())
+	})
+}
@@ -3,16 +3,49 @@ package validate
 import (
 	"errors"
 	"fmt"
+	"math"
 
 	"github.com/abhchand/libmatch/pkg/core"
 )
 
 // DoubleTableValidator contains all information required to validate a pair
 // of preference tables.
+//
+// When `Capacitated` is set, members of the second table may specify a
+// capacity greater than 1 and the tables are no longer required to be the
+// same size. Members of the first table may be left unmatched when the second
+// table does not have enough capacity for all of them.
+//
+// When `LowerQuotas` is set, members of the second table may specify a lower
+// quota, which can not exceed their capacity.
+//
+// When `IncompleteLists` is set, preference lists may omit members of the
+// other table and the tables may be of different sizes.
+//
+// When `Owners` is set, members of the second table may specify an owner from
+// the first table.
+//
+// When `Priority` is set, it must list every member of the first table exactly
+// once.
+//
+// When `Scores` is set, members may score their preferences. Each member that
+// does must give a finite score to every member of their preference list.
+//
+// When `Ties` is set, preference lists may contain members that are tied in
+// rank. When `PriorityClasses` is set, only preference lists of the second
+// table may (e.g. schools that rank students in coarse priority classes).
 type DoubleTableValidator struct {
-	PrefsSet []*[]core.MatchPreference
-	Tables   []*core.PreferenceTable
-	Err      error
+	PrefsSet        []*[]core.MatchPreference
+	Tables          []*core.PreferenceTable
+	Capacitated     bool
+	LowerQuotas     bool
+	IncompleteLists bool
+	Owners          bool
+	Priority        []string
+	Scores          bool
+	Ties            bool
+	PriorityClasses bool
+	Err             error
 }
 
 // Validate validates the pair of preference tables specified in the struct.
@@ -34,6 +67,16 @@ func (v DoubleTableValidator) Validate() error {
 		return err
 	}
 
+	err = v.validateCapacity()
+	if err != nil {
+		return err
+	}
+
+	err = v.validateLowerQuotas()
+	if err != nil {
+		return err
+	}
+
 	err = v.validateSize()
 	if err != nil {
 		return err
@@ -44,6 +87,26 @@ func (v DoubleTableValidator) Validate() error {
 		return err
 	}
 
+	err = v.validateOwners()
+	if err != nil {
+		return err
+	}
+
+	err = v.validatePriority()
+	if err != nil {
+		return err
+	}
+
+	err = v.validateScores()
+	if err != nil {
+		return err
+	}
+
+	err = v.validateTies()
+	if err != nil {
+		return err
+	}
+
 	err = v.validateSymmetry()
 	if err != nil {
 		return err
@@ -92,7 +155,63 @@ func (v DoubleTableValidator) validateTableUniqueness() error {
 	return nil
 }
 
-// validateSize validates that both tables are non-empty and of equal size
+// validateCapacity validates that member capacities are positive, and that
+// only members of the second table of a capacitated problem have a capacity
+// greater than 1.
+func (v DoubleTableValidator) validateCapacity() error {
+	for e := range v.PrefsSet {
+		for i := range *v.PrefsSet[e] {
+			pref := (*v.PrefsSet[e])[i]
+
+			if pref.Capacity < 0 {
+				return errors.New(
+					fmt.Sprintf("Capacity for '%v' must be a positive number", pref.Name))
+			}
+
+			if pref.Capacity > 1 && !(v.Capacitated && e == 1) {
+				return errors.New(
+					fmt.Sprintf("Capacity for '%v' can not be greater than 1", pref.Name))
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateLowerQuotas validates that only members of the second table of a
+// problem with lower quotas specify a lower quota, and that each lower quota is
+// a positive number no greater than the member's capacity.
+func (v DoubleTableValidator) validateLowerQuotas() error {
+	for e := range v.PrefsSet {
+		for i := range *v.PrefsSet[e] {
+			pref := (*v.PrefsSet[e])[i]
+
+			if pref.LowerQuota == 0 {
+				continue
+			}
+
+			if !(v.LowerQuotas && e == 1) {
+				return errors.New(
+					fmt.Sprintf("Lower quota can not be specified for '%v'", pref.Name))
+			}
+
+			if pref.LowerQuota < 0 {
+				return errors.New(
+					fmt.Sprintf("Lower quota for '%v' must be a positive number", pref.Name))
+			}
+
+			if pref.LowerQuota > (*v.Tables[1])[pref.Name].Capacity() {
+				return errors.New(
+					fmt.Sprintf("Lower quota for '%v' can not be greater than its capacity", pref.Name))
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateSize validates that both tables are non-empty and, unless the
+// problem is capacitated or has incomplete lists, of equal size.
 func (v DoubleTableValidator) validateSize() error {
 	sizes := make([]int, 2)
 
@@ -104,6 +223,10 @@ func (v DoubleTableValidator) validateSize() error {
 		}
 	}
 
+	if v.IncompleteLists || v.Capacitated {
+		return nil
+	}
+
 	if sizes[0] != sizes[1] {
 		return errors.New("Tables must be the same size")
 	}
@@ -122,9 +245,135 @@ func (v DoubleTableValidator) validateMembers() error {
 	return nil
 }
 
+// validateOwners validates that only members of the second table of a problem
+// with owners specify an owner, and that each owner is a member of the first
+// table.
+func (v DoubleTableValidator) validateOwners() error {
+	for e := range v.PrefsSet {
+		for i := range *v.PrefsSet[e] {
+			pref := (*v.PrefsSet[e])[i]
+
+			if pref.Owner == "" {
+				continue
+			}
+
+			if !(v.Owners && e == 1) {
+				return errors.New(
+					fmt.Sprintf("Owner can not be specified for '%v'", pref.Name))
+			}
+
+			if (*v.Tables[0])[pref.Owner] == nil {
+				return errors.New(
+					fmt.Sprintf("Owner of '%v' must be a member of the first table", pref.Name))
+			}
+		}
+	}
+
+	return nil
+}
+
+// validatePriority validates that the priority order, when specified, lists
+// every member of the first table exactly once.
+func (v DoubleTableValidator) validatePriority() error {
+	if v.Priority == nil {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(v.Priority))
+
+	for _, name := range v.Priority {
+		if (*v.Tables[0])[name] == nil {
+			return errors.New(
+				fmt.Sprintf("Priority order contains unknown member '%v'", name))
+		}
+
+		if seen[name] {
+			return errors.New(
+				fmt.Sprintf("Priority order contains duplicate member '%v'", name))
+		}
+
+		seen[name] = true
+	}
+
+	if len(seen) != len(*v.Tables[0]) {
+		return errors.New("Priority order must contain every member of the first table")
+	}
+
+	return nil
+}
+
+// validateScores validates that members only score their preferences when
+// scores are allowed, and that each score is a finite number given to a
+// member of their preference list.
+func (v DoubleTableValidator) validateScores() error {
+	for e := range v.PrefsSet {
+		for i := range *v.PrefsSet[e] {
+			pref := (*v.PrefsSet[e])[i]
+
+			if pref.Scores == nil {
+				continue
+			}
+
+			if !v.Scores {
+				return errors.New(
+					fmt.Sprintf("Scores can not be specified for '%v'", pref.Name))
+			}
+
+			if len(pref.Scores) != len(pref.Preferences) {
+				return errors.New(
+					fmt.Sprintf("Scores for '%v' must contain one score for each preference", pref.Name))
+			}
+
+			for s := range pref.Scores {
+				if math.IsNaN(pref.Scores[s]) || math.IsInf(pref.Scores[s], 0) {
+					return errors.New(
+						fmt.Sprintf("Scores for '%v' must be finite numbers", pref.Name))
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateTies validates that preference lists do not contain ties, unless
+// ties are explicitly allowed for all preference lists or for those of the
+// second table.
+func (v DoubleTableValidator) validateTies() error {
+	if v.Ties {
+		return nil
+	}
+
+	for t := range v.Tables {
+		if v.PriorityClasses && t == 1 {
+			continue
+		}
+
+		if err := validateNoTies(v.Tables[t]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // validateSymmetry validates whether the tables are symmetrical. Each member's
 // preferences should contain all members of the other table.
+//
+// When preference lists are incomplete, each member's preferences only need
+// to contain distinct members of the other table.
 func (v DoubleTableValidator) validateSymmetry() error {
+	if v.IncompleteLists {
+		for t := range v.Tables {
+			for name, member := range *v.Tables[t] {
+				if err := validatePreferenceSubset(name, member); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
 
 	// Build a list of member names of both tables
 
@@ -2,6 +2,7 @@ package validate
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/abhchand/libmatch/pkg/core"
@@ -309,4 +310,725 @@ func TestValidate__DoubleTable(t *testing.T) {
 		wanted := fmt.Sprintf("Preference list for '%v' contains at least one unknown member", "L")
 		assert.Equal(t, wanted, err.Error())
 	})
+
+	t.Run("capacitated success", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "L"}},
+				{Name: "B", Preferences: []string{"L", "K"}},
+				{Name: "C", Preferences: []string{"L", "K"}},
+			},
+			{
+				{Name: "K", Preferences: []string{"B", "C", "A"}, Capacity: 2},
+				{Name: "L", Preferences: []string{"A", "C", "B"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := DoubleTableValidator{
+			PrefsSet:    prefsSet,
+			Tables:      []*core.PreferenceTable{&tables[0], &tables[1]},
+			Capacitated: true,
+		}
+		err := v.Validate()
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("capacitated with more members than capacity", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "L"}},
+				{Name: "B", Preferences: []string{"L", "K"}},
+				{Name: "C", Preferences: []string{"L", "K"}},
+			},
+			{
+				{Name: "K", Preferences: []string{"B", "C", "A"}},
+				{Name: "L", Preferences: []string{"A", "C", "B"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := DoubleTableValidator{
+			PrefsSet:    prefsSet,
+			Tables:      []*core.PreferenceTable{&tables[0], &tables[1]},
+			Capacitated: true,
+		}
+		err := v.Validate()
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("capacity on first table", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "L"}, Capacity: 2},
+				{Name: "B", Preferences: []string{"L", "K"}},
+			},
+			{
+				{Name: "K", Preferences: []string{"B", "A"}, Capacity: 2},
+				{Name: "L", Preferences: []string{"A", "B"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := DoubleTableValidator{
+			PrefsSet:    prefsSet,
+			Tables:      []*core.PreferenceTable{&tables[0], &tables[1]},
+			Capacitated: true,
+		}
+		err := v.Validate()
+
+		wanted := fmt.Sprintf("Capacity for '%v' can not be greater than 1", "A")
+		assert.Equal(t, wanted, err.Error())
+	})
+
+	t.Run("capacity when not capacitated", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "L"}},
+				{Name: "B", Preferences: []string{"L", "K"}},
+			},
+			{
+				{Name: "K", Preferences: []string{"B", "A"}, Capacity: 2},
+				{Name: "L", Preferences: []string{"A", "B"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := DoubleTableValidator{
+			PrefsSet: prefsSet,
+			Tables:   []*core.PreferenceTable{&tables[0], &tables[1]},
+		}
+		err := v.Validate()
+
+		wanted := fmt.Sprintf("Capacity for '%v' can not be greater than 1", "K")
+		assert.Equal(t, wanted, err.Error())
+	})
+
+	t.Run("negative capacity", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "L"}},
+				{Name: "B", Preferences: []string{"L", "K"}},
+			},
+			{
+				{Name: "K", Preferences: []string{"B", "A"}, Capacity: -1},
+				{Name: "L", Preferences: []string{"A", "B"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := DoubleTableValidator{
+			PrefsSet:    prefsSet,
+			Tables:      []*core.PreferenceTable{&tables[0], &tables[1]},
+			Capacitated: true,
+		}
+		err := v.Validate()
+
+		wanted := fmt.Sprintf("Capacity for '%v' must be a positive number", "K")
+		assert.Equal(t, wanted, err.Error())
+	})
+
+	t.Run("lower quotas success", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "L"}},
+				{Name: "B", Preferences: []string{"L", "K"}},
+			},
+			{
+				{Name: "K", Preferences: []string{"B", "A"}, Capacity: 2, LowerQuota: 2},
+				{Name: "L", Preferences: []string{"A", "B"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := DoubleTableValidator{
+			PrefsSet:    prefsSet,
+			Tables:      []*core.PreferenceTable{&tables[0], &tables[1]},
+			Capacitated: true,
+			LowerQuotas: true,
+		}
+		err := v.Validate()
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("lower quota on first table", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "L"}, LowerQuota: 1},
+				{Name: "B", Preferences: []string{"L", "K"}},
+			},
+			{
+				{Name: "K", Preferences: []string{"B", "A"}},
+				{Name: "L", Preferences: []string{"A", "B"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := DoubleTableValidator{
+			PrefsSet:    prefsSet,
+			Tables:      []*core.PreferenceTable{&tables[0], &tables[1]},
+			Capacitated: true,
+			LowerQuotas: true,
+		}
+		err := v.Validate()
+
+		wanted := fmt.Sprintf("Lower quota can not be specified for '%v'", "A")
+		assert.Equal(t, wanted, err.Error())
+	})
+
+	t.Run("lower quota when not allowed", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "L"}},
+				{Name: "B", Preferences: []string{"L", "K"}},
+			},
+			{
+				{Name: "K", Preferences: []string{"B", "A"}, LowerQuota: 1},
+				{Name: "L", Preferences: []string{"A", "B"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := DoubleTableValidator{
+			PrefsSet:    prefsSet,
+			Tables:      []*core.PreferenceTable{&tables[0], &tables[1]},
+			Capacitated: true,
+		}
+		err := v.Validate()
+
+		wanted := fmt.Sprintf("Lower quota can not be specified for '%v'", "K")
+		assert.Equal(t, wanted, err.Error())
+	})
+
+	t.Run("negative lower quota", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "L"}},
+				{Name: "B", Preferences: []string{"L", "K"}},
+			},
+			{
+				{Name: "K", Preferences: []string{"B", "A"}, LowerQuota: -1},
+				{Name: "L", Preferences: []string{"A", "B"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := DoubleTableValidator{
+			PrefsSet:    prefsSet,
+			Tables:      []*core.PreferenceTable{&tables[0], &tables[1]},
+			Capacitated: true,
+			LowerQuotas: true,
+		}
+		err := v.Validate()
+
+		wanted := fmt.Sprintf("Lower quota for '%v' must be a positive number", "K")
+		assert.Equal(t, wanted, err.Error())
+	})
+
+	t.Run("lower quota greater than capacity", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "L"}},
+				{Name: "B", Preferences: []string{"L", "K"}},
+			},
+			{
+				{Name: "K", Preferences: []string{"B", "A"}, Capacity: 2, LowerQuota: 3},
+				{Name: "L", Preferences: []string{"A", "B"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := DoubleTableValidator{
+			PrefsSet:    prefsSet,
+			Tables:      []*core.PreferenceTable{&tables[0], &tables[1]},
+			Capacitated: true,
+			LowerQuotas: true,
+		}
+		err := v.Validate()
+
+		wanted := fmt.Sprintf("Lower quota for '%v' can not be greater than its capacity", "K")
+		assert.Equal(t, wanted, err.Error())
+	})
+
+	t.Run("owners success", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "L"}},
+				{Name: "B", Preferences: []string{"L", "K"}},
+			},
+			{
+				{Name: "K", Preferences: []string{}, Owner: "A"},
+				{Name: "L", Preferences: []string{}, Owner: "B"},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := DoubleTableValidator{
+			PrefsSet:        prefsSet,
+			Tables:          []*core.PreferenceTable{&tables[0], &tables[1]},
+			IncompleteLists: true,
+			Owners:          true,
+		}
+		err := v.Validate()
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("owner on first table", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "L"}, Owner: "B"},
+				{Name: "B", Preferences: []string{"L", "K"}},
+			},
+			{
+				{Name: "K", Preferences: []string{"B", "A"}},
+				{Name: "L", Preferences: []string{"A", "B"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := DoubleTableValidator{
+			PrefsSet: prefsSet,
+			Tables:   []*core.PreferenceTable{&tables[0], &tables[1]},
+			Owners:   true,
+		}
+		err := v.Validate()
+
+		wanted := fmt.Sprintf("Owner can not be specified for '%v'", "A")
+		assert.Equal(t, wanted, err.Error())
+	})
+
+	t.Run("owner when not allowed", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "L"}},
+				{Name: "B", Preferences: []string{"L", "K"}},
+			},
+			{
+				{Name: "K", Preferences: []string{"B", "A"}, Owner: "A"},
+				{Name: "L", Preferences: []string{"A", "B"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := DoubleTableValidator{
+			PrefsSet: prefsSet,
+			Tables:   []*core.PreferenceTable{&tables[0], &tables[1]},
+		}
+		err := v.Validate()
+
+		wanted := fmt.Sprintf("Owner can not be specified for '%v'", "K")
+		assert.Equal(t, wanted, err.Error())
+	})
+
+	t.Run("unknown owner", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "L"}},
+				{Name: "B", Preferences: []string{"L", "K"}},
+			},
+			{
+				{Name: "K", Preferences: []string{"B", "A"}, Owner: "X"},
+				{Name: "L", Preferences: []string{"A", "B"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := DoubleTableValidator{
+			PrefsSet: prefsSet,
+			Tables:   []*core.PreferenceTable{&tables[0], &tables[1]},
+			Owners:   true,
+		}
+		err := v.Validate()
+
+		wanted := fmt.Sprintf("Owner of '%v' must be a member of the first table", "K")
+		assert.Equal(t, wanted, err.Error())
+	})
+
+	t.Run("priority success", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "L"}},
+				{Name: "B", Preferences: []string{"L"}},
+			},
+			{
+				{Name: "K"},
+				{Name: "L"},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := DoubleTableValidator{
+			PrefsSet:        prefsSet,
+			Tables:          []*core.PreferenceTable{&tables[0], &tables[1]},
+			IncompleteLists: true,
+			Priority:        []string{"B", "A"},
+		}
+		err := v.Validate()
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("priority with unknown member", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "L"}},
+				{Name: "B", Preferences: []string{"L"}},
+			},
+			{
+				{Name: "K"},
+				{Name: "L"},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := DoubleTableValidator{
+			PrefsSet:        prefsSet,
+			Tables:          []*core.PreferenceTable{&tables[0], &tables[1]},
+			IncompleteLists: true,
+			Priority:        []string{"B", "K"},
+		}
+		err := v.Validate()
+
+		wanted := fmt.Sprintf("Priority order contains unknown member '%v'", "K")
+		assert.Equal(t, wanted, err.Error())
+	})
+
+	t.Run("priority with duplicate member", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "L"}},
+				{Name: "B", Preferences: []string{"L"}},
+			},
+			{
+				{Name: "K"},
+				{Name: "L"},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := DoubleTableValidator{
+			PrefsSet:        prefsSet,
+			Tables:          []*core.PreferenceTable{&tables[0], &tables[1]},
+			IncompleteLists: true,
+			Priority:        []string{"B", "A", "B"},
+		}
+		err := v.Validate()
+
+		wanted := fmt.Sprintf("Priority order contains duplicate member '%v'", "B")
+		assert.Equal(t, wanted, err.Error())
+	})
+
+	t.Run("priority with missing member", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "L"}},
+				{Name: "B", Preferences: []string{"L"}},
+			},
+			{
+				{Name: "K"},
+				{Name: "L"},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := DoubleTableValidator{
+			PrefsSet:        prefsSet,
+			Tables:          []*core.PreferenceTable{&tables[0], &tables[1]},
+			IncompleteLists: true,
+			Priority:        []string{"B"},
+		}
+		err := v.Validate()
+
+		assert.Equal(t, "Priority order must contain every member of the first table", err.Error())
+	})
+
+	t.Run("scores success", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "L"}, Scores: []float64{2, -1.5}},
+				{Name: "B", Preferences: []string{"L", "K"}},
+			},
+			{
+				{Name: "K", Preferences: []string{"A", "B"}, Scores: []float64{0, 3}},
+				{Name: "L", Preferences: []string{"B", "A"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := DoubleTableValidator{
+			PrefsSet: prefsSet,
+			Tables:   []*core.PreferenceTable{&tables[0], &tables[1]},
+			Scores:   true,
+		}
+		err := v.Validate()
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("scores when not allowed", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "L"}},
+				{Name: "B", Preferences: []string{"L", "K"}},
+			},
+			{
+				{Name: "K", Preferences: []string{"A", "B"}, Scores: []float64{0, 3}},
+				{Name: "L", Preferences: []string{"B", "A"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := DoubleTableValidator{
+			PrefsSet: prefsSet,
+			Tables:   []*core.PreferenceTable{&tables[0], &tables[1]},
+		}
+		err := v.Validate()
+
+		wanted := fmt.Sprintf("Scores can not be specified for '%v'", "K")
+		assert.Equal(t, wanted, err.Error())
+	})
+
+	t.Run("scores with missing score", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "L"}, Scores: []float64{2}},
+				{Name: "B", Preferences: []string{"L", "K"}},
+			},
+			{
+				{Name: "K", Preferences: []string{"A", "B"}},
+				{Name: "L", Preferences: []string{"B", "A"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := DoubleTableValidator{
+			PrefsSet: prefsSet,
+			Tables:   []*core.PreferenceTable{&tables[0], &tables[1]},
+			Scores:   true,
+		}
+		err := v.Validate()
+
+		wanted := fmt.Sprintf("Scores for '%v' must contain one score for each preference", "A")
+		assert.Equal(t, wanted, err.Error())
+	})
+
+	t.Run("scores with infinite score", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "L"}, Scores: []float64{2, math.Inf(1)}},
+				{Name: "B", Preferences: []string{"L", "K"}},
+			},
+			{
+				{Name: "K", Preferences: []string{"A", "B"}},
+				{Name: "L", Preferences: []string{"B", "A"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := DoubleTableValidator{
+			PrefsSet: prefsSet,
+			Tables:   []*core.PreferenceTable{&tables[0], &tables[1]},
+			Scores:   true,
+		}
+		err := v.Validate()
+
+		wanted := fmt.Sprintf("Scores for '%v' must be finite numbers", "A")
+		assert.Equal(t, wanted, err.Error())
+	})
+
+	t.Run("incomplete lists success", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K"}},
+				{Name: "B", Preferences: []string{}},
+				{Name: "C", Preferences: []string{"L", "K"}},
+			},
+			{
+				{Name: "K", Preferences: []string{"C", "A"}},
+				{Name: "L", Preferences: []string{"A"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := DoubleTableValidator{
+			PrefsSet:        prefsSet,
+			Tables:          []*core.PreferenceTable{&tables[0], &tables[1]},
+			IncompleteLists: true,
+		}
+		err := v.Validate()
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("incomplete lists with unknown member", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K"}},
+				{Name: "B", Preferences: []string{"X"}},
+			},
+			{
+				{Name: "K", Preferences: []string{"A"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := DoubleTableValidator{
+			PrefsSet:        prefsSet,
+			Tables:          []*core.PreferenceTable{&tables[0], &tables[1]},
+			IncompleteLists: true,
+		}
+		err := v.Validate()
+
+		wanted := fmt.Sprintf("Preference list for '%v' contains at least one unknown member", "B")
+		assert.Equal(t, wanted, err.Error())
+	})
+
+	t.Run("incomplete lists with duplicate member", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "K"}},
+				{Name: "B", Preferences: []string{"K"}},
+			},
+			{
+				{Name: "K", Preferences: []string{"A"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := DoubleTableValidator{
+			PrefsSet:        prefsSet,
+			Tables:          []*core.PreferenceTable{&tables[0], &tables[1]},
+			IncompleteLists: true,
+		}
+		err := v.Validate()
+
+		wanted := fmt.Sprintf("Preference list for '%v' contains duplicate members", "A")
+		assert.Equal(t, wanted, err.Error())
+	})
+
+	t.Run("ties success", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", RankedPreferences: [][]string{{"K", "L"}}},
+				{Name: "B", Preferences: []string{"L", "K"}},
+			},
+			{
+				{Name: "K", Preferences: []string{"A", "B"}},
+				{Name: "L", RankedPreferences: [][]string{{"B"}, {"A"}}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := DoubleTableValidator{
+			PrefsSet: prefsSet,
+			Tables:   []*core.PreferenceTable{&tables[0], &tables[1]},
+			Ties:     true,
+		}
+		err := v.Validate()
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("ties when not allowed", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", RankedPreferences: [][]string{{"K", "L"}}},
+				{Name: "B", Preferences: []string{"L", "K"}},
+			},
+			{
+				{Name: "K", Preferences: []string{"A", "B"}},
+				{Name: "L", Preferences: []string{"B", "A"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := DoubleTableValidator{
+			PrefsSet: prefsSet,
+			Tables:   []*core.PreferenceTable{&tables[0], &tables[1]},
+		}
+		err := v.Validate()
+
+		wanted := fmt.Sprintf("Preference list for '%v' can not contain ties", "A")
+		assert.Equal(t, wanted, err.Error())
+	})
+
+	t.Run("priority classes success", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "L"}},
+				{Name: "B", Preferences: []string{"L", "K"}},
+			},
+			{
+				{Name: "K", RankedPreferences: [][]string{{"A", "B"}}},
+				{Name: "L", Preferences: []string{"B", "A"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := DoubleTableValidator{
+			PrefsSet:        prefsSet,
+			Tables:          []*core.PreferenceTable{&tables[0], &tables[1]},
+			PriorityClasses: true,
+		}
+		err := v.Validate()
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("priority classes on first table", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", RankedPreferences: [][]string{{"K", "L"}}},
+				{Name: "B", Preferences: []string{"L", "K"}},
+			},
+			{
+				{Name: "K", RankedPreferences: [][]string{{"A", "B"}}},
+				{Name: "L", Preferences: []string{"B", "A"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := DoubleTableValidator{
+			PrefsSet:        prefsSet,
+			Tables:          []*core.PreferenceTable{&tables[0], &tables[1]},
+			PriorityClasses: true,
+		}
+		err := v.Validate()
+
+		wanted := fmt.Sprintf("Preference list for '%v' can not contain ties", "A")
+		assert.Equal(t, wanted, err.Error())
+	})
 }
@@ -0,0 +1,185 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// OneSidedValidator contains all information required to validate a pair of
+// preference tables for a one-sided problem, where only members of the first
+// table (e.g. applicants) have preferences over members of the second table
+// (e.g. houses).
+//
+// Preference lists may always omit members of the second table, and the
+// tables may be of different sizes. Members of the second table may not have
+// preferences of their own.
+//
+// When `Capacitated` is set, members of the second table may specify a
+// capacity greater than 1.
+//
+// When `Ties` is set, preference lists may contain members that are tied in
+// rank.
+type OneSidedValidator struct {
+	PrefsSet    []*[]core.MatchPreference
+	Tables      []*core.PreferenceTable
+	Capacitated bool
+	Ties        bool
+	Err         error
+}
+
+// Validate validates the pair of preference tables specified in the struct.
+func (v OneSidedValidator) Validate() error {
+	var err error
+
+	// This should already be verified upstream
+	if len(v.PrefsSet) != 2 || len(v.Tables) != 2 {
+		return errors.New("Internal error: expected exactly 2 Prefs and 2 Tables")
+	}
+
+	err = v.validatePrefsUniqueness()
+	if err != nil {
+		return err
+	}
+
+	err = v.validateTableUniqueness()
+	if err != nil {
+		return err
+	}
+
+	err = v.validateCapacity()
+	if err != nil {
+		return err
+	}
+
+	err = v.validateSize()
+	if err != nil {
+		return err
+	}
+
+	err = v.validateMembers()
+	if err != nil {
+		return err
+	}
+
+	err = v.validatePreferences()
+	if err != nil {
+		return err
+	}
+
+	err = v.validateTies()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validatePrefsUniqueness validates that all member names are unique
+func (v OneSidedValidator) validatePrefsUniqueness() error {
+	for e := range v.PrefsSet {
+		cache := make(map[string]bool, 0)
+
+		for i := range *v.PrefsSet[e] {
+			name := (*v.PrefsSet[e])[i].Name
+
+			if cache[name] {
+				msg := fmt.Sprintf("Member names must be unique. Found duplicate entry '%v'", name)
+				return errors.New(msg)
+			}
+
+			cache[name] = true
+		}
+	}
+
+	return nil
+}
+
+// validateTableUniqueness validates that both tables have distinct sets of
+// members
+func (v OneSidedValidator) validateTableUniqueness() error {
+	for name := range *v.Tables[0] {
+		if (*v.Tables[1])[name] != nil {
+			msg := fmt.Sprintf("Tables must have distinct members. '%v' found in both tables", name)
+			return errors.New(msg)
+		}
+	}
+
+	return nil
+}
+
+// validateCapacity validates that member capacities are positive, and that
+// only members of the second table of a capacitated problem have a capacity
+// greater than 1.
+func (v OneSidedValidator) validateCapacity() error {
+	for e := range v.PrefsSet {
+		for i := range *v.PrefsSet[e] {
+			pref := (*v.PrefsSet[e])[i]
+
+			if pref.Capacity < 0 {
+				return errors.New(
+					fmt.Sprintf("Capacity for '%v' must be a positive number", pref.Name))
+			}
+
+			if pref.Capacity > 1 && !(v.Capacitated && e == 1) {
+				return errors.New(
+					fmt.Sprintf("Capacity for '%v' can not be greater than 1", pref.Name))
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateSize validates that both tables are non-empty.
+func (v OneSidedValidator) validateSize() error {
+	for t := range v.Tables {
+		if len(*v.Tables[t]) == 0 {
+			return errors.New("Table must be non-empty")
+		}
+	}
+
+	return nil
+}
+
+// validateMembers validates that members are non-blank.
+func (v OneSidedValidator) validateMembers() error {
+	for t := range v.Tables {
+		if (*v.Tables[t])[""] != nil {
+			return errors.New("All member names must non-blank")
+		}
+	}
+
+	return nil
+}
+
+// validatePreferences validates that members of the first table only prefer
+// distinct members of the second table, and that members of the second table
+// have no preferences.
+func (v OneSidedValidator) validatePreferences() error {
+	for name, member := range *v.Tables[0] {
+		if err := validatePreferenceSubset(name, member); err != nil {
+			return err
+		}
+	}
+
+	for name, member := range *v.Tables[1] {
+		if len(member.PreferenceList().Members()) > 0 {
+			return errors.New(
+				fmt.Sprintf("Preference list for '%v' must be empty", name))
+		}
+	}
+
+	return nil
+}
+
+// validateTies validates that preference lists do not contain ties, unless
+// ties are explicitly allowed.
+func (v OneSidedValidator) validateTies() error {
+	if v.Ties {
+		return nil
+	}
+
+	return validateNoTies(v.Tables[0])
+}
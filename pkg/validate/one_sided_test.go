@@ -0,0 +1,326 @@
+package validate
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate__OneSided(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "L"}},
+				{Name: "B", Preferences: []string{"L"}},
+				{Name: "C", Preferences: []string{}},
+			},
+			{
+				{Name: "K"},
+				{Name: "L"},
+				{Name: "M"},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := OneSidedValidator{
+			PrefsSet: prefsSet,
+			Tables:   []*core.PreferenceTable{&tables[0], &tables[1]},
+		}
+		err := v.Validate()
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("bad number of prefs", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K"}},
+			},
+			{
+				{Name: "K"},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := OneSidedValidator{
+			PrefsSet: []*[]core.MatchPreference{prefsSet[0]},
+			Tables:   []*core.PreferenceTable{&tables[0], &tables[1]},
+		}
+		err := v.Validate()
+
+		assert.Equal(t, "Internal error: expected exactly 2 Prefs and 2 Tables", err.Error())
+	})
+
+	t.Run("non-unique names", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K"}},
+				{Name: "A", Preferences: []string{"L"}},
+			},
+			{
+				{Name: "K"},
+				{Name: "L"},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := OneSidedValidator{
+			PrefsSet: prefsSet,
+			Tables:   []*core.PreferenceTable{&tables[0], &tables[1]},
+		}
+		err := v.Validate()
+
+		wanted := fmt.Sprintf("Member names must be unique. Found duplicate entry '%v'", "A")
+		assert.Equal(t, wanted, err.Error())
+	})
+
+	t.Run("tables not distinct", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K"}},
+				{Name: "K", Preferences: []string{"K"}},
+			},
+			{
+				{Name: "K"},
+				{Name: "L"},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := OneSidedValidator{
+			PrefsSet: prefsSet,
+			Tables:   []*core.PreferenceTable{&tables[0], &tables[1]},
+		}
+		err := v.Validate()
+
+		wanted := fmt.Sprintf("Tables must have distinct members. '%v' found in both tables", "K")
+		assert.Equal(t, wanted, err.Error())
+	})
+
+	t.Run("capacity greater than 1", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K"}},
+			},
+			{
+				{Name: "K", Capacity: 2},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := OneSidedValidator{
+			PrefsSet: prefsSet,
+			Tables:   []*core.PreferenceTable{&tables[0], &tables[1]},
+		}
+		err := v.Validate()
+
+		wanted := fmt.Sprintf("Capacity for '%v' can not be greater than 1", "K")
+		assert.Equal(t, wanted, err.Error())
+	})
+
+	t.Run("capacity success", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K"}},
+				{Name: "B", Preferences: []string{"K"}},
+			},
+			{
+				{Name: "K", Capacity: 2},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := OneSidedValidator{
+			PrefsSet:    prefsSet,
+			Tables:      []*core.PreferenceTable{&tables[0], &tables[1]},
+			Capacitated: true,
+		}
+		err := v.Validate()
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("capacity on first table", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K"}, Capacity: 2},
+			},
+			{
+				{Name: "K"},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := OneSidedValidator{
+			PrefsSet:    prefsSet,
+			Tables:      []*core.PreferenceTable{&tables[0], &tables[1]},
+			Capacitated: true,
+		}
+		err := v.Validate()
+
+		wanted := fmt.Sprintf("Capacity for '%v' can not be greater than 1", "A")
+		assert.Equal(t, wanted, err.Error())
+	})
+
+	t.Run("empty table", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{}},
+			},
+			{},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := OneSidedValidator{
+			PrefsSet: prefsSet,
+			Tables:   []*core.PreferenceTable{&tables[0], &tables[1]},
+		}
+		err := v.Validate()
+
+		assert.Equal(t, "Table must be non-empty", err.Error())
+	})
+
+	t.Run("blank member", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K"}},
+				{Name: "", Preferences: []string{"K"}},
+			},
+			{
+				{Name: "K"},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := OneSidedValidator{
+			PrefsSet: prefsSet,
+			Tables:   []*core.PreferenceTable{&tables[0], &tables[1]},
+		}
+		err := v.Validate()
+
+		assert.Equal(t, "All member names must non-blank", err.Error())
+	})
+
+	t.Run("unknown member", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "X"}},
+			},
+			{
+				{Name: "K"},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := OneSidedValidator{
+			PrefsSet: prefsSet,
+			Tables:   []*core.PreferenceTable{&tables[0], &tables[1]},
+		}
+		err := v.Validate()
+
+		wanted := fmt.Sprintf("Preference list for '%v' contains at least one unknown member", "A")
+		assert.Equal(t, wanted, err.Error())
+	})
+
+	t.Run("duplicate member", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "K"}},
+			},
+			{
+				{Name: "K"},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := OneSidedValidator{
+			PrefsSet: prefsSet,
+			Tables:   []*core.PreferenceTable{&tables[0], &tables[1]},
+		}
+		err := v.Validate()
+
+		wanted := fmt.Sprintf("Preference list for '%v' contains duplicate members", "A")
+		assert.Equal(t, wanted, err.Error())
+	})
+
+	t.Run("second table with preferences", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K"}},
+			},
+			{
+				{Name: "K", Preferences: []string{"A"}},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := OneSidedValidator{
+			PrefsSet: prefsSet,
+			Tables:   []*core.PreferenceTable{&tables[0], &tables[1]},
+		}
+		err := v.Validate()
+
+		wanted := fmt.Sprintf("Preference list for '%v' must be empty", "K")
+		assert.Equal(t, wanted, err.Error())
+	})
+
+	t.Run("ties success", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "L"}, RankedPreferences: [][]string{{"K", "L"}}},
+			},
+			{
+				{Name: "K"},
+				{Name: "L"},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := OneSidedValidator{
+			PrefsSet: prefsSet,
+			Tables:   []*core.PreferenceTable{&tables[0], &tables[1]},
+			Ties:     true,
+		}
+		err := v.Validate()
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("ties not allowed", func(t *testing.T) {
+		prefsSet := []*[]core.MatchPreference{
+			{
+				{Name: "A", Preferences: []string{"K", "L"}, RankedPreferences: [][]string{{"K", "L"}}},
+			},
+			{
+				{Name: "K"},
+				{Name: "L"},
+			},
+		}
+
+		tables := core.NewPreferenceTablePair(prefsSet[0], prefsSet[1])
+
+		v := OneSidedValidator{
+			PrefsSet: prefsSet,
+			Tables:   []*core.PreferenceTable{&tables[0], &tables[1]},
+		}
+		err := v.Validate()
+
+		wanted := fmt.Sprintf("Preference list for '%v' can not contain ties", "A")
+		assert.Equal(t, wanted, err.Error())
+	})
+}
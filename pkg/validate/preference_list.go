@@ -0,0 +1,49 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// validatePreferenceSubset validates that a member's preference list only
+// contains known members, each listed at most once.
+func validatePreferenceSubset(name string, member *core.Member) error {
+	prefs := member.PreferenceList().Members()
+	seen := make(map[string]bool, len(prefs))
+
+	for p := range prefs {
+		/*
+		 * The only way a PreferenceList member would be `nil` is if it referenced
+		 * a member that does not exist. That is, no `Member` value could be determined
+		 * when constructing the PreferenceTable.
+		 */
+		if prefs[p] == nil {
+			return errors.New(
+				fmt.Sprintf("Preference list for '%v' contains at least one unknown member", name))
+		}
+
+		if seen[prefs[p].Name()] {
+			return errors.New(
+				fmt.Sprintf("Preference list for '%v' contains duplicate members", name))
+		}
+
+		seen[prefs[p].Name()] = true
+	}
+
+	return nil
+}
+
+// validateNoTies validates that no preference list in a table contains members
+// that are tied in rank.
+func validateNoTies(table *core.PreferenceTable) error {
+	for name, member := range *table {
+		if member.PreferenceList().HasTies() {
+			return errors.New(
+				fmt.Sprintf("Preference list for '%v' can not contain ties", name))
+		}
+	}
+
+	return nil
+}
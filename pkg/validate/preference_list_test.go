@@ -0,0 +1,81 @@
+package validate
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePreferenceSubset(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		prefs := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"C"}},
+			{Name: "B", Preferences: []string{}},
+			{Name: "C", Preferences: []string{"B", "A"}},
+		}
+
+		table := core.NewPreferenceTable(&prefs)
+
+		for name, member := range table {
+			assert.Nil(t, validatePreferenceSubset(name, member))
+		}
+	})
+
+	t.Run("unknown member", func(t *testing.T) {
+		prefs := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"X"}},
+		}
+
+		table := core.NewPreferenceTable(&prefs)
+
+		err := validatePreferenceSubset("A", table["A"])
+
+		wanted := fmt.Sprintf("Preference list for '%v' contains at least one unknown member", "A")
+		assert.Equal(t, wanted, err.Error())
+	})
+
+	t.Run("duplicate member", func(t *testing.T) {
+		prefs := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "B"}},
+			{Name: "B", Preferences: []string{"A"}},
+		}
+
+		table := core.NewPreferenceTable(&prefs)
+
+		err := validatePreferenceSubset("A", table["A"])
+
+		wanted := fmt.Sprintf("Preference list for '%v' contains duplicate members", "A")
+		assert.Equal(t, wanted, err.Error())
+	})
+}
+
+func TestValidateNoTies(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		prefs := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "C"}},
+			{Name: "B", RankedPreferences: [][]string{{"A"}, {"C"}}},
+			{Name: "C", Preferences: []string{"A", "B"}},
+		}
+
+		table := core.NewPreferenceTable(&prefs)
+
+		assert.Nil(t, validateNoTies(&table))
+	})
+
+	t.Run("tied members", func(t *testing.T) {
+		prefs := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "C"}},
+			{Name: "B", RankedPreferences: [][]string{{"A", "C"}}},
+			{Name: "C", Preferences: []string{"A", "B"}},
+		}
+
+		table := core.NewPreferenceTable(&prefs)
+
+		err := validateNoTies(&table)
+
+		wanted := fmt.Sprintf("Preference list for '%v' can not contain ties", "B")
+		assert.Equal(t, wanted, err.Error())
+	})
+}
@@ -9,10 +9,18 @@ import (
 
 // SingleTableValidator contains all information required to validate a single
 // preference table.
+//
+// When `Capacitated` is set, members may specify a capacity greater than 1. A
+// table where any member does so may have an odd number of members.
+//
+// When `IncompleteLists` is set, preference lists may omit other members and
+// the table may have an odd number of members.
 type SingleTableValidator struct {
-	Prefs *[]core.MatchPreference
-	Table *core.PreferenceTable
-	Err   error
+	Prefs           *[]core.MatchPreference
+	Table           *core.PreferenceTable
+	Capacitated     bool
+	IncompleteLists bool
+	Err             error
 }
 
 // Validate validates the preference table specified in the struct.
@@ -24,6 +32,11 @@ func (v SingleTableValidator) Validate() error {
 		return err
 	}
 
+	err = v.validateCapacity()
+	if err != nil {
+		return err
+	}
+
 	err = v.validateSize()
 	if err != nil {
 		return err
@@ -34,6 +47,11 @@ func (v SingleTableValidator) Validate() error {
 		return err
 	}
 
+	err = validateNoTies(v.Table)
+	if err != nil {
+		return err
+	}
+
 	err = v.validateSymmetry()
 	if err != nil {
 		return err
@@ -60,7 +78,28 @@ func (v SingleTableValidator) validateUniqueness() error {
 	return nil
 }
 
-// validateSize validates that the table is non-empty and of even size
+// validateCapacity validates that member capacities are positive, and that
+// only members of a capacitated problem have a capacity greater than 1.
+func (v SingleTableValidator) validateCapacity() error {
+	for i := range *v.Prefs {
+		pref := (*v.Prefs)[i]
+
+		if pref.Capacity < 0 {
+			return errors.New(
+				fmt.Sprintf("Capacity for '%v' must be a positive number", pref.Name))
+		}
+
+		if pref.Capacity > 1 && !v.Capacitated {
+			return errors.New(
+				fmt.Sprintf("Capacity for '%v' can not be greater than 1", pref.Name))
+		}
+	}
+
+	return nil
+}
+
+// validateSize validates that the table is non-empty and of even size. Tables
+// with capacities greater than 1 or with incomplete lists may be of any size.
 func (v SingleTableValidator) validateSize() error {
 	numMembers := len(*v.Table)
 
@@ -68,7 +107,14 @@ func (v SingleTableValidator) validateSize() error {
 		return errors.New("Table must be non-empty")
 	}
 
-	if numMembers%2 != 0 {
+	capacitated := false
+	for _, member := range *v.Table {
+		if member.Capacity() > 1 {
+			capacitated = true
+		}
+	}
+
+	if numMembers%2 != 0 && !capacitated && !v.IncompleteLists {
 		return errors.New("Table must have an even number of members")
 	}
 
@@ -86,7 +132,24 @@ func (v SingleTableValidator) validateMembers() error {
 
 // validateSymmetry validates whether the table is symmetrical. Each member's
 // preferences should contain all other members of the table.
+//
+// When preference lists are incomplete, each member's preferences only need
+// to contain distinct members of the table other than itself.
 func (v SingleTableValidator) validateSymmetry() error {
+	if v.IncompleteLists {
+		for name, member := range *v.Table {
+			if err := validatePreferenceSubset(name, member); err != nil {
+				return err
+			}
+
+			if member.PreferenceList().IndexOf(*member) != -1 {
+				return errors.New(
+					fmt.Sprintf("Preference list for '%v' can not contain itself", name))
+			}
+		}
+
+		return nil
+	}
 
 	// Build a list of member names
 
@@ -74,6 +74,58 @@ func TestValidate__SingleTable(t *testing.T) {
 		}
 	})
 
+	t.Run("capacitated success", func(t *testing.T) {
+		prefs := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "C"}, Capacity: 2},
+			{Name: "B", Preferences: []string{"A", "C"}, Capacity: 2},
+			{Name: "C", Preferences: []string{"A", "B"}, Capacity: 2},
+		}
+
+		table := core.NewPreferenceTable(&prefs)
+
+		v := SingleTableValidator{Prefs: &prefs, Table: &table, Capacitated: true}
+		err := v.Validate()
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("capacity greater than 1", func(t *testing.T) {
+		prefs := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "C", "D"}},
+			{Name: "B", Preferences: []string{"A", "C", "D"}, Capacity: 2},
+			{Name: "C", Preferences: []string{"A", "B", "D"}},
+			{Name: "D", Preferences: []string{"A", "B", "C"}},
+		}
+
+		table := core.NewPreferenceTable(&prefs)
+
+		v := SingleTableValidator{Prefs: &prefs, Table: &table}
+		err := v.Validate()
+
+		if assert.NotNil(t, err) {
+			wanted := fmt.Sprintf("Capacity for '%v' can not be greater than 1", "B")
+			assert.Equal(t, wanted, err.Error())
+		}
+	})
+
+	t.Run("negative capacity", func(t *testing.T) {
+		prefs := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "C"}, Capacity: 2},
+			{Name: "B", Preferences: []string{"A", "C"}, Capacity: -1},
+			{Name: "C", Preferences: []string{"A", "B"}},
+		}
+
+		table := core.NewPreferenceTable(&prefs)
+
+		v := SingleTableValidator{Prefs: &prefs, Table: &table, Capacitated: true}
+		err := v.Validate()
+
+		if assert.NotNil(t, err) {
+			wanted := fmt.Sprintf("Capacity for '%v' must be a positive number", "B")
+			assert.Equal(t, wanted, err.Error())
+		}
+	})
+
 	t.Run("empty member", func(t *testing.T) {
 		prefs := []core.MatchPreference{
 			{Name: "A", Preferences: []string{"B", "C", "D"}},
@@ -164,4 +216,74 @@ func TestValidate__SingleTable(t *testing.T) {
 			assert.Equal(t, wanted, err.Error())
 		}
 	})
+
+	t.Run("incomplete lists success", func(t *testing.T) {
+		prefs := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "C"}},
+			{Name: "B", Preferences: []string{"A"}},
+			{Name: "C", Preferences: []string{}},
+		}
+
+		table := core.NewPreferenceTable(&prefs)
+
+		v := SingleTableValidator{Prefs: &prefs, Table: &table, IncompleteLists: true}
+		err := v.Validate()
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("incomplete lists with unknown member", func(t *testing.T) {
+		prefs := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"B", "X"}},
+			{Name: "B", Preferences: []string{"A"}},
+			{Name: "C", Preferences: []string{}},
+		}
+
+		table := core.NewPreferenceTable(&prefs)
+
+		v := SingleTableValidator{Prefs: &prefs, Table: &table, IncompleteLists: true}
+		err := v.Validate()
+
+		if assert.NotNil(t, err) {
+			wanted := fmt.Sprintf("Preference list for '%v' contains at least one unknown member", "A")
+			assert.Equal(t, wanted, err.Error())
+		}
+	})
+
+	t.Run("incomplete lists with self reference", func(t *testing.T) {
+		prefs := []core.MatchPreference{
+			{Name: "A", Preferences: []string{"B"}},
+			{Name: "B", Preferences: []string{"A", "B"}},
+			{Name: "C", Preferences: []string{}},
+		}
+
+		table := core.NewPreferenceTable(&prefs)
+
+		v := SingleTableValidator{Prefs: &prefs, Table: &table, IncompleteLists: true}
+		err := v.Validate()
+
+		if assert.NotNil(t, err) {
+			wanted := fmt.Sprintf("Preference list for '%v' can not contain itself", "B")
+			assert.Equal(t, wanted, err.Error())
+		}
+	})
+
+	t.Run("ties", func(t *testing.T) {
+		prefs := []core.MatchPreference{
+			{Name: "A", RankedPreferences: [][]string{{"B", "C"}, {"D"}}},
+			{Name: "B", Preferences: []string{"A", "C", "D"}},
+			{Name: "C", Preferences: []string{"A", "B", "D"}},
+			{Name: "D", Preferences: []string{"A", "B", "C"}},
+		}
+
+		table := core.NewPreferenceTable(&prefs)
+
+		v := SingleTableValidator{Prefs: &prefs, Table: &table}
+		err := v.Validate()
+
+		if assert.NotNil(t, err) {
+			wanted := fmt.Sprintf("Preference list for '%v' can not contain ties", "A")
+			assert.Equal(t, wanted, err.Error())
+		}
+	})
 }
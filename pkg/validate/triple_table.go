@@ -0,0 +1,230 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/abhchand/libmatch/pkg/core"
+)
+
+// TripleTableValidator contains all information required to validate the
+// three preference tables of a three-level problem, such as the students,
+// projects and lecturers of Student-Project Allocation.
+//
+// Members of the first table rank members of the second table, and members of
+// the third table rank members of the first table. Members of the second
+// table have no preferences of their own and each belong to an owner in the
+// third table. Preference lists may be incomplete, but an owner must rank
+// every member that ranks one of the members it owns.
+type TripleTableValidator struct {
+	PrefsSet []*[]core.MatchPreference
+	Tables   []*core.PreferenceTable
+	Err      error
+}
+
+// Validate validates the preference tables specified in the struct.
+func (v TripleTableValidator) Validate() error {
+	var err error
+
+	// This should already be verified upstream
+	if len(v.PrefsSet) != 3 || len(v.Tables) != 3 {
+		return errors.New("Internal error: expected exactly 3 Prefs and 3 Tables")
+	}
+
+	err = v.validatePrefsUniqueness()
+	if err != nil {
+		return err
+	}
+
+	err = v.validateTableUniqueness()
+	if err != nil {
+		return err
+	}
+
+	err = v.validateCapacity()
+	if err != nil {
+		return err
+	}
+
+	err = v.validateSize()
+	if err != nil {
+		return err
+	}
+
+	err = v.validateMembers()
+	if err != nil {
+		return err
+	}
+
+	err = v.validateOwners()
+	if err != nil {
+		return err
+	}
+
+	err = v.validatePreferences()
+	if err != nil {
+		return err
+	}
+
+	err = v.validateCoverage()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validatePrefsUniqueness validates that all member names are unique
+func (v TripleTableValidator) validatePrefsUniqueness() error {
+	for e := range v.PrefsSet {
+		cache := make(map[string]bool, 0)
+
+		for i := range *v.PrefsSet[e] {
+			name := (*v.PrefsSet[e])[i].Name
+
+			if cache[name] {
+				msg := fmt.Sprintf("Member names must be unique. Found duplicate entry '%v'", name)
+				return errors.New(msg)
+			}
+
+			cache[name] = true
+		}
+	}
+
+	return nil
+}
+
+// validateTableUniqueness validates that all tables have distinct sets of
+// members
+func (v TripleTableValidator) validateTableUniqueness() error {
+	for t := range v.Tables {
+		for o := t + 1; o < len(v.Tables); o++ {
+			for name := range *v.Tables[t] {
+				if (*v.Tables[o])[name] != nil {
+					msg := fmt.Sprintf("Tables must have distinct members. '%v' found in more than one table", name)
+					return errors.New(msg)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateCapacity validates that member capacities are positive, and that
+// members of the first table have a capacity of at most 1.
+func (v TripleTableValidator) validateCapacity() error {
+	for e := range v.PrefsSet {
+		for i := range *v.PrefsSet[e] {
+			pref := (*v.PrefsSet[e])[i]
+
+			if pref.Capacity < 0 {
+				return errors.New(
+					fmt.Sprintf("Capacity for '%v' must be a positive number", pref.Name))
+			}
+
+			if pref.Capacity > 1 && e == 0 {
+				return errors.New(
+					fmt.Sprintf("Capacity for '%v' can not be greater than 1", pref.Name))
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateSize validates that all tables are non-empty
+func (v TripleTableValidator) validateSize() error {
+	for t := range v.Tables {
+		if len(*v.Tables[t]) == 0 {
+			return errors.New("Table must be non-empty")
+		}
+	}
+
+	return nil
+}
+
+// validateMembers validates that members are non-blank.
+func (v TripleTableValidator) validateMembers() error {
+	for t := range v.Tables {
+		if (*v.Tables[t])[""] != nil {
+			return errors.New("All member names must non-blank")
+		}
+	}
+
+	return nil
+}
+
+// validateOwners validates that every member of the second table belongs to a
+// member of the third table, and that no other member has an owner.
+func (v TripleTableValidator) validateOwners() error {
+	for e := range v.PrefsSet {
+		for i := range *v.PrefsSet[e] {
+			pref := (*v.PrefsSet[e])[i]
+
+			if e != 1 {
+				if pref.Owner != "" {
+					return errors.New(
+						fmt.Sprintf("Owner can not be specified for '%v'", pref.Name))
+				}
+
+				continue
+			}
+
+			if (*v.Tables[2])[pref.Owner] == nil {
+				return errors.New(
+					fmt.Sprintf("Owner of '%v' must be a member of the third table", pref.Name))
+			}
+		}
+	}
+
+	return nil
+}
+
+// validatePreferences validates that preference lists only contain distinct,
+// known members without ties, and that members of the second table have no
+// preference list.
+func (v TripleTableValidator) validatePreferences() error {
+	for t := range v.Tables {
+		for name, member := range *v.Tables[t] {
+			if t == 1 {
+				if len(member.PreferenceList().Members()) > 0 {
+					return errors.New(
+						fmt.Sprintf("Preference list for '%v' must be empty, since it is ranked by its owner", name))
+				}
+
+				continue
+			}
+
+			if err := validatePreferenceSubset(name, member); err != nil {
+				return err
+			}
+		}
+
+		if err := validateNoTies(v.Tables[t]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateCoverage validates that each owner ranks every member of the first
+// table that ranks one of the members it owns.
+func (v TripleTableValidator) validateCoverage() error {
+	for name, member := range *v.Tables[0] {
+		prefs := member.PreferenceList().Members()
+
+		for p := range prefs {
+			owner := prefs[p].Owner()
+
+			if owner.PreferenceList().IndexOf(*member) == -1 {
+				return errors.New(
+					fmt.Sprintf("Preference list for '%v' must contain '%v', who ranks '%v'",
+						owner.Name(), name, prefs[p].Name()))
+			}
+		}
+	}
+
+	return nil
+}
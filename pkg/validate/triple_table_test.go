@@ -0,0 +1,174 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/abhchand/libmatch/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func tripleTablePrefs() []*[]core.MatchPreference {
+	return []*[]core.MatchPreference{
+		{
+			{Name: "S1", Preferences: []string{"P1", "P2"}},
+			{Name: "S2", Preferences: []string{"P2"}},
+		},
+		{
+			{Name: "P1", Owner: "L1", Capacity: 2},
+			{Name: "P2", Owner: "L2"},
+		},
+		{
+			{Name: "L1", Preferences: []string{"S1"}, Capacity: 2},
+			{Name: "L2", Preferences: []string{"S2", "S1"}},
+		},
+	}
+}
+
+func validateTripleTable(prefsSet []*[]core.MatchPreference) error {
+	tables := core.NewPreferenceTableTriple(prefsSet[0], prefsSet[1], prefsSet[2])
+
+	v := TripleTableValidator{
+		PrefsSet: prefsSet,
+		Tables:   []*core.PreferenceTable{&tables[0], &tables[1], &tables[2]},
+	}
+
+	return v.Validate()
+}
+
+func TestValidate__TripleTable(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		err := validateTripleTable(tripleTablePrefs())
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("bad number of prefs", func(t *testing.T) {
+		prefsSet := tripleTablePrefs()
+		tables := core.NewPreferenceTableTriple(prefsSet[0], prefsSet[1], prefsSet[2])
+
+		v := TripleTableValidator{
+			PrefsSet: prefsSet[0:2],
+			Tables:   []*core.PreferenceTable{&tables[0], &tables[1], &tables[2]},
+		}
+		err := v.Validate()
+
+		assert.Equal(t, "Internal error: expected exactly 3 Prefs and 3 Tables", err.Error())
+	})
+
+	t.Run("duplicate member names", func(t *testing.T) {
+		prefsSet := tripleTablePrefs()
+		*prefsSet[1] = append(*prefsSet[1], core.MatchPreference{Name: "P1", Owner: "L1"})
+
+		err := validateTripleTable(prefsSet)
+
+		assert.Equal(t, "Member names must be unique. Found duplicate entry 'P1'", err.Error())
+	})
+
+	t.Run("members in more than one table", func(t *testing.T) {
+		prefsSet := tripleTablePrefs()
+		*prefsSet[2] = append(*prefsSet[2], core.MatchPreference{Name: "P1"})
+
+		err := validateTripleTable(prefsSet)
+
+		assert.Equal(t, "Tables must have distinct members. 'P1' found in more than one table", err.Error())
+	})
+
+	t.Run("negative capacity", func(t *testing.T) {
+		prefsSet := tripleTablePrefs()
+		(*prefsSet[2])[0].Capacity = -1
+
+		err := validateTripleTable(prefsSet)
+
+		assert.Equal(t, "Capacity for 'L1' must be a positive number", err.Error())
+	})
+
+	t.Run("capacity of the first table", func(t *testing.T) {
+		prefsSet := tripleTablePrefs()
+		(*prefsSet[0])[0].Capacity = 2
+
+		err := validateTripleTable(prefsSet)
+
+		assert.Equal(t, "Capacity for 'S1' can not be greater than 1", err.Error())
+	})
+
+	t.Run("empty table", func(t *testing.T) {
+		prefsSet := tripleTablePrefs()
+		*prefsSet[0] = []core.MatchPreference{}
+
+		err := validateTripleTable(prefsSet)
+
+		assert.Equal(t, "Table must be non-empty", err.Error())
+	})
+
+	t.Run("blank member name", func(t *testing.T) {
+		prefsSet := tripleTablePrefs()
+		*prefsSet[0] = append(*prefsSet[0], core.MatchPreference{Name: ""})
+
+		err := validateTripleTable(prefsSet)
+
+		assert.Equal(t, "All member names must non-blank", err.Error())
+	})
+
+	t.Run("unknown owner", func(t *testing.T) {
+		prefsSet := tripleTablePrefs()
+		(*prefsSet[1])[1].Owner = "X"
+
+		err := validateTripleTable(prefsSet)
+
+		assert.Equal(t, "Owner of 'P2' must be a member of the third table", err.Error())
+	})
+
+	t.Run("missing owner", func(t *testing.T) {
+		prefsSet := tripleTablePrefs()
+		(*prefsSet[1])[1].Owner = ""
+
+		err := validateTripleTable(prefsSet)
+
+		assert.Equal(t, "Owner of 'P2' must be a member of the third table", err.Error())
+	})
+
+	t.Run("owner outside the second table", func(t *testing.T) {
+		prefsSet := tripleTablePrefs()
+		(*prefsSet[0])[1].Owner = "L1"
+
+		err := validateTripleTable(prefsSet)
+
+		assert.Equal(t, "Owner can not be specified for 'S2'", err.Error())
+	})
+
+	t.Run("unknown preference", func(t *testing.T) {
+		prefsSet := tripleTablePrefs()
+		(*prefsSet[0])[1].Preferences = []string{"P2", "X"}
+
+		err := validateTripleTable(prefsSet)
+
+		assert.Equal(t, "Preference list for 'S2' contains at least one unknown member", err.Error())
+	})
+
+	t.Run("preferences in the second table", func(t *testing.T) {
+		prefsSet := tripleTablePrefs()
+		(*prefsSet[1])[1].Preferences = []string{"L1"}
+
+		err := validateTripleTable(prefsSet)
+
+		assert.Equal(t, "Preference list for 'P2' must be empty, since it is ranked by its owner", err.Error())
+	})
+
+	t.Run("ties", func(t *testing.T) {
+		prefsSet := tripleTablePrefs()
+		(*prefsSet[2])[1].RankedPreferences = [][]string{{"S2", "S1"}}
+
+		err := validateTripleTable(prefsSet)
+
+		assert.Equal(t, "Preference list for 'L2' can not contain ties", err.Error())
+	})
+
+	t.Run("owner does not rank a member", func(t *testing.T) {
+		prefsSet := tripleTablePrefs()
+		(*prefsSet[2])[1].Preferences = []string{"S2"}
+
+		err := validateTripleTable(prefsSet)
+
+		assert.Equal(t, "Preference list for 'L2' must contain 'S1', who ranks 'P2'", err.Error())
+	})
+}